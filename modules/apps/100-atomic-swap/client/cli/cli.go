@@ -19,6 +19,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdParams(),
 		GetCmdQueryEscrowAddress(),
 		GetCmdOrderList(),
+		GetCmdOrder(),
+		GetCmdOrderPrice(),
+		GetCmdCollectedFees(),
+		GetCmdBidsByOrder(),
+		GetCmdBidsByBidder(),
+		GetCmdSwapLists(),
+		GetCmdRateLimits(),
+		GetCmdChannelRateLimits(),
 	)
 
 	return queryCmd
@@ -38,6 +46,15 @@ func NewTxCmd() *cobra.Command {
 		NewMakeSwapTxCmd(),
 		NewTakeSwapTxCmd(),
 		NewCancelSwapTxCmd(),
+		NewMakeBidTxCmd(),
+		NewAcceptBidTxCmd(),
+		NewCancelBidTxCmd(),
+		NewLockHTLCTxCmd(),
+		NewClaimHTLCTxCmd(),
+		NewRefundHTLCTxCmd(),
+		NewCancelChannelOrdersTxCmd(),
+		NewSignOrderCmd(),
+		NewFillSignedOrderTxCmd(),
 	)
 
 	return txCmd
@@ -91,3 +91,270 @@ func GetCmdOrderList() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdOrder returns the command handler for querying an order by its id.
+func GetCmdOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "order [order-id]",
+		Short:   "Get an order by its id",
+		Long:    "Get an order by its id, together with the counterparty chain id, the escrow address and the expiry state",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-swap order [order-id]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetOrder(cmd.Context(), &types.QueryOrderRequest{OrderId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdOrderPrice returns the command handler for querying the current price of an order.
+func GetCmdOrderPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "order-price [order-id]",
+		Short:   "Get the current price of an order",
+		Long:    "Get the buy token asked by an order at the current block time, which decays over time for auction orders",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-swap order-price [order-id]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetOrderPrice(cmd.Context(), &types.QueryOrderPriceRequest{OrderId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCollectedFees returns the command handler for querying the collected swap fees.
+func GetCmdCollectedFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "collected-fees [denom]",
+		Short:   "Get the swap fees collected by the module",
+		Long:    "Get the swap fees collected by the module, optionally restricted to a single denom",
+		Args:    cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf("%s query ibc-swap collected-fees [denom]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCollectedFeesRequest{}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+			res, err := queryClient.CollectedFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBidsByOrder returns the command handler for querying the bids on an order.
+func GetCmdBidsByOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bids [order-id]",
+		Short:   "Get the bids on an order",
+		Long:    "Get the bids on an order",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-swap bids [order-id]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetBidsByOrder(cmd.Context(), &types.QueryBidsByOrderRequest{OrderId: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bids")
+
+	return cmd
+}
+
+// GetCmdBidsByBidder returns the command handler for querying the bids of a bidder.
+func GetCmdBidsByBidder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bidder-bids [bidder]",
+		Short:   "Get the bids of a bidder",
+		Long:    "Get the bids of a bidder",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-swap bidder-bids [bidder]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetBidsByBidder(cmd.Context(), &types.QueryBidsByBidderRequest{Bidder: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bidder-bids")
+
+	return cmd
+}
+
+// GetCmdSwapLists returns the command handler for querying the entries of a governed swap list.
+func GetCmdSwapLists() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "swap-lists [allowlist|denylist]",
+		Short:   "Get the entries of the swap allowlist or denylist",
+		Long:    "Get the denoms, channels and chain ids of the swap allowlist or denylist",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-swap swap-lists denylist", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var list types.SwapList
+			switch args[0] {
+			case "allowlist":
+				list = types.ALLOWLIST
+			case "denylist":
+				list = types.DENYLIST
+			default:
+				return fmt.Errorf("unknown swap list %s, expected allowlist or denylist", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SwapLists(cmd.Context(), &types.QuerySwapListsRequest{List: list, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "swap-lists")
+
+	return cmd
+}
+
+// GetCmdRateLimits returns the command handler for querying the escrow rate limits.
+func GetCmdRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits",
+		Short:   "Get the escrow rate limits with their usage",
+		Long:    "Get the escrow rate limits with their outflow and inflow in the current window",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-swap rate-limits", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimits(cmd.Context(), &types.QueryRateLimitsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate-limits")
+
+	return cmd
+}
+
+// GetCmdChannelRateLimits returns the command handler for querying the escrow rate limits of a channel.
+func GetCmdChannelRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-rate-limits [port] [channel-id]",
+		Short:   "Get the escrow rate limits of a channel",
+		Long:    "Get the escrow rate limits of a channel with their usage, and whether the channel is paused",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-swap channel-rate-limits swap channel-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelRateLimits(cmd.Context(), &types.QueryChannelRateLimitsRequest{PortId: args[0], ChannelId: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
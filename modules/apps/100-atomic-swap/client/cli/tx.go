@@ -1,7 +1,11 @@
 package cli
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -9,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
@@ -22,6 +27,14 @@ const (
 	flagAbsoluteTimeouts       = "absolute-timeouts"
 
 	flagExpectedCounterparty = "expected-counterparty"
+	flagExpirationTimestamp  = "expiration-timestamp"
+	flagAllowPartialFill     = "allow-partial-fill"
+	flagMinFillAmount        = "min-fill-amount"
+	flagFloorBuyAmount       = "floor-buy-amount"
+	flagDecayWindow          = "decay-window"
+
+	flagSenderReceivingAddress = "sender-receiving-address"
+	flagDesiredTaker           = "desired-taker"
 )
 
 // NewMakeSwapTxCmd returns the command to create a NewMsgMakeSwap transaction
@@ -34,7 +47,9 @@ as absolute or relative using the "absolute-timeouts" flag. Timeout height can b
 in the form {revision}-{height} using the "packet-timeout-height" flag. Relative timeout height is added to the block
 height queried from the latest consensus state corresponding to the counterparty channel. Relative timeout timestamp 
 is added to the greater value of the local clock time and the block timestamp queried from the latest consensus state 
-corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
+corresponding to the counterparty channel. Any timeout set to 0 is disabled.
+A basket order is made by passing several comma separated coins as amount or receiving token amount,
+it requires a channel negotiated with the basket version.`),
 		// Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-channel] [receiver] [amount]", version.AppName),
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -46,14 +61,19 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 			srcChannel := args[0]
 			receivingAddress := args[2]
 
-			fromCoin, err := sdk.ParseCoinNormalized(args[1])
+			fromCoins, err := sdk.ParseCoinsNormalized(args[1])
 			if err != nil {
 				return err
 			}
-			toCoin, err2 := sdk.ParseCoinNormalized(args[3])
-			if err2 != nil {
+			toCoins, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
 				return err
 			}
+			basket := len(fromCoins) != 1 || len(toCoins) != 1
+			var fromCoin, toCoin sdk.Coin
+			if !basket {
+				fromCoin, toCoin = fromCoins[0], toCoins[0]
+			}
 
 			//if !strings.HasPrefix(coin.Denom, "ibc/") {
 			//	denomTrace := types.ParseDenomTrace(coin.Denom)
@@ -84,6 +104,34 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				return err
 			}
 
+			expirationTimestamp, err := cmd.Flags().GetUint64(flagExpirationTimestamp)
+			if err != nil {
+				return err
+			}
+
+			allowPartialFill, err := cmd.Flags().GetBool(flagAllowPartialFill)
+			if err != nil {
+				return err
+			}
+
+			minFillAmountStr, err := cmd.Flags().GetString(flagMinFillAmount)
+			if err != nil {
+				return err
+			}
+			minFillAmount, ok := sdk.NewIntFromString(minFillAmountStr)
+			if !ok {
+				return fmt.Errorf("invalid min fill amount: %s", minFillAmountStr)
+			}
+
+			floorBuyAmountStr, err := cmd.Flags().GetString(flagFloorBuyAmount)
+			if err != nil {
+				return err
+			}
+			decayWindow, err := cmd.Flags().GetUint64(flagDecayWindow)
+			if err != nil {
+				return err
+			}
+
 			// if the timeouts are not absolute, retrieve latest block height and block timestamp
 			// for the consensus state connected to the destination port/channel
 			if !absoluteTimeouts {
@@ -123,6 +171,25 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				sender, receivingAddress, expectedCounterparty,
 				timeoutHeight, timeoutTimestamp, time.Now().UTC().Unix(),
 			)
+			if basket {
+				msg.SellTokens = fromCoins
+				msg.BuyTokens = toCoins
+			}
+			msg.ExpirationTimestamp = expirationTimestamp
+			msg.AllowPartialFill = allowPartialFill
+			msg.MinFillAmount = minFillAmount
+			// the order is a dutch auction starting at the receiving token amount
+			if floorBuyAmountStr != "" {
+				floorBuyAmount, ok := sdk.NewIntFromString(floorBuyAmountStr)
+				if !ok {
+					return fmt.Errorf("invalid floor buy amount: %s", floorBuyAmountStr)
+				}
+				msg.PriceSchedule = &types.PriceSchedule{
+					StartBuyAmount: toCoin.Amount,
+					FloorBuyAmount: floorBuyAmount,
+					DecayWindow:    decayWindow,
+				}
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -131,6 +198,11 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
 	cmd.Flags().String(flagExpectedCounterparty, "", "Expected Counterparty address on the taker chains")
+	cmd.Flags().Uint64(flagExpirationTimestamp, 0, "Order expiration timestamp in unix seconds. The expiration is disabled when set to 0.")
+	cmd.Flags().Bool(flagAllowPartialFill, false, "Allow the order to be filled partially by several takers.")
+	cmd.Flags().String(flagMinFillAmount, "0", "Minimum amount of the receiving token a single partial fill has to pay.")
+	cmd.Flags().String(flagFloorBuyAmount, "", "Sell the order as a dutch auction whose price decays from the receiving token amount down to this amount.")
+	cmd.Flags().Uint64(flagDecayWindow, 0, "Duration in seconds of the price decay of a dutch auction order.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -146,7 +218,8 @@ as absolute or relative using the "absolute-timeouts" flag. Timeout height can b
 in the form {revision}-{height} using the "packet-timeout-height" flag. Relative timeout height is added to the block
 height queried from the latest consensus state corresponding to the counterparty channel. Relative timeout timestamp 
 is added to the greater value of the local clock time and the block timestamp queried from the latest consensus state 
-corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
+corresponding to the counterparty channel. Any timeout set to 0 is disabled.
+A basket order is taken by passing all its receiving tokens as comma separated coins.`),
 		// Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-channel] [receiver] [amount]", version.AppName),
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -158,7 +231,14 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 			orderId := args[0]
 			receivingAddress := args[2]
 
-			sellToken, err := sdk.ParseCoinNormalized(args[1])
+			sellTokens, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			var sellToken sdk.Coin
+			if len(sellTokens) == 1 {
+				sellToken = sellTokens[0]
+			}
 
 			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
 			if err != nil {
@@ -218,6 +298,9 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				sender, receivingAddress,
 				timeoutHeight, timeoutTimestamp, time.Now().UTC().Unix(),
 			)
+			if len(sellTokens) > 1 {
+				msg.SellTokens = sellTokens
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -323,3 +406,381 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 
 	return cmd
 }
+
+// NewMakeBidTxCmd returns the command to create a MakeBidMsg transaction
+func NewMakeBidTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "make-bid [order-id] [amount] [receiving-address]",
+		Short: "Propose a different price for an order through IBC",
+		Long: strings.TrimSpace(`Bid on an order of the counterparty chain. The amount is locked until the bid is
+accepted by the maker, cancelled or refunded. The receiving address is the bidder's address on the maker chain.
+Timeout height can be set by passing in the height string in the form {revision}-{height} using the
+"packet-timeout-height" flag. Any timeout set to 0 is disabled.`),
+		Example: fmt.Sprintf("%s tx ibc-swap make-bid [order-id] 100uatom [receiving-address]", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			timeoutHeight, timeoutTimestamp, err := parseTimeoutFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMakeBid(
+				args[0], amount,
+				clientCtx.GetFromAddress().String(), args[2],
+				timeoutHeight, timeoutTimestamp, time.Now().UTC().Unix(),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addTimeoutFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAcceptBidTxCmd returns the command to create an AcceptBidMsg transaction
+func NewAcceptBidTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-bid [order-id] [bidder]",
+		Short: "Accept a bid on an order through IBC",
+		Long: strings.TrimSpace(`Accept a bid on an order of the maker. The order is settled with the bidder
+and the other bids are refunded. Timeout height can be set by passing in the height string in the form
+{revision}-{height} using the "packet-timeout-height" flag. Any timeout set to 0 is disabled.`),
+		Example: fmt.Sprintf("%s tx ibc-swap accept-bid [order-id] [bidder]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeight, timeoutTimestamp, err := parseTimeoutFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptBid(
+				args[0], clientCtx.GetFromAddress().String(), args[1],
+				timeoutHeight, timeoutTimestamp, time.Now().UTC().Unix(),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addTimeoutFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelBidTxCmd returns the command to create a CancelBidMsg transaction
+func NewCancelBidTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-bid [order-id]",
+		Short: "Withdraw a bid on an order through IBC",
+		Long: strings.TrimSpace(`Withdraw a bid on an order. The bid is refunded once the maker chain
+acknowledges the cancellation. Timeout height can be set by passing in the height string in the form
+{revision}-{height} using the "packet-timeout-height" flag. Any timeout set to 0 is disabled.`),
+		Example: fmt.Sprintf("%s tx ibc-swap cancel-bid [order-id]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeight, timeoutTimestamp, err := parseTimeoutFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelBid(
+				args[0], clientCtx.GetFromAddress().String(),
+				timeoutHeight, timeoutTimestamp, time.Now().UTC().Unix(),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addTimeoutFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewLockHTLCTxCmd returns the command to create a LockHTLCMsg transaction
+func NewLockHTLCTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-htlc [receiver] [sell-token] [buy-token] [hash-lock] [timelock]",
+		Short: "Lock tokens in a hash time locked order",
+		Long: strings.TrimSpace(`Lock tokens in a hash time locked order to swap with a chain which is not connected
+over IBC. The hash lock is the hex encoded SHA-256 hash of the secret preimage and the timelock is a unix
+timestamp in seconds. The receiver can claim the tokens with the preimage before the timelock, afterwards
+they are refunded to the sender.`),
+		Example: fmt.Sprintf("%s tx ibc-swap lock-htlc [receiver] 100uatom 10btc [hash-lock] [timelock]", version.AppName),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sellToken, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			buyToken, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+			hashLock, err := hex.DecodeString(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid hash lock: %w", err)
+			}
+			timelock, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid timelock: %w", err)
+			}
+
+			receivingAddress, err := cmd.Flags().GetString(flagSenderReceivingAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLockHTLC(
+				clientCtx.GetFromAddress().String(), args[0],
+				sellToken, buyToken, receivingAddress,
+				hashLock, timelock, time.Now().UTC().Unix(),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagSenderReceivingAddress, "", "Address of the sender on the counterparty chain.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewClaimHTLCTxCmd returns the command to create a ClaimHTLCMsg transaction
+func NewClaimHTLCTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-htlc [order-id] [preimage]",
+		Short:   "Claim the tokens of a hash time locked order with the hex encoded preimage of its hash lock",
+		Example: fmt.Sprintf("%s tx ibc-swap claim-htlc [order-id] [preimage]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			preimage, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid preimage: %w", err)
+			}
+
+			msg := types.NewMsgClaimHTLC(args[0], clientCtx.GetFromAddress().String(), preimage)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRefundHTLCTxCmd returns the command to create a RefundHTLCMsg transaction
+func NewRefundHTLCTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "refund-htlc [order-id]",
+		Short:   "Refund the tokens of a hash time locked order once its timelock is reached",
+		Example: fmt.Sprintf("%s tx ibc-swap refund-htlc [order-id]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRefundHTLC(args[0], clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelChannelOrdersTxCmd returns the command to create a CancelChannelOrdersMsg transaction
+func NewCancelChannelOrdersTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-channel-orders [src-port] [src-channel]",
+		Short: "Cancel the open orders of a closed channel",
+		Long: strings.TrimSpace(`Cancel the open orders of a closed channel and refund their locked tokens. The orders
+of a channel are cancelled when its closing is confirmed, the command cleans up channels closed before.`),
+		Example: fmt.Sprintf("%s tx ibc-swap cancel-channel-orders [src-port] [src-channel]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelChannelOrders(clientCtx.GetFromAddress().String(), args[0], args[1])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSignOrderCmd returns the command to sign an order off-chain, the signed order is printed
+// so that it can be filled with the fill-signed-order command.
+func NewSignOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-order [src-channel] [amount] [receiving-address] [receiving-token-amount] [nonce] [expiration-timestamp]",
+		Short: "Sign an order off-chain",
+		Long: strings.TrimSpace(`Sign an order off-chain with the key of the maker account. The signed order is printed,
+a taker holding it can take the order from the counterparty chain with the fill-signed-order command. The sell tokens
+are pulled from the maker when the order is filled, through a send authorization granted to the atomic swap module account:
+  tx authz grant [swap-module-address] send --spend-limit [amount] --from [maker]
+A basket order is signed by passing several comma separated coins as amount or receiving token amount.`),
+		Example: fmt.Sprintf("%s tx ibc-swap sign-order channel-0 100stake [receiving-address] 50uatom 1 1700000000 --from maker > order.json", version.AppName),
+		Args:    cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sellTokens, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			buyTokens, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return err
+			}
+			nonce, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+			expirationTimestamp, err := strconv.ParseUint(args[5], 10, 64)
+			if err != nil {
+				return err
+			}
+			desiredTaker, err := cmd.Flags().GetString(flagDesiredTaker)
+			if err != nil {
+				return err
+			}
+
+			order := types.SignedOrder{
+				ChainId:               clientCtx.ChainID,
+				SourcePort:            types.PortID,
+				SourceChannel:         args[0],
+				MakerAddress:          clientCtx.GetFromAddress().String(),
+				MakerReceivingAddress: args[2],
+				SellTokens:            sellTokens,
+				BuyTokens:             buyTokens,
+				Nonce:                 nonce,
+				ExpirationTimestamp:   expirationTimestamp,
+				DesiredTaker:          desiredTaker,
+			}
+			if err := order.ValidateBasic(); err != nil {
+				return err
+			}
+
+			signature, _, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), order.GetSignBytes())
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&types.FillSignedOrderMsg{Order: order, Signature: signature})
+		},
+	}
+
+	cmd.Flags().String(flagDesiredTaker, "", "Only the desired taker on the counterparty chain is allowed to take the order")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewFillSignedOrderTxCmd returns the command to create a FillSignedOrderMsg transaction
+func NewFillSignedOrderTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fill-signed-order [src-channel] [taker-receiving-address] [signed-order-file]",
+		Short: "Take an order signed off-chain by its maker",
+		Long: strings.TrimSpace(`Take an order signed off-chain by its maker with the sign-order command. The command is
+sent on the taker chain: the buy tokens of the order are locked and the maker chain settles the order on receipt
+of the packet, the sell tokens are sent to the taker receiving address on the maker chain.`),
+		Example: fmt.Sprintf("%s tx ibc-swap fill-signed-order channel-0 cosmos1... order.json", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+			var signed types.FillSignedOrderMsg
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &signed); err != nil {
+				return err
+			}
+
+			timeoutHeight, timeoutTimestamp, err := parseTimeoutFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFillSignedOrder(
+				types.PortID, args[0],
+				clientCtx.GetFromAddress().String(), args[1], signed.Order, signed.Signature,
+				timeoutHeight, timeoutTimestamp,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addTimeoutFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addTimeoutFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagPacketTimeoutHeight, types.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
+}
+
+func parseTimeoutFlags(cmd *cobra.Command) (clienttypes.Height, uint64, error) {
+	timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+	timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+	timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+	return timeoutHeight, timeoutTimestamp, nil
+}
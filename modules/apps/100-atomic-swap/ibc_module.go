@@ -1,6 +1,7 @@
 package swap
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"strings"
@@ -76,12 +77,14 @@ func (im IBCModule) OnChanOpenInit(
 		return "", err
 	}
 
+	// the v1 version is proposed by default, so that the channel opens with the counterparties which
+	// do not support the protobuf encoded packets of the v2 version
 	if strings.TrimSpace(version) == "" {
 		version = types.Version
 	}
 
-	if version != types.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	if !types.IsSupportedVersion(version) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected one of %s", version, strings.Join(types.SupportedVersions, ", "))
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -106,8 +109,11 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	// The channel uses the version proposed by the counterparty, which selects the basket support and
+	// the packet encoding: JSON for the v1 versions and protobuf for the v2 version. A counterparty
+	// never proposes a version it does not support, and an older chain rejects the newer versions.
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected one of %s", counterpartyVersion, strings.Join(types.SupportedVersions, ", "))
 	}
 
 	// OpenTry must claim the channelCapability that IBC passes into the callback
@@ -115,7 +121,7 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	return types.Version, nil
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -126,8 +132,8 @@ func (im IBCModule) OnChanOpenAck(
 	_ string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected one of %s", counterpartyVersion, strings.Join(types.SupportedVersions, ", "))
 	}
 	return nil
 }
@@ -157,6 +163,8 @@ func (im IBCModule) OnChanCloseConfirm(
 	portID,
 	channelID string,
 ) error {
+	// the orders of the channel can not be settled or cancelled with a packet anymore
+	im.keeper.CancelClosedChannelOrders(ctx, portID, channelID)
 	return nil
 }
 
@@ -174,22 +182,28 @@ func (im IBCModule) OnRecvPacket(
 ) ibcexported.Acknowledgement {
 	logger := im.keeper.Logger(ctx)
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	cdc := im.keeper.PacketCodec(ctx, packet.GetDestPort(), packet.GetDestChannel())
 
-	var data types.AtomicSwapPacketData
 	var ackErr error
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := cdc.UnmarshalPacketData(packet.GetData())
+	if err != nil {
 		ackErr = sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
-		ack = channeltypes.NewErrorAcknowledgement(ackErr)
+		ack = types.NewErrorAcknowledgement(ackErr)
 	}
 
 	// only attempt the application logic if the packet data
 	// was successfully decoded
 	if ack.Success() {
-		resp, err := im.keeper.OnRecvPacket(ctx, packet, data)
+		// the application logic runs in a cache context, so that its state changes are discarded
+		// while the pause of a channel whose outflows exceed the rate limits is kept
+		cacheCtx, write := ctx.CacheContext()
+		resp, err := im.keeper.OnRecvPacket(cacheCtx, packet, data)
 		if err != nil {
-			ack = channeltypes.NewErrorAcknowledgement(err)
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			ack = types.NewErrorAcknowledgement(err)
 			ackErr = err
 		} else {
+			write()
 			ack = channeltypes.NewResultAcknowledgement(resp)
 			logger.Info("successfully handled ICS-100 packet sequence: %d", packet.Sequence)
 		}
@@ -211,8 +225,18 @@ func (im IBCModule) OnRecvPacket(
 		),
 	)
 
+	// the error acknowledgement of a rate limited packet is written asynchronously, the core handler
+	// would discard the pause of the channel with the state changes of a failed acknowledgement
+	if errors.Is(ackErr, types.ErrRateLimitExceeded) {
+		if err := im.keeper.PauseRateLimitedChannel(ctx, packet, cdc.NewAcknowledgement(ack)); err != nil {
+			logger.Error("failed to pause rate limited channel", "error", err)
+			return cdc.NewAcknowledgement(ack)
+		}
+		return nil
+	}
+
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
-	return ack
+	return cdc.NewAcknowledgement(ack)
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
@@ -222,12 +246,13 @@ func (im IBCModule) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	var ack channeltypes.Acknowledgement
-	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+	cdc := im.keeper.PacketCodec(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	ack, err := cdc.UnmarshalAcknowledgement(acknowledgement)
+	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
 	}
-	var data types.AtomicSwapPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := cdc.UnmarshalPacketData(packet.GetData())
+	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 
@@ -263,8 +288,8 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	var data types.AtomicSwapPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := im.keeper.PacketCodec(ctx, packet.GetSourcePort(), packet.GetSourceChannel()).UnmarshalPacketData(packet.GetData())
+	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-101 transfer packet data: %s", err.Error())
 	}
 	// refund tokens
@@ -141,6 +141,11 @@ func (suite *SwapTestSuite) TestOnChanOpenTry() {
 				path.EndpointA.ChannelConfig.PortID = ibctesting.MockPort
 			}, false,
 		},
+		{
+			"protobuf encoded version", func() {
+				counterpartyVersion = types.Version2
+			}, true,
+		},
 		{
 			"invalid counterparty version", func() {
 				counterpartyVersion = "version"
@@ -185,7 +190,7 @@ func (suite *SwapTestSuite) TestOnChanOpenTry() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(counterpartyVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal("", version)
@@ -205,6 +210,11 @@ func (suite *SwapTestSuite) TestOnChanOpenAck() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"protobuf encoded version", func() {
+				counterpartyVersion = types.Version2
+			}, true,
+		},
 		{
 			"invalid counterparty version", func() {
 				counterpartyVersion = "version"
@@ -22,17 +22,19 @@ func (k Keeper) GetAtomicOrderCount(ctx sdk.Context) uint64 {
 	return binary.BigEndian.Uint64(bz)
 }
 
-// GetAuctionCount get the total number of auction
-func (k Keeper) GetAtomicOrderCountByOrderId(ctx sdk.Context, orderId string) uint64 {
+// GetAtomicOrderCountByOrderId returns the position of an order in the order book, it returns false for unknown orders.
+func (k Keeper) GetAtomicOrderCountByOrderId(ctx sdk.Context, orderId string) (uint64, bool) {
+	return k.getOrderPosition(ctx, orderId)
+}
+
+// getOrderPosition returns the position of an order in the order book, it returns false for unknown orders.
+func (k Keeper) getOrderPosition(ctx sdk.Context, orderId string) (uint64, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderBookKeyIndexKey)
-	byteKey := []byte(orderId)
-	bz := store.Get(byteKey)
-	// Count doesn't exist: no element
+	bz := store.Get([]byte(orderId))
 	if bz == nil {
-		return 0
+		return 0, false
 	}
-	// Parse bytes
-	return binary.BigEndian.Uint64(bz)
+	return binary.BigEndian.Uint64(bz), true
 }
 
 // SetAuctionCount set the total number of auction
@@ -60,28 +62,59 @@ func (k Keeper) AppendAtomicOrder(
 ) uint64 {
 	// Create the auction
 	count := k.GetAtomicOrderCount(ctx)
-	// Set the ID of the appended value
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderBookKey)
-	appendedValue := k.cdc.MustMarshal(&order)
-	store.Set(GetOrderIDBytes(count), appendedValue)
+	k.setOrderAt(ctx, order, count)
 	// Update auction count
-	k.SetAtomicOrderCountToOrderID(ctx, order.Id, count)
 	k.SetAtomicOrderCount(ctx, count+1)
 	return count
 }
 
-// SetAuction set a specific auction in the store
+// setOrderAt stores a new order at the given position of the order book with its indexes.
+func (k Keeper) setOrderAt(ctx sdk.Context, order types.Order, count uint64) {
+	setClosedAt(ctx, &order)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderBookKey)
+	store.Set(GetOrderIDBytes(count), k.cdc.MustMarshal(&order))
+	k.setOrderIndexes(ctx, order, count)
+	k.SetAtomicOrderCountToOrderID(ctx, order.Id, count)
+	// Track open orders with an expiration in the expiration queue
+	if order.Maker != nil && order.Maker.ExpirationTimestamp != 0 &&
+		(order.Status == types.Status_INITIAL || order.Status == types.Status_SYNC) {
+		k.InsertExpiringOrderQueue(ctx, order.Id, order.Maker.ExpirationTimestamp)
+	}
+}
+
+// setClosedAt records the block time at which an order is closed. The retention of the order
+// starts from it, so that the requests closing the order can not choose when it is pruned.
+func setClosedAt(ctx sdk.Context, order *types.Order) {
+	if order.ClosedAt == 0 && order.IsClosed() {
+		order.ClosedAt = ctx.BlockTime().Unix()
+	}
+}
+
+// SetAuction set a specific auction in the store, an unknown order is appended to the order book
 func (k Keeper) SetAtomicOrder(ctx sdk.Context, order types.Order) {
+	setClosedAt(ctx, &order)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderBookKey)
 	b := k.cdc.MustMarshal(&order)
-	id := k.GetAtomicOrderCountByOrderId(ctx, order.Id)
+	id, found := k.getOrderPosition(ctx, order.Id)
+	if !found {
+		k.AppendAtomicOrder(ctx, order)
+		return
+	}
+	// Keep the secondary indexes in sync with the updated order
+	if bz := store.Get(GetOrderIDBytes(id)); bz != nil {
+		k.removeOrderIndexes(ctx, k.MustUnmarshalOrder(bz), id)
+	}
 	store.Set(GetOrderIDBytes(id), b)
+	k.setOrderIndexes(ctx, order, id)
 }
 
 // GetAuction returns a auction from its id
 func (k Keeper) GetAtomicOrder(ctx sdk.Context, orderId string) (val types.Order, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderBookKey)
-	id := k.GetAtomicOrderCountByOrderId(ctx, orderId)
+	id, found := k.getOrderPosition(ctx, orderId)
+	if !found {
+		return val, false
+	}
 	b := store.Get(GetOrderIDBytes(id))
 	if b == nil {
 		return val, false
@@ -93,8 +126,18 @@ func (k Keeper) GetAtomicOrder(ctx sdk.Context, orderId string) (val types.Order
 // RemoveAuction removes a auction from the store
 func (k Keeper) RemoveOrder(ctx sdk.Context, orderId string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderBookKey)
-	id := k.GetAtomicOrderCountByOrderId(ctx, orderId)
+	id, found := k.getOrderPosition(ctx, orderId)
+	if !found {
+		return
+	}
+	if order, found := k.GetAtomicOrder(ctx, orderId); found {
+		if order.Maker != nil && order.Maker.ExpirationTimestamp != 0 {
+			k.RemoveFromExpiringOrderQueue(ctx, orderId, order.Maker.ExpirationTimestamp)
+		}
+		k.removeOrderIndexes(ctx, order, id)
+	}
 	store.Delete(GetOrderIDBytes(id))
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderBookKeyIndexKey).Delete([]byte(orderId))
 }
 
 // GetAllAuction returns all auction
@@ -136,21 +179,3 @@ func (k Keeper) MoveOrderToBottom(ctx sdk.Context, orderId string) error {
 	k.AppendAtomicOrder(ctx, order)
 	return nil
 }
-
-func (k Keeper) TrimExcessOrders(ctx sdk.Context) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderBookKey)
-
-	totalCount := k.GetAtomicOrderCount(ctx)
-	if totalCount <= types.MaxOrderCount {
-		return
-	}
-	// Calculate number of items to be removed
-	excess := totalCount - types.MaxOrderCount
-	for i := uint64(0); i < excess; i++ {
-		// As items are appended, to remove from the bottom, we need to remove the items
-		// starting from totalCount - i (i.e., the last item in the list, then the second last, etc.)
-		idToRemove := totalCount - i - 1
-		store.Delete(GetOrderIDBytes(idToRemove))
-		k.SetAtomicOrderCount(ctx, totalCount-i-1)
-	}
-}
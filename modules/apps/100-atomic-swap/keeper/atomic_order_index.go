@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// orderIndexPrefixes returns the prefixes of all secondary indexes the order belongs to.
+func orderIndexPrefixes(order types.Order) [][]byte {
+	prefixes := [][]byte{
+		types.OrderStatusIndexPrefix(order.Status),
+		types.OrderSideIndexPrefix(order.Side),
+	}
+	if order.Maker != nil {
+		prefixes = append(prefixes, types.OrderIndexPrefix(types.OTCOrderMakerIndexKey, order.Maker.MakerAddress))
+		if order.Maker.IsBasket() {
+			// a basket order is listed under every pair of its sell and buy tokens
+			for _, sellToken := range order.Maker.SellTokens {
+				for _, buyToken := range order.Maker.BuyTokens {
+					prefixes = append(prefixes, types.OrderIndexPrefix(types.OTCOrderPairIndexKey, sellToken.Denom, buyToken.Denom))
+				}
+			}
+		} else {
+			prefixes = append(prefixes, types.OrderIndexPrefix(types.OTCOrderPairIndexKey, order.Maker.SellToken.Denom, order.Maker.BuyToken.Denom))
+		}
+		if order.Maker.DesiredTaker != "" {
+			prefixes = append(prefixes, types.OrderIndexPrefix(types.OTCOrderDesiredTakerIndexKey, order.Maker.DesiredTaker))
+		}
+	}
+
+	takers := make(map[string]bool)
+	if order.Takers != nil {
+		takers[order.Takers.TakerAddress] = true
+	}
+	for _, fill := range order.Fills {
+		takers[fill.TakerAddress] = true
+	}
+	for taker := range takers {
+		prefixes = append(prefixes, types.OrderIndexPrefix(types.OTCOrderTakerIndexKey, taker))
+	}
+	return prefixes
+}
+
+// setOrderIndexes adds the order stored at the given position of the order book to its secondary indexes.
+func (k Keeper) setOrderIndexes(ctx sdk.Context, order types.Order, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, indexPrefix := range orderIndexPrefixes(order) {
+		store.Set(append(indexPrefix, GetOrderIDBytes(count)...), []byte(order.Id))
+	}
+	k.setOrderRetention(ctx, order, count)
+}
+
+// removeOrderIndexes removes the order stored at the given position of the order book from its secondary indexes.
+func (k Keeper) removeOrderIndexes(ctx sdk.Context, order types.Order, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, indexPrefix := range orderIndexPrefixes(order) {
+		store.Delete(append(indexPrefix, GetOrderIDBytes(count)...))
+	}
+	k.removeOrderRetention(ctx, order, count)
+}
+
+// paginateOrderIndex paginates over the orders of a secondary index in the order book order.
+func (k Keeper) paginateOrderIndex(ctx sdk.Context, indexPrefix []byte, pagination *query.PageRequest) ([]*types.Order, *query.PageResponse, error) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	orderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderBookKey)
+
+	var orders []*types.Order
+	pageRes, err := query.Paginate(indexStore, pagination, func(key, _ []byte) error {
+		bz := orderStore.Get(key)
+		if bz == nil {
+			return nil
+		}
+		var order types.Order
+		if err := k.cdc.Unmarshal(bz, &order); err != nil {
+			return err
+		}
+		orders = append(orders, &order)
+		return nil
+	})
+	return orders, pageRes, err
+}
@@ -69,10 +69,15 @@ func (suite *KeeperTestSuite) TestMoveOrderToBottom() {
 	suite.Require().Equal(lastOrder.Id, orderIDs[2])
 
 	// Additional check for the updated `orderId -> count` relationship
-	movedOrderCount := k.GetAtomicOrderCountByOrderId(ctx, orderIDs[2])
+	movedOrderCount, found := k.GetAtomicOrderCountByOrderId(ctx, orderIDs[2])
+	suite.Require().True(found)
 	lastOrderCount := k.GetAtomicOrderCount(ctx) - 1
 	suite.Require().Equal(movedOrderCount, lastOrderCount)
 
+	// an unknown order has no position, it is not aliased to the first order
+	_, found = k.GetAtomicOrderCountByOrderId(ctx, "unknown")
+	suite.Require().False(found)
+
 	// Verify that all other orders have maintained their original sequence
 	for i := 0; i < len(orderIDs)-1; i++ { // Exclude the last order (since it was moved)
 		if i < 2 { // For orders before the moved order
@@ -82,30 +87,3 @@ func (suite *KeeperTestSuite) TestMoveOrderToBottom() {
 		}
 	}
 }
-
-func (suite *KeeperTestSuite) TestTrimExcessOrders() {
-	ctx := suite.chainA.GetContext()
-	k := suite.chainA.GetSimApp().AtomicSwapKeeper
-
-	// Add a few more than MaxItems
-	for i := uint64(0); i < types.MaxOrderCount+100; i++ {
-		orderID := fmt.Sprintf("testOrder%d", i)
-		k.AppendAtomicOrder(ctx, types.Order{
-			Id:              orderID,
-			Side:            types.NATIVE,
-			Status:          types.Status_INITIAL,
-			Path:            "path" + strconv.FormatUint(i, 10),
-			Maker:           nil,
-			CancelTimestamp: int64(i),
-		})
-	}
-
-	// Ensure we have MaxItems + 100 orders
-	suite.Require().Equal(uint64(types.MaxOrderCount+100), k.GetAtomicOrderCount(ctx))
-
-	// Trim excess
-	k.TrimExcessOrders(ctx)
-
-	// Validate only MaxItems are present now
-	suite.Require().Equal(uint64(types.MaxOrderCount), k.GetAtomicOrderCount(ctx))
-}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+	ibctesting "github.com/sideprotocol/ibcswap/v6/testing"
+)
+
+func (suite *KeeperTestSuite) TestAuctionTakeOnMakerChain() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	maker := suite.chainA.SenderAccount.GetAddress()
+	taker := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))
+	buyToken := sdk.NewCoin("osmo", sdk.NewInt(1000))
+	escrowAddr := types.GetEscrowAddress(types.PortID, ibctesting.FirstChannelID)
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, maker, escrowAddr, sdk.NewCoins(sellToken)))
+
+	makeMsg := types.NewMsgMakeSwap(
+		types.PortID, ibctesting.FirstChannelID,
+		sellToken, buyToken,
+		maker.String(), maker.String(), "",
+		suite.chainB.GetTimeoutHeight(), 0,
+		ctx.BlockTime().Unix(),
+	)
+	makeMsg.PriceSchedule = &types.PriceSchedule{
+		StartBuyAmount: buyToken.Amount,
+		FloorBuyAmount: sdk.NewInt(400),
+		DecayWindow:    100,
+	}
+	suite.Require().NoError(makeMsg.ValidateBasic())
+	k.AppendAtomicOrder(ctx, types.Order{
+		Id:     "order",
+		Side:   types.NATIVE,
+		Status: types.Status_SYNC,
+		Maker:  makeMsg,
+	})
+
+	res, err := k.GetOrderPrice(sdk.WrapSDKContext(ctx), &types.QueryOrderPriceRequest{OrderId: "order"})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Auction)
+	suite.Require().Equal(buyToken, res.Price)
+
+	// half of the decay window has elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(50 * time.Second))
+	res, err = k.GetOrderPrice(sdk.WrapSDKContext(ctx), &types.QueryOrderPriceRequest{OrderId: "order"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin("osmo", sdk.NewInt(700)), res.Price)
+
+	packet := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: ibctesting.FirstChannelID}
+	take := &types.TakeSwapMsg{
+		OrderId:               "order",
+		SellToken:             sdk.NewCoin("osmo", sdk.NewInt(650)),
+		TakerAddress:          taker.String(),
+		TakerReceivingAddress: taker.String(),
+	}
+	_, err = k.OnReceivedTake(ctx, packet, take)
+	suite.Require().ErrorIs(err, types.ErrOrderInsufficientAmount)
+
+	takerBalance := bankKeeper.GetBalance(ctx, taker, sdk.DefaultBondDenom)
+
+	// the take is charged the current price only
+	take.SellToken = sdk.NewCoin("osmo", sdk.NewInt(800))
+	takeRes, err := k.OnReceivedTake(ctx, packet, take)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin("osmo", sdk.NewInt(700)), takeRes.PaidToken)
+
+	order, found := k.GetAtomicOrder(ctx, "order")
+	suite.Require().True(found)
+	suite.Require().Equal(types.Status_COMPLETE, order.Status)
+	suite.Require().Equal(takeRes.PaidToken, order.Takers.SellToken)
+	suite.Require().Equal(takerBalance.Add(sellToken), bankKeeper.GetBalance(ctx, taker, sdk.DefaultBondDenom))
+	suite.Require().True(bankKeeper.GetBalance(ctx, escrowAddr, sdk.DefaultBondDenom).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestAuctionTakeOnTakerChain() {
+	ctx := suite.chainB.GetContext()
+	k := suite.chainB.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainB.GetSimApp().BankKeeper
+
+	maker := suite.chainB.SenderAccounts[0].SenderAccount.GetAddress()
+	taker := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+
+	take := &types.TakeSwapMsg{
+		OrderId:               "order",
+		SellToken:             sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(800)),
+		TakerAddress:          taker.String(),
+		TakerReceivingAddress: taker.String(),
+	}
+	k.AppendAtomicOrder(ctx, types.Order{
+		Id:     "order",
+		Side:   types.REMOTE,
+		Status: types.Status_SYNC,
+		Maker: &types.MakeSwapMsg{
+			SellToken:             sdk.NewCoin("atom", sdk.NewInt(100)),
+			BuyToken:              sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+			MakerReceivingAddress: maker.String(),
+			PriceSchedule: &types.PriceSchedule{
+				StartBuyAmount: sdk.NewInt(1000),
+				FloorBuyAmount: sdk.NewInt(400),
+				DecayWindow:    100,
+			},
+		},
+		Takers: take,
+	})
+	escrowAddr := types.GetEscrowAddress(types.PortID, ibctesting.FirstChannelID)
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, taker, escrowAddr, sdk.NewCoins(take.SellToken)))
+
+	makerBalance := bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom)
+	takerBalance := bankKeeper.GetBalance(ctx, taker, sdk.DefaultBondDenom)
+
+	// the maker chain charged a lower price than the locked amount
+	paid := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(700))
+	ackData, err := types.ModuleCdc.MarshalJSON(&types.MsgTakeSwapResponse{OrderId: "order", PaidToken: paid})
+	suite.Require().NoError(err)
+	takeData, err := types.ModuleCdc.MarshalJSON(take)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: ibctesting.FirstChannelID}
+	err = k.OnAcknowledgementPacket(ctx, packet, &types.AtomicSwapPacketData{Type: types.TAKE_SWAP, Data: takeData}, channeltypes.NewResultAcknowledgement(ackData))
+	suite.Require().NoError(err)
+
+	// the maker is paid the price and the overpayment is refunded to the taker
+	suite.Require().Equal(makerBalance.Add(paid), bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom))
+	suite.Require().Equal(takerBalance.Add(take.SellToken.Sub(paid)), bankKeeper.GetBalance(ctx, taker, sdk.DefaultBondDenom))
+	suite.Require().True(bankKeeper.GetBalance(ctx, escrowAddr, sdk.DefaultBondDenom).IsZero())
+
+	order, found := k.GetAtomicOrder(ctx, "order")
+	suite.Require().True(found)
+	suite.Require().Equal(types.Status_COMPLETE, order.Status)
+	suite.Require().Equal(paid, order.Takers.SellToken)
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+	ibctesting "github.com/sideprotocol/ibcswap/v6/testing"
+)
+
+func (suite *KeeperTestSuite) TestBasketOrder() {
+	path := NewSwapPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+	basketPath := NewSwapPath(suite.chainA, suite.chainB)
+	basketPath.EndpointA.ChannelConfig.Version = types.VersionBasket
+	basketPath.EndpointB.ChannelConfig.Version = types.VersionBasket
+	basketPath.EndpointA.ClientID, basketPath.EndpointA.ConnectionID = path.EndpointA.ClientID, path.EndpointA.ConnectionID
+	basketPath.EndpointB.ClientID, basketPath.EndpointB.ConnectionID = path.EndpointB.ClientID, path.EndpointB.ConnectionID
+	suite.coordinator.CreateChannels(basketPath)
+	suite.Require().Equal(types.VersionBasket, basketPath.EndpointA.GetChannel().Version)
+	suite.Require().Equal(types.VersionBasket, basketPath.EndpointB.GetChannel().Version)
+
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	maker := suite.chainA.SenderAccount.GetAddress()
+	taker := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	sellTokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)), sdk.NewCoin("uatom", sdk.NewInt(500)))
+	buyTokens := sdk.NewCoins(sdk.NewCoin("osmo", sdk.NewInt(300)), sdk.NewCoin("usdc", sdk.NewInt(200)))
+	suite.Require().NoError(bankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sellTokens[1])))
+	suite.Require().NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, maker, sdk.NewCoins(sellTokens[1])))
+
+	makeMsg := func(channelID string) *types.MakeSwapMsg {
+		msg := types.NewMsgMakeSwap(
+			types.PortID, channelID,
+			sdk.Coin{}, sdk.Coin{},
+			maker.String(), maker.String(), "",
+			suite.chainB.GetTimeoutHeight(), 0,
+			ctx.BlockTime().Unix(),
+		)
+		msg.SellTokens = sellTokens
+		msg.BuyTokens = buyTokens
+		return msg
+	}
+
+	// the counterparty of a channel without basket support cannot settle basket orders
+	_, err := k.MakeSwap(sdk.WrapSDKContext(ctx), makeMsg(path.EndpointA.ChannelID))
+	suite.Require().ErrorIs(err, types.ErrInvalidVersion)
+
+	escrowAddr := types.GetEscrowAddress(types.PortID, basketPath.EndpointA.ChannelID)
+	res, err := k.MakeSwap(sdk.WrapSDKContext(ctx), makeMsg(basketPath.EndpointA.ChannelID))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sellTokens, bankKeeper.GetAllBalances(ctx, escrowAddr))
+
+	// the order is listed under every pair of the basket
+	for _, pair := range [][2]string{{sdk.DefaultBondDenom, "osmo"}, {"uatom", "usdc"}} {
+		orders, err := k.GetOrdersByPair(sdk.WrapSDKContext(ctx), &types.QueryOrdersByPairRequest{SellDenom: pair[0], BuyDenom: pair[1]})
+		suite.Require().NoError(err)
+		suite.Require().Len(orders.Orders, 1)
+	}
+
+	order, found := k.GetAtomicOrder(ctx, res.OrderId)
+	suite.Require().True(found)
+	order.Status = types.Status_SYNC
+	k.SetAtomicOrder(ctx, order)
+
+	packet := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: basketPath.EndpointA.ChannelID}
+	take := &types.TakeSwapMsg{
+		OrderId:               res.OrderId,
+		SellTokens:            sdk.NewCoins(buyTokens[0]),
+		TakerAddress:          taker.String(),
+		TakerReceivingAddress: taker.String(),
+	}
+	// a basket is taken as a whole
+	_, err = k.OnReceivedTake(ctx, packet, take)
+	suite.Require().ErrorIs(err, types.ErrInvalidSellToken)
+
+	takerBalances := bankKeeper.GetAllBalances(ctx, taker)
+	take.SellTokens = buyTokens
+	_, err = k.OnReceivedTake(ctx, packet, take)
+	suite.Require().NoError(err)
+
+	order, found = k.GetAtomicOrder(ctx, res.OrderId)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Status_COMPLETE, order.Status)
+	suite.Require().True(order.RemainingSellTokens().IsZero())
+	suite.Require().Equal(takerBalances.Add(sellTokens...), bankKeeper.GetAllBalances(ctx, taker))
+	suite.Require().True(bankKeeper.GetAllBalances(ctx, escrowAddr).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestBasketTakeAckSingleBuyToken() {
+	ctx := suite.chainB.GetContext()
+	k := suite.chainB.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainB.GetSimApp().BankKeeper
+
+	maker := suite.chainB.SenderAccounts[0].SenderAccount.GetAddress()
+	taker := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+
+	// a basket buying a single coin is taken with the sell token of the take
+	take := &types.TakeSwapMsg{
+		OrderId:               "basket",
+		SellToken:             sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(300)),
+		TakerAddress:          taker.String(),
+		TakerReceivingAddress: taker.String(),
+	}
+	k.AppendAtomicOrder(ctx, types.Order{
+		Id:     "basket",
+		Side:   types.REMOTE,
+		Status: types.Status_SYNC,
+		Maker: &types.MakeSwapMsg{
+			SellTokens:            sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100)), sdk.NewCoin("osmo", sdk.NewInt(200))),
+			BuyTokens:             sdk.NewCoins(take.SellToken),
+			MakerReceivingAddress: maker.String(),
+		},
+		Takers: take,
+	})
+	escrowAddr := types.GetEscrowAddress(types.PortID, ibctesting.FirstChannelID)
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, taker, escrowAddr, sdk.NewCoins(take.SellToken)))
+
+	makerBalance := bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom)
+	takeData, err := types.ModuleCdc.MarshalJSON(take)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: ibctesting.FirstChannelID}
+	err = k.OnAcknowledgementPacket(ctx, packet, &types.AtomicSwapPacketData{Type: types.TAKE_SWAP, Data: takeData}, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(makerBalance.Add(take.SellToken), bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom))
+	suite.Require().True(bankKeeper.GetBalance(ctx, escrowAddr, sdk.DefaultBondDenom).IsZero())
+}
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// SetBid stores a bid and indexes it by its bidder.
+func (k Keeper) SetBid(ctx sdk.Context, bid types.Bid) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OrderIndexPrefix(types.BidKey, bid.OrderId, bid.Bidder), k.cdc.MustMarshal(&bid))
+	store.Set(types.OrderIndexPrefix(types.BidBidderIndexKey, bid.Bidder, bid.OrderId), []byte(bid.OrderId))
+}
+
+// GetBid returns the bid of a bidder on an order.
+func (k Keeper) GetBid(ctx sdk.Context, orderId, bidder string) (val types.Bid, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.OrderIndexPrefix(types.BidKey, orderId, bidder))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// IterateOrderBids iterates over the bids of an order and calls cb for each of them until cb returns true.
+func (k Keeper) IterateOrderBids(ctx sdk.Context, orderId string, cb func(bid types.Bid) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderIndexPrefix(types.BidKey, orderId))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.Bid
+		k.cdc.MustUnmarshal(iterator.Value(), &bid)
+		if cb(bid) {
+			break
+		}
+	}
+}
+
+// removeOrderBids deletes the bids of an order and their bidder index.
+func (k Keeper) removeOrderBids(ctx sdk.Context, orderId string) {
+	var bids []types.Bid
+	k.IterateOrderBids(ctx, orderId, func(bid types.Bid) bool {
+		bids = append(bids, bid)
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, bid := range bids {
+		store.Delete(types.OrderIndexPrefix(types.BidKey, bid.OrderId, bid.Bidder))
+		store.Delete(types.OrderIndexPrefix(types.BidBidderIndexKey, bid.Bidder, bid.OrderId))
+	}
+}
+
+// GetAllBids returns the bids of all the orders.
+func (k Keeper) GetAllBids(ctx sdk.Context) (list []types.Bid) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.Bid
+		k.cdc.MustUnmarshal(iterator.Value(), &bid)
+		list = append(list, bid)
+	}
+	return
+}
+
+// hasAcceptedBid returns true if the settlement of an accepted bid on the order is in flight.
+func (k Keeper) hasAcceptedBid(ctx sdk.Context, orderId string) bool {
+	accepted := false
+	k.IterateOrderBids(ctx, orderId, func(bid types.Bid) bool {
+		accepted = bid.Status == types.BID_ACCEPTED
+		return accepted
+	})
+	return accepted
+}
+
+// refundOrderBids closes the open bids of an order which has been completed, cancelled or expired,
+// except the bid of the given bidder. The bids are locked on the taker chain, so the tokens are
+// only refunded on the remote side of the order.
+func (k Keeper) refundOrderBids(ctx sdk.Context, order types.Order, except string, reason string) error {
+	var bids []types.Bid
+	k.IterateOrderBids(ctx, order.Id, func(bid types.Bid) bool {
+		if bid.Bidder != except && bid.IsOpen() {
+			bids = append(bids, bid)
+		}
+		return false
+	})
+
+	for _, bid := range bids {
+		if order.Side == types.REMOTE {
+			if err := k.refundBid(ctx, order, bid, reason); err != nil {
+				return err
+			}
+		}
+		bid.Status = types.BID_REFUNDED
+		k.SetBid(ctx, bid)
+		emitBidEvent(ctx, types.EventValueActionRefundBid, bid)
+	}
+	return nil
+}
+
+// refundBid sends the tokens of a bid back from the escrow account to the bidder.
+func (k Keeper) refundBid(ctx sdk.Context, order types.Order, bid types.Bid, reason string) error {
+	bidderAddr, err := sdk.AccAddressFromBech32(bid.Bidder)
+	if err != nil {
+		return err
+	}
+	refund := sdk.NewCoins(bid.Amount)
+	if err := k.refundFromEscrow(ctx, extractSourcePortForTakerMsg(order.Path), extractSourceChannelForTakerMsg(order.Path), bidderAddr, refund); err != nil {
+		return err
+	}
+	emitOrderRefunded(ctx, order, bid.Bidder, refund, reason)
+	return nil
+}
+
+// completeOrderWithBid closes an order settled by an accepted bid. The bid is recorded as the taker of the order.
+func (k Keeper) completeOrderWithBid(ctx sdk.Context, order types.Order, bid types.Bid, completeTimestamp int64) error {
+	bid.Status = types.BID_COMPLETE
+	k.SetBid(ctx, bid)
+
+	order.Status = types.Status_COMPLETE
+	order.Takers = &types.TakeSwapMsg{
+		OrderId:               order.Id,
+		SellToken:             bid.Amount,
+		TakerAddress:          bid.Bidder,
+		TakerReceivingAddress: bid.BidderReceivingAddress,
+		CreateTimestamp:       completeTimestamp,
+	}
+	order.CompleteTimestamp = statusTimestamp(ctx, completeTimestamp)
+	k.SetAtomicOrder(ctx, order)
+	// Move Completed assets to bottom
+	k.MoveOrderToBottom(ctx, order.Id)
+	emitOrderCompleted(ctx, order)
+
+	return k.refundOrderBids(ctx, order, bid.Bidder, types.ReasonOrderCompleted)
+}
+
+// validateBid checks that a bid can be placed on the order.
+func (k Keeper) validateBid(ctx sdk.Context, order types.Order, bidder string, amount sdk.Coin) error {
+	if order.Status != types.Status_SYNC {
+		return types.ErrInvalidOrderStatus
+	}
+	if order.Maker.IsExpired(ctx.BlockTime().Unix()) {
+		return types.ErrOrderExpired
+	}
+	if order.Maker.AllowPartialFill {
+		return types.ErrBidNotAllowed
+	}
+	if order.Maker.IsBasket() {
+		return types.ErrBasketNotSupported
+	}
+	if order.Maker.BuyToken.Denom != amount.Denom {
+		return types.ErrOrderDenominationMismatched
+	}
+	// If `desiredTaker` is set, only the desiredTaker can bid on the order.
+	if order.Maker.DesiredTaker != "" && order.Maker.DesiredTaker != bidder {
+		return types.ErrInvalidTakerAddress
+	}
+	if bid, found := k.GetBid(ctx, order.Id, bidder); found && bid.IsOpen() {
+		return types.ErrBidAlreadyExists
+	}
+	return nil
+}
+
+func emitBidEvent(ctx sdk.Context, action string, bid types.Bid) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.Attribute{
+				Key:   types.AttributeAction,
+				Value: action,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeOrderId,
+				Value: bid.OrderId,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeBidder,
+				Value: bid.Bidder,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeName,
+				Value: types.EventOwner,
+			},
+		),
+	)
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+func (suite *KeeperTestSuite) TestBidOnTakerChain() {
+	path := NewSwapPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := suite.chainB.GetContext()
+	k := suite.chainB.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainB.GetSimApp().BankKeeper
+
+	maker := suite.chainB.SenderAccounts[0].SenderAccount.GetAddress()
+	bidder1 := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	bidder2 := suite.chainB.SenderAccounts[2].SenderAccount.GetAddress()
+
+	orderPath := fmt.Sprintf("channel/%s/port/%s/channel/%s/port/%s/%s",
+		path.EndpointA.ChannelID, path.EndpointA.ChannelConfig.PortID,
+		path.EndpointB.ChannelID, path.EndpointB.ChannelConfig.PortID, "bid")
+	k.AppendAtomicOrder(ctx, types.Order{
+		Id:     "order",
+		Side:   types.REMOTE,
+		Status: types.Status_SYNC,
+		Path:   orderPath,
+		Maker: &types.MakeSwapMsg{
+			SellToken:             sdk.NewCoin("atom", sdk.NewInt(100)),
+			BuyToken:              sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+			MakerReceivingAddress: maker.String(),
+		},
+	})
+
+	escrowAddr := types.GetEscrowAddress(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	bid1 := types.NewMsgMakeBid("order", sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(90)), bidder1.String(), bidder1.String(), suite.chainA.GetTimeoutHeight(), 0, 0)
+	bid2 := types.NewMsgMakeBid("order", sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(80)), bidder2.String(), bidder2.String(), suite.chainA.GetTimeoutHeight(), 0, 0)
+	for _, msg := range []*types.MakeBidMsg{bid1, bid2} {
+		_, err := k.MakeBid(sdk.WrapSDKContext(ctx), msg)
+		suite.Require().NoError(err)
+	}
+	suite.Require().Equal(sdk.NewInt(170), bankKeeper.GetBalance(ctx, escrowAddr, sdk.DefaultBondDenom).Amount)
+
+	// a bidder can not have two open bids on the same order
+	_, err := k.MakeBid(sdk.WrapSDKContext(ctx), bid1)
+	suite.Require().ErrorIs(err, types.ErrBidAlreadyExists)
+
+	// the maker chain recorded the first bid
+	bidData, err := types.ModuleCdc.MarshalJSON(bid1)
+	suite.Require().NoError(err)
+	sentPacket := channeltypes.Packet{SourcePort: path.EndpointB.ChannelConfig.PortID, SourceChannel: path.EndpointB.ChannelID}
+	err = k.OnAcknowledgementPacket(ctx, sentPacket, &types.AtomicSwapPacketData{Type: types.MAKE_BID, Data: bidData}, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	suite.Require().NoError(err)
+	bid, found := k.GetBid(ctx, "order", bidder1.String())
+	suite.Require().True(found)
+	suite.Require().Equal(types.BID_PLACED, bid.Status)
+
+	bidder2Balance := bankKeeper.GetBalance(ctx, bidder2, sdk.DefaultBondDenom)
+	makerBalance := bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom)
+
+	// the maker accepted the first bid
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	recvPacket := channeltypes.Packet{DestinationPort: path.EndpointB.ChannelConfig.PortID, DestinationChannel: path.EndpointB.ChannelID}
+	_, err = k.OnReceivedAcceptBid(ctx, recvPacket, types.NewMsgAcceptBid("order", maker.String(), bidder1.String(), suite.chainB.GetTimeoutHeight(), 0, 0))
+	suite.Require().NoError(err)
+
+	order, found := k.GetAtomicOrder(ctx, "order")
+	suite.Require().True(found)
+	suite.Require().Equal(types.Status_COMPLETE, order.Status)
+	suite.Require().Equal(bidder1.String(), order.Takers.TakerAddress)
+
+	// the accepted bid is paid to the maker and the other bid is refunded
+	suite.Require().Equal(makerBalance.Add(bid1.Amount), bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom))
+	suite.Require().Equal(bidder2Balance.Add(bid2.Amount), bankKeeper.GetBalance(ctx, bidder2, sdk.DefaultBondDenom))
+	suite.Require().True(bankKeeper.GetBalance(ctx, escrowAddr, sdk.DefaultBondDenom).IsZero())
+
+	// the refund of the other bid is reported with the refunds of the orders
+	suite.Require().Contains(suite.typedEvents(ctx), &types.EventOrderRefunded{
+		OrderId:  "order",
+		Path:     orderPath,
+		Side:     types.REMOTE,
+		Status:   types.Status_COMPLETE,
+		Receiver: bidder2.String(),
+		Tokens:   sdk.NewCoins(bid2.Amount),
+		Reason:   types.ReasonOrderCompleted,
+	})
+
+	res, err := k.GetBidsByOrder(sdk.WrapSDKContext(ctx), &types.QueryBidsByOrderRequest{OrderId: "order"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Bids, 2)
+
+	res, err = k.GetBidsByBidder(sdk.WrapSDKContext(ctx), &types.QueryBidsByBidderRequest{Bidder: bidder2.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Bids, 1)
+	suite.Require().Equal(types.BID_REFUNDED, res.Bids[0].Status)
+}
+
+func (suite *KeeperTestSuite) TestBidOnMakerChain() {
+	path := NewSwapPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	maker := suite.chainA.SenderAccount.GetAddress()
+	bidder := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	escrowAddr := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, maker, escrowAddr, sdk.NewCoins(sellToken)))
+
+	k.AppendAtomicOrder(ctx, types.Order{
+		Id:     "order",
+		Side:   types.NATIVE,
+		Status: types.Status_SYNC,
+		Maker: &types.MakeSwapMsg{
+			SourcePort:    path.EndpointA.ChannelConfig.PortID,
+			SourceChannel: path.EndpointA.ChannelID,
+			SellToken:     sellToken,
+			BuyToken:      sdk.NewCoin("osmo", sdk.NewInt(100)),
+			MakerAddress:  maker.String(),
+		},
+	})
+
+	recvPacket := channeltypes.Packet{DestinationPort: path.EndpointA.ChannelConfig.PortID, DestinationChannel: path.EndpointA.ChannelID}
+	_, err := k.OnReceivedMakeBid(ctx, recvPacket, types.NewMsgMakeBid("order", sdk.NewCoin("osmo", sdk.NewInt(90)), bidder.String(), bidder.String(), suite.chainA.GetTimeoutHeight(), 0, 0))
+	suite.Require().NoError(err)
+
+	// only the maker can accept a bid
+	acceptMsg := types.NewMsgAcceptBid("order", maker.String(), bidder.String(), suite.chainB.GetTimeoutHeight(), 0, 0)
+	_, err = k.AcceptBid(sdk.WrapSDKContext(ctx), types.NewMsgAcceptBid("order", bidder.String(), bidder.String(), suite.chainB.GetTimeoutHeight(), 0, 0))
+	suite.Require().ErrorIs(err, types.ErrOrderPermissionIsNotAllowed)
+	_, err = k.AcceptBid(sdk.WrapSDKContext(ctx), acceptMsg)
+	suite.Require().NoError(err)
+
+	// the order is reserved for the accepted bid
+	_, err = k.OnReceivedTake(ctx, recvPacket, &types.TakeSwapMsg{
+		OrderId:               "order",
+		SellToken:             sdk.NewCoin("osmo", sdk.NewInt(100)),
+		TakerAddress:          bidder.String(),
+		TakerReceivingAddress: bidder.String(),
+	})
+	suite.Require().ErrorIs(err, types.ErrOrderBidAccepted)
+
+	// the taker chain settled the bid
+	bidderBalance := bankKeeper.GetBalance(ctx, bidder, sdk.DefaultBondDenom)
+	acceptData, err := types.ModuleCdc.MarshalJSON(acceptMsg)
+	suite.Require().NoError(err)
+	sentPacket := channeltypes.Packet{SourcePort: path.EndpointA.ChannelConfig.PortID, SourceChannel: path.EndpointA.ChannelID}
+	err = k.OnAcknowledgementPacket(ctx, sentPacket, &types.AtomicSwapPacketData{Type: types.ACCEPT_BID, Data: acceptData}, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(bidderBalance.Add(sellToken), bankKeeper.GetBalance(ctx, bidder, sdk.DefaultBondDenom))
+	order, found := k.GetAtomicOrder(ctx, "order")
+	suite.Require().True(found)
+	suite.Require().Equal(types.Status_COMPLETE, order.Status)
+	bid, found := k.GetBid(ctx, "order", bidder.String())
+	suite.Require().True(found)
+	suite.Require().Equal(types.BID_COMPLETE, bid.Status)
+}
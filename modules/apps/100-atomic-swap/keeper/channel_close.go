@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// CancelClosedChannelOrders cancels the open orders settled over a closed channel, since they can not be
+// cancelled with a packet round trip anymore. On the maker chain the unfilled sell token is refunded
+// to the maker from the channel escrow, on the taker chain the open bids are refunded.
+// Orders with a take or an accepted bid in flight are left to the timeout of their packet.
+func (k Keeper) CancelClosedChannelOrders(ctx sdk.Context, portID, channelID string) []string {
+	var orders []types.Order
+	for _, status := range []types.Status{types.Status_INITIAL, types.Status_SYNC} {
+		k.iterateOrdersByStatus(ctx, status, func(order types.Order) bool {
+			if order.Kind != types.IBCOrder {
+				return false
+			}
+			if port, channel, ok := orderLocalChannel(order); ok && port == portID && channel == channelID {
+				orders = append(orders, order)
+			}
+			return false
+		})
+	}
+
+	var cancelled []string
+	for _, order := range orders {
+		// the refunds of an order are applied all together or not at all
+		cacheCtx, write := ctx.CacheContext()
+		ok, err := k.cancelChannelOrder(cacheCtx, order)
+		if err != nil {
+			k.Logger(ctx).Error("failed to cancel order of closed channel", "order_id", order.Id, "error", err)
+			continue
+		}
+		if ok {
+			write()
+			cancelled = append(cancelled, order.Id)
+		}
+	}
+	return cancelled
+}
+
+func (k Keeper) cancelChannelOrder(ctx sdk.Context, order types.Order) (bool, error) {
+	var refund sdk.Coins
+	switch order.Side {
+	case types.NATIVE:
+		// the order is resolved by the timeout of the accepted bid.
+		if k.hasAcceptedBid(ctx, order.Id) {
+			return false, nil
+		}
+		// refund the locked sell token to the maker on the maker chain.
+		makerAddr, err := sdk.AccAddressFromBech32(order.Maker.MakerAddress)
+		if err != nil {
+			return false, err
+		}
+		refund = order.RemainingSellTokens()
+		if err := k.refundFromEscrow(ctx, order.Maker.SourcePort, order.Maker.SourceChannel, makerAddr, refund); err != nil {
+			return false, err
+		}
+	case types.REMOTE:
+		// a take is in flight, the order is resolved by the timeout of the take.
+		if order.Takers != nil {
+			return false, nil
+		}
+	}
+
+	order.Status = types.Status_CANCEL
+	order.CancelTimestamp = ctx.BlockTime().Unix()
+	k.SetAtomicOrder(ctx, order)
+
+	if err := k.refundOrderBids(ctx, order, "", types.ReasonChannelClosed); err != nil {
+		return false, err
+	}
+	if order.Side == types.NATIVE {
+		emitOrderRefunded(ctx, order, order.Maker.MakerAddress, refund, types.ReasonChannelClosed)
+	}
+	emitOrderCancelled(ctx, order, types.ReasonChannelClosed)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.Attribute{
+				Key: types.AttributeAction,
+				Value: types.GetEventValueWithSuffix(
+					types.EventValueActionCancelOrder, types.EventValueSuffixChannelClosed,
+				),
+			},
+			sdk.Attribute{
+				Key:   types.AttributeOrderId,
+				Value: order.Id,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeName,
+				Value: types.EventOwner,
+			},
+		),
+	)
+	return true, nil
+}
+
+// iterateOrdersByStatus iterates over the orders in the given status and calls cb for each of them until cb returns true.
+func (k Keeper) iterateOrdersByStatus(ctx sdk.Context, status types.Status, cb func(order types.Order) (stop bool)) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderStatusIndexPrefix(status))
+	orderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderBookKey)
+	iterator := sdk.KVStorePrefixIterator(indexStore, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		bz := orderStore.Get(iterator.Key())
+		if bz == nil {
+			continue
+		}
+		if cb(k.MustUnmarshalOrder(bz)) {
+			break
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+func (suite *KeeperTestSuite) TestCancelChannelOrders() {
+	path := NewSwapPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+	ctx := suite.chainA.GetContext()
+
+	maker := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress()
+	taker := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+	escrowAddr := types.GetEscrowAddress(portID, channelID)
+
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, maker, escrowAddr, sdk.NewCoins(sellToken)))
+	makerBalance := bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom)
+
+	makeMsg := types.NewMsgMakeSwap(portID, channelID, sellToken, sdk.NewCoin("osmo", sdk.NewInt(10)), maker.String(), maker.String(), "", suite.chainB.GetTimeoutHeight(), 0, 0)
+	k.AppendAtomicOrder(ctx, types.Order{Id: "native", Side: types.NATIVE, Status: types.Status_SYNC, Maker: makeMsg})
+
+	// an order of another channel and a remote order with a take in flight are kept open
+	otherMsg := *makeMsg
+	otherMsg.SourceChannel = "channel-100"
+	k.AppendAtomicOrder(ctx, types.Order{Id: "other", Side: types.NATIVE, Status: types.Status_SYNC, Maker: &otherMsg})
+	remotePath := fmt.Sprintf("channel/%s/port/%s/channel/%s/port/%s/%s",
+		path.EndpointB.ChannelID, path.EndpointB.ChannelConfig.PortID, channelID, portID, "remote")
+	k.AppendAtomicOrder(ctx, types.Order{
+		Id:     "remote",
+		Side:   types.REMOTE,
+		Status: types.Status_SYNC,
+		Path:   remotePath,
+		Maker:  makeMsg,
+		Takers: &types.TakeSwapMsg{OrderId: "remote", TakerAddress: taker.String()},
+	})
+
+	msg := types.NewMsgCancelChannelOrders(taker.String(), portID, channelID)
+	_, err := k.CancelChannelOrders(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrChannelNotClosed)
+
+	suite.Require().NoError(path.EndpointA.SetChannelClosed())
+	ctx = suite.chainA.GetContext()
+
+	// anyone can cancel the orders of a closed channel
+	res, err := k.CancelChannelOrders(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"native"}, res.OrderIds)
+
+	order, found := k.GetAtomicOrder(ctx, "native")
+	suite.Require().True(found)
+	suite.Require().Equal(types.Status_CANCEL, order.Status)
+	suite.Require().Equal(makerBalance.Add(sellToken), bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom))
+	suite.Require().True(bankKeeper.GetBalance(ctx, escrowAddr, sdk.DefaultBondDenom).IsZero())
+
+	for _, orderId := range []string{"other", "remote"} {
+		order, found := k.GetAtomicOrder(ctx, orderId)
+		suite.Require().True(found)
+		suite.Require().Equal(types.Status_SYNC, order.Status)
+	}
+
+	// cancelled orders are not refunded twice
+	res, err = k.CancelChannelOrders(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.OrderIds)
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// emitOrderCreated emits the typed event of an order created on this chain.
+func emitOrderCreated(ctx sdk.Context, order types.Order) {
+	ctx.EventManager().EmitTypedEvent(&types.EventOrderCreated{
+		OrderId:               order.Id,
+		Path:                  order.Path,
+		Side:                  order.Side,
+		Status:                order.Status,
+		Kind:                  order.Kind,
+		Maker:                 order.Maker.MakerAddress,
+		MakerReceivingAddress: order.Maker.MakerReceivingAddress,
+		DesiredTaker:          order.Maker.DesiredTaker,
+		SellTokens:            eventCoins(order.Maker.SellToken, order.Maker.SellTokens),
+		BuyTokens:             eventCoins(order.Maker.BuyToken, order.Maker.BuyTokens),
+		ExpirationTimestamp:   order.Maker.ExpirationTimestamp,
+	})
+}
+
+// emitOrderSynced emits the typed event of an order acknowledged by the taker chain.
+func emitOrderSynced(ctx sdk.Context, order types.Order) {
+	ctx.EventManager().EmitTypedEvent(&types.EventOrderSynced{
+		OrderId: order.Id,
+		Path:    order.Path,
+		Side:    order.Side,
+		Status:  order.Status,
+	})
+}
+
+// emitOrderTaken emits the typed event of a take paying the sell tokens of the take message
+// for the released sell tokens of the order.
+func emitOrderTaken(ctx sdk.Context, order types.Order, take *types.TakeSwapMsg, released sdk.Coins) {
+	ctx.EventManager().EmitTypedEvent(&types.EventOrderTaken{
+		OrderId:               order.Id,
+		Path:                  order.Path,
+		Side:                  order.Side,
+		Status:                order.Status,
+		Taker:                 take.TakerAddress,
+		TakerReceivingAddress: take.TakerReceivingAddress,
+		PaidTokens:            eventCoins(take.SellToken, take.SellTokens),
+		ReleasedTokens:        released,
+	})
+}
+
+// emitOrderCompleted emits the typed event of a filled order.
+func emitOrderCompleted(ctx sdk.Context, order types.Order) {
+	var taker string
+	if order.Takers != nil {
+		taker = order.Takers.TakerAddress
+	}
+	ctx.EventManager().EmitTypedEvent(&types.EventOrderCompleted{
+		OrderId:           order.Id,
+		Path:              order.Path,
+		Side:              order.Side,
+		Status:            order.Status,
+		Maker:             order.Maker.MakerAddress,
+		Taker:             taker,
+		CompleteTimestamp: order.CompleteTimestamp,
+	})
+}
+
+// emitOrderCancelled emits the typed event of an order closed without being filled.
+func emitOrderCancelled(ctx sdk.Context, order types.Order, reason string) {
+	ctx.EventManager().EmitTypedEvent(&types.EventOrderCancelled{
+		OrderId:         order.Id,
+		Path:            order.Path,
+		Side:            order.Side,
+		Status:          order.Status,
+		Maker:           order.Maker.MakerAddress,
+		CancelTimestamp: order.CancelTimestamp,
+		Reason:          reason,
+		AckError:        eventAckError(order, reason),
+	})
+}
+
+// emitOrderRefunded emits the typed event of locked tokens returned to their owner.
+func emitOrderRefunded(ctx sdk.Context, order types.Order, receiver string, tokens sdk.Coins, reason string) {
+	ctx.EventManager().EmitTypedEvent(&types.EventOrderRefunded{
+		OrderId:  order.Id,
+		Path:     order.Path,
+		Side:     order.Side,
+		Status:   order.Status,
+		Receiver: receiver,
+		Tokens:   tokens,
+		Reason:   reason,
+		AckError: eventAckError(order, reason),
+	})
+}
+
+// eventAckError returns the error acknowledgement recorded on the order when it is the reason of the event.
+func eventAckError(order types.Order, reason string) *types.ErrorAcknowledgement {
+	if reason != types.ReasonErrorAck {
+		return nil
+	}
+	return order.AckError
+}
+
+// emitOrdersArchived emits the typed event summarizing the orders pruned from the store.
+func emitOrdersArchived(ctx sdk.Context, orders []types.Order) {
+	event := &types.EventOrdersArchived{Orders: make([]types.ArchivedOrder, 0, len(orders))}
+	for _, order := range orders {
+		archived := types.ArchivedOrder{
+			OrderId:           order.Id,
+			Path:              order.Path,
+			Side:              order.Side,
+			Status:            order.Status,
+			Kind:              order.Kind,
+			CompleteTimestamp: order.CompleteTimestamp,
+			CancelTimestamp:   order.CancelTimestamp,
+		}
+		if order.Maker != nil {
+			archived.Maker = order.Maker.MakerAddress
+			archived.SellTokens = eventCoins(order.Maker.SellToken, order.Maker.SellTokens)
+			archived.BuyTokens = eventCoins(order.Maker.BuyToken, order.Maker.BuyTokens)
+		}
+		for _, fill := range order.Fills {
+			archived.Takers = append(archived.Takers, fill.TakerAddress)
+		}
+		if len(order.Fills) == 0 && order.Takers != nil {
+			archived.Takers = append(archived.Takers, order.Takers.TakerAddress)
+		}
+		event.Orders = append(event.Orders, archived)
+	}
+	ctx.EventManager().EmitTypedEvent(event)
+}
+
+// eventCoins returns the coins of an order or a take carried by the typed events, the coins
+// received from the counterparty chain are not sanitized so that the events never fail.
+func eventCoins(token sdk.Coin, basket sdk.Coins) sdk.Coins {
+	if len(basket) > 0 {
+		return basket
+	}
+	return sdk.Coins{token}
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsmod "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// typedEvents returns the typed atomic swap events emitted on the context.
+func (suite *KeeperTestSuite) typedEvents(ctx sdk.Context) []proto.Message {
+	var events []proto.Message
+	for _, event := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			continue
+		}
+		events = append(events, msg)
+	}
+	return events
+}
+
+func (suite *KeeperTestSuite) TestOrderLifecycleEvents() {
+	path := NewSwapPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+
+	maker := suite.chainA.SenderAccount.GetAddress()
+	taker := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	buyToken := sdk.NewCoin("osmo", sdk.NewInt(50))
+
+	makeOrder := func() (types.Order, *types.AtomicSwapPacketData) {
+		msg := types.NewMsgMakeSwap(
+			types.PortID, path.EndpointA.ChannelID,
+			sellToken, buyToken,
+			maker.String(), maker.String(), "",
+			suite.chainB.GetTimeoutHeight(), 0,
+			ctx.BlockTime().Unix(),
+		)
+		res, err := k.MakeSwap(sdk.WrapSDKContext(ctx), msg)
+		suite.Require().NoError(err)
+		order, found := k.GetAtomicOrder(ctx, res.OrderId)
+		suite.Require().True(found)
+		data, err := types.ModuleCdc.MarshalJSON(msg)
+		suite.Require().NoError(err)
+		return order, &types.AtomicSwapPacketData{Type: types.MAKE_SWAP, Data: data, OrderId: order.Id, Path: order.Path}
+	}
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: path.EndpointA.ChannelID}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	order, data := makeOrder()
+	suite.Require().Equal([]proto.Message{&types.EventOrderCreated{
+		OrderId:               order.Id,
+		Path:                  order.Path,
+		Side:                  types.NATIVE,
+		Status:                types.Status_INITIAL,
+		Maker:                 maker.String(),
+		MakerReceivingAddress: maker.String(),
+		SellTokens:            sdk.NewCoins(sellToken),
+		BuyTokens:             sdk.NewCoins(buyToken),
+	}}, suite.typedEvents(ctx))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ack := channeltypes.NewResultAcknowledgement([]byte(`{}`))
+	suite.Require().NoError(k.OnAcknowledgementPacket(ctx, packet, data, ack))
+	suite.Require().Equal([]proto.Message{&types.EventOrderSynced{
+		OrderId: order.Id,
+		Path:    order.Path,
+		Side:    types.NATIVE,
+		Status:  types.Status_SYNC,
+	}}, suite.typedEvents(ctx))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	take := &types.TakeSwapMsg{
+		OrderId:               order.Id,
+		SellToken:             buyToken,
+		TakerAddress:          taker.String(),
+		TakerReceivingAddress: taker.String(),
+	}
+	recvPacket := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: path.EndpointA.ChannelID}
+	_, err := k.OnReceivedTake(ctx, recvPacket, take)
+	suite.Require().NoError(err)
+	order, _ = k.GetAtomicOrder(ctx, order.Id)
+	suite.Require().Equal([]proto.Message{
+		&types.EventOrderTaken{
+			OrderId:               order.Id,
+			Path:                  order.Path,
+			Side:                  types.NATIVE,
+			Status:                types.Status_COMPLETE,
+			Taker:                 taker.String(),
+			TakerReceivingAddress: taker.String(),
+			PaidTokens:            sdk.NewCoins(buyToken),
+			ReleasedTokens:        sdk.NewCoins(sellToken),
+		},
+		&types.EventOrderCompleted{
+			OrderId:           order.Id,
+			Path:              order.Path,
+			Side:              types.NATIVE,
+			Status:            types.Status_COMPLETE,
+			Maker:             maker.String(),
+			Taker:             taker.String(),
+			CompleteTimestamp: order.CompleteTimestamp,
+		},
+	}, suite.typedEvents(ctx))
+
+	// the make packet of another order times out, the maker is refunded
+	order, data = makeOrder()
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(k.OnTimeoutPacket(ctx, packet, data))
+	order, _ = k.GetAtomicOrder(ctx, order.Id)
+	suite.Require().Equal([]proto.Message{
+		&types.EventOrderRefunded{
+			OrderId:  order.Id,
+			Path:     order.Path,
+			Side:     types.NATIVE,
+			Status:   types.Status_CANCEL,
+			Receiver: maker.String(),
+			Tokens:   sdk.NewCoins(sellToken),
+			Reason:   types.ReasonTimeout,
+		},
+		&types.EventOrderCancelled{
+			OrderId:         order.Id,
+			Path:            order.Path,
+			Side:            types.NATIVE,
+			Status:          types.Status_CANCEL,
+			Maker:           maker.String(),
+			CancelTimestamp: order.CancelTimestamp,
+			Reason:          types.ReasonTimeout,
+		},
+	}, suite.typedEvents(ctx))
+
+	// the make packet of another order is rejected, the error is recorded on the order
+	order, data = makeOrder()
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	errAck := types.NewErrorAcknowledgement(errorsmod.Wrapf(types.ErrOrderDoesNotExists, "order %s", order.Id))
+	suite.Require().NoError(k.OnAcknowledgementPacket(ctx, packet, data, errAck))
+	order, _ = k.GetAtomicOrder(ctx, order.Id)
+	ackError := &types.ErrorAcknowledgement{Codespace: types.ModuleName, Code: 12, Reason: "Make Order does not exist"}
+	suite.Require().Equal(ackError, order.AckError)
+	suite.Require().Equal([]proto.Message{
+		&types.EventOrderRefunded{
+			OrderId:  order.Id,
+			Path:     order.Path,
+			Side:     types.NATIVE,
+			Status:   types.Status_CANCEL,
+			Receiver: maker.String(),
+			Tokens:   sdk.NewCoins(sellToken),
+			Reason:   types.ReasonErrorAck,
+			AckError: ackError,
+		},
+		&types.EventOrderCancelled{
+			OrderId:         order.Id,
+			Path:            order.Path,
+			Side:            types.NATIVE,
+			Status:          types.Status_CANCEL,
+			Maker:           maker.String(),
+			CancelTimestamp: order.CancelTimestamp,
+			Reason:          types.ReasonErrorAck,
+			AckError:        ackError,
+		},
+	}, suite.typedEvents(ctx))
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// GetSwapFee returns the fee charged on the proceeds of a swap. The fee rate is capped by the max fee rate,
+// since both params can be changed independently by governance.
+func (k Keeper) GetSwapFee(ctx sdk.Context, proceeds sdk.Coin) sdk.Coin {
+	feeRate := k.GetSwapFeeRate(ctx)
+	if maxFeeRate := k.GetSwapMaxFeeRate(ctx); feeRate > maxFeeRate {
+		feeRate = maxFeeRate
+	}
+	amount := proceeds.Amount.MulRaw(int64(feeRate)).QuoRaw(types.FeeRateDenominator)
+	return sdk.NewCoin(proceeds.Denom, amount)
+}
+
+// sendProceeds releases the proceeds of a swap from the escrow account of a channel to the receiver.
+// The swap fee is deducted from the proceeds and sent to the fee collector.
+func (k Keeper) sendProceeds(ctx sdk.Context, portID, channelID string, receiver sdk.AccAddress, proceeds sdk.Coin) error {
+	if err := k.TrackEscrowOutflow(ctx, portID, channelID, sdk.NewCoins(proceeds)); err != nil {
+		return err
+	}
+	escrowAddr := types.GetEscrowAddress(portID, channelID)
+	fee := k.GetSwapFee(ctx, proceeds)
+	if fee.IsPositive() {
+		if err := k.collectFee(ctx, escrowAddr, fee); err != nil {
+			return err
+		}
+	}
+	return k.bankKeeper.SendCoins(ctx, escrowAddr, receiver, sdk.NewCoins(proceeds.Sub(fee)))
+}
+
+// collectFee sends the fee to the fee collector, or funds the community pool if no fee collector is set.
+func (k Keeper) collectFee(ctx sdk.Context, from sdk.AccAddress, fee sdk.Coin) error {
+	if collector := k.GetFeeCollector(ctx); collector != "" {
+		collectorAddr, err := sdk.AccAddressFromBech32(collector)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, from, collectorAddr, sdk.NewCoins(fee)); err != nil {
+			return err
+		}
+	} else if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(fee), from); err != nil {
+		return err
+	}
+
+	k.SetCollectedFee(ctx, k.GetCollectedFee(ctx, fee.Denom).Add(fee))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.Attribute{
+				Key:   types.AttributeAction,
+				Value: types.EventValueActionCollectFee,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeKeyAmount,
+				Value: fee.String(),
+			},
+			sdk.Attribute{
+				Key:   types.AttributeName,
+				Value: types.EventOwner,
+			},
+		),
+	)
+	return nil
+}
+
+// GetCollectedFee returns the total fees collected in the given denom.
+func (k Keeper) GetCollectedFee(ctx sdk.Context, denom string) sdk.Coin {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CollectedFeesKey)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+// SetCollectedFee sets the total fees collected in the denom of the given coin.
+func (k Keeper) SetCollectedFee(ctx sdk.Context, fee sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CollectedFeesKey)
+	bz, err := fee.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(fee.Denom), bz)
+}
+
+// GetAllCollectedFees returns the total fees collected in all denoms.
+func (k Keeper) GetAllCollectedFees(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CollectedFeesKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	fees := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		fees = fees.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return fees
+}
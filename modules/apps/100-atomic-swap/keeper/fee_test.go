@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+	ibctesting "github.com/sideprotocol/ibcswap/v6/testing"
+)
+
+func (suite *KeeperTestSuite) TestSwapFee() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	maker := suite.chainA.SenderAccount.GetAddress()
+	taker := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	collector := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+
+	// the fee rate is capped by the max fee rate
+	k.SetParams(ctx, types.NewParams(true, 100, 30, collector.String(), 0, 0, 0))
+	suite.Require().Equal(sdk.NewInt64Coin("atom", 3), k.GetSwapFee(ctx, sdk.NewInt64Coin("atom", 1000)))
+	suite.chainA.GetSimApp().GetSubspace(types.ModuleName).Set(ctx, types.KeySwapMaxFeeRate, uint32(10))
+	suite.Require().Equal(sdk.NewInt64Coin("atom", 1), k.GetSwapFee(ctx, sdk.NewInt64Coin("atom", 1000)))
+
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))
+	buyToken := sdk.NewCoin("osmo", sdk.NewInt(100))
+	escrowAddr := types.GetEscrowAddress(types.PortID, ibctesting.FirstChannelID)
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, maker, escrowAddr, sdk.NewCoins(sellToken)))
+
+	makeMsg := types.NewMsgMakeSwap(
+		types.PortID, ibctesting.FirstChannelID,
+		sellToken, buyToken,
+		maker.String(), maker.String(), "",
+		suite.chainB.GetTimeoutHeight(), 0,
+		ctx.BlockTime().Unix(),
+	)
+	k.AppendAtomicOrder(ctx, types.Order{
+		Id:     "order",
+		Side:   types.NATIVE,
+		Status: types.Status_SYNC,
+		Maker:  makeMsg,
+	})
+
+	takerBalance := bankKeeper.GetBalance(ctx, taker, sdk.DefaultBondDenom)
+	collectorBalance := bankKeeper.GetBalance(ctx, collector, sdk.DefaultBondDenom)
+
+	packet := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: ibctesting.FirstChannelID}
+	_, err := k.OnReceivedTake(ctx, packet, &types.TakeSwapMsg{
+		OrderId:               "order",
+		SellToken:             buyToken,
+		TakerAddress:          taker.String(),
+		TakerReceivingAddress: taker.String(),
+	})
+	suite.Require().NoError(err)
+
+	// the taker receives the sell token less the fee, the fee is sent to the collector
+	fee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))
+	suite.Require().Equal(takerBalance.Add(sellToken.Sub(fee)), bankKeeper.GetBalance(ctx, taker, sdk.DefaultBondDenom))
+	suite.Require().Equal(collectorBalance.Add(fee), bankKeeper.GetBalance(ctx, collector, sdk.DefaultBondDenom))
+	suite.Require().True(bankKeeper.GetBalance(ctx, escrowAddr, sdk.DefaultBondDenom).IsZero())
+
+	res, err := k.CollectedFees(sdk.WrapSDKContext(ctx), &types.QueryCollectedFeesRequest{Denom: sdk.DefaultBondDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(fee), res.Fees)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
@@ -24,12 +25,62 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	}
 
 	k.SetParams(ctx, state.Params)
+
+	// the orders keep their position in the order book, so that the order of the
+	// queries and the ids of the orders appended later do not change
+	for _, entry := range state.Orders {
+		k.setOrderAt(ctx, entry.Order, entry.Position)
+	}
+	k.SetAtomicOrderCount(ctx, state.OrderCount)
+	for _, bid := range state.Bids {
+		k.SetBid(ctx, bid)
+	}
+	for _, entry := range state.SwapLists {
+		k.SetListEntry(ctx, entry)
+	}
+	for _, usage := range state.RateLimits {
+		k.SetRateLimit(ctx, usage.RateLimit)
+		k.setRateLimitFlow(ctx, usage.RateLimit, usage.Flow)
+	}
+	for _, channel := range state.PausedChannels {
+		portID, channelID, err := types.ParseChannelListValue(channel)
+		if err != nil {
+			panic(err)
+		}
+		k.SetChannelPaused(ctx, portID, channelID, true)
+	}
+	for _, nonce := range state.SignedOrderNonces {
+		k.SetNonceUsed(ctx, nonce.Maker, nonce.Nonce)
+	}
 }
 
 // ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId: k.GetPort(ctx),
-		Params: k.GetParams(ctx),
+		PortId:            k.GetPort(ctx),
+		Params:            k.GetParams(ctx),
+		Orders:            k.getGenesisOrders(ctx),
+		OrderCount:        k.GetAtomicOrderCount(ctx),
+		Bids:              k.GetAllBids(ctx),
+		SwapLists:         k.GetAllListEntries(ctx),
+		RateLimits:        k.GetAllRateLimitUsages(ctx),
+		PausedChannels:    k.GetPausedChannels(ctx),
+		SignedOrderNonces: k.GetAllSignedOrderNonces(ctx),
+	}
+}
+
+// getGenesisOrders returns all the orders with their position in the order book.
+func (k Keeper) getGenesisOrders(ctx sdk.Context) []types.GenesisOrder {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderBookKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var orders []types.GenesisOrder
+	for ; iterator.Valid(); iterator.Next() {
+		orders = append(orders, types.GenesisOrder{
+			Position: GetBidIDFromBytes(iterator.Key()),
+			Order:    k.MustUnmarshalOrder(iterator.Value()),
+		})
 	}
+	return orders
 }
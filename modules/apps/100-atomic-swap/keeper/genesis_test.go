@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
 )
 
@@ -14,3 +16,72 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		suite.chainA.GetSimApp().AtomicSwapKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
 	})
 }
+
+func (suite *KeeperTestSuite) TestGenesisRoundTrip() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+
+	maker := suite.chainA.SenderAccount.GetAddress().String()
+	token := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	makeMsg := &types.MakeSwapMsg{
+		SourcePort:          types.PortID,
+		SourceChannel:       "channel-0",
+		SellToken:           token,
+		BuyToken:            sdk.NewCoin("osmo", sdk.NewInt(50)),
+		MakerAddress:        maker,
+		ExpirationTimestamp: uint64(ctx.BlockTime().Unix() + 3600),
+	}
+	now := ctx.BlockTime().Unix()
+	k.AppendAtomicOrder(ctx, types.Order{Id: "open", Side: types.NATIVE, Status: types.Status_SYNC, Maker: makeMsg})
+	k.AppendAtomicOrder(ctx, types.Order{Id: "completed", Side: types.REMOTE, Status: types.Status_COMPLETE, Maker: makeMsg, CompleteTimestamp: now})
+	k.AppendAtomicOrder(ctx, types.Order{Id: "cancelled", Side: types.NATIVE, Status: types.Status_CANCEL, Maker: makeMsg, CancelTimestamp: now})
+	// moving an order leaves a gap in the order book
+	suite.Require().NoError(k.MoveOrderToBottom(ctx, "completed"))
+	k.SetBid(ctx, types.Bid{OrderId: "open", Bidder: maker, Amount: token, Status: types.BID_PLACED})
+	k.SetListEntry(ctx, types.NewListEntry(types.DENYLIST, types.DenomEntry, "osmo"))
+	k.SetRateLimit(ctx, types.NewRateLimit(types.PortID, "channel-0", sdk.DefaultBondDenom, sdk.NewInt(1000), 3600))
+	suite.Require().NoError(k.TrackEscrowOutflow(ctx, types.PortID, "channel-0", sdk.NewCoins(token)))
+	k.SetChannelPaused(ctx, types.PortID, "channel-1", true)
+	k.SetNonceUsed(ctx, maker, 7)
+
+	exported := k.ExportGenesis(ctx)
+	suite.Require().NoError(exported.Validate())
+	suite.Require().Len(exported.Orders, 3)
+	suite.Require().Equal(uint64(4), exported.OrderCount)
+	suite.Require().Equal(uint64(3), exported.Orders[2].Position)
+
+	// import the state on a chain without orders
+	ctxB := suite.chainB.GetContext()
+	kB := suite.chainB.GetSimApp().AtomicSwapKeeper
+	kB.InitGenesis(ctxB, *exported)
+	suite.Require().Equal(exported, kB.ExportGenesis(ctxB))
+
+	// the indexes are rebuilt from the exported orders
+	suite.Require().Equal(k.GetTerminalOrderCount(ctx), kB.GetTerminalOrderCount(ctxB))
+	res, err := kB.GetOrdersByStatus(sdk.WrapSDKContext(ctxB), &types.QueryOrdersByStatusRequest{Status: types.Status_COMPLETE})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Orders, 1)
+	suite.Require().Equal("completed", res.Orders[0].Id)
+	bid, found := kB.GetBid(ctxB, "open", maker)
+	suite.Require().True(found)
+	suite.Require().Equal(types.BID_PLACED, bid.Status)
+
+	suite.Require().True(kB.HasListEntry(ctxB, types.NewListEntry(types.DENYLIST, types.DenomEntry, "osmo")))
+	suite.Require().True(kB.IsChannelPaused(ctxB, types.PortID, "channel-1"))
+	suite.Require().Equal([]types.SignedOrderNonce{{Maker: maker, Nonce: 7}}, exported.SignedOrderNonces)
+	suite.Require().True(kB.IsNonceUsed(ctxB, maker, 7))
+
+	var expiring []string
+	kB.IterateExpiredOrdersQueue(ctxB, makeMsg.ExpirationTimestamp, func(orderId string, _ uint64) bool {
+		expiring = append(expiring, orderId)
+		return false
+	})
+	suite.Require().Equal([]string{"open"}, expiring)
+
+	// unknown orders are not aliased to the first order of the order book
+	_, found = kB.GetAtomicOrder(ctxB, "unknown")
+	suite.Require().False(found)
+
+	// the next order is appended after the imported ones
+	suite.Require().Equal(uint64(4), kB.AppendAtomicOrder(ctxB, types.Order{Id: "new", Side: types.NATIVE, Maker: makeMsg}))
+}
@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -34,23 +37,15 @@ func (q Keeper) GetAllOrders(goCtx context.Context, request *types.QueryOrdersRe
 }
 
 func (q Keeper) GetAllOrdersByType(ctx context.Context, request *types.QueryOrdersByRequest) (*types.QueryOrdersResponse, error) {
-	clientCtx := sdk.UnwrapSDKContext(ctx)
-	store := clientCtx.KVStore(q.storeKey)
-	orderStore := prefix.NewStore(store, types.OTCOrderBookKey)
-	var orders []*types.Order
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	pageRes, err := query.Paginate(orderStore, request.Pagination, func(key, value []byte) error {
-		var order types.Order
-		if err := q.cdc.Unmarshal(value, &order); err != nil {
-			return err
-		}
-		acc := q.authKeeper.GetAccount(clientCtx, sdk.MustAccAddressFromBech32(order.Maker.MakerAddress))
-		if (acc != nil && request.OrderType == types.OrderType_SellToBuy) ||
-			(acc == nil && request.OrderType == types.OrderType_BuyToSell) {
-			orders = append(orders, &order)
-		}
-		return nil
-	})
+	// The maker of a sell-to-buy order has its account on this chain, which is the native side of the order.
+	side := types.REMOTE
+	if request.OrderType == types.OrderType_SellToBuy {
+		side = types.NATIVE
+	}
+
+	orders, pageRes, err := q.paginateOrderIndex(sdkCtx, types.OrderSideIndexPrefix(side), request.Pagination)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
 	}
@@ -58,22 +53,10 @@ func (q Keeper) GetAllOrdersByType(ctx context.Context, request *types.QueryOrde
 }
 
 func (q Keeper) GetSubmittedOrders(ctx context.Context, request *types.QuerySubmittedOrdersRequest) (*types.QueryOrdersResponse, error) {
-	clientCtx := sdk.UnwrapSDKContext(ctx)
-	store := clientCtx.KVStore(q.storeKey)
-	orderStore := prefix.NewStore(store, types.OTCOrderBookKey)
-	var orders []*types.Order
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	indexPrefix := types.OrderIndexPrefix(types.OTCOrderMakerIndexKey, request.MakerAddress)
 
-	pageRes, err := query.Paginate(orderStore, request.Pagination, func(key, value []byte) error {
-
-		var order types.Order
-		if err := q.cdc.Unmarshal(value, &order); err != nil {
-			return err
-		}
-		if order.Maker != nil && order.Maker.MakerAddress == request.MakerAddress {
-			orders = append(orders, &order)
-		}
-		return nil
-	})
+	orders, pageRes, err := q.paginateOrderIndex(sdkCtx, indexPrefix, request.Pagination)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
 	}
@@ -81,48 +64,180 @@ func (q Keeper) GetSubmittedOrders(ctx context.Context, request *types.QuerySubm
 }
 
 func (q Keeper) GetTookOrders(ctx context.Context, request *types.QueryTookOrdersRequest) (*types.QueryOrdersResponse, error) {
-	clientCtx := sdk.UnwrapSDKContext(ctx)
-	store := clientCtx.KVStore(q.storeKey)
-	orderStore := prefix.NewStore(store, types.OTCOrderBookKey)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	indexPrefix := types.OrderIndexPrefix(types.OTCOrderTakerIndexKey, request.TakerAddress)
 
-	var orders []*types.Order
+	orders, pageRes, err := q.paginateOrderIndex(sdkCtx, indexPrefix, request.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+	return &types.QueryOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+}
 
-	pageRes, err := query.Paginate(orderStore, request.Pagination, func(key, value []byte) error {
-		var order types.Order
-		if err := q.cdc.Unmarshal(value, &order); err != nil {
+func (q Keeper) GetPrivateOrders(ctx context.Context, request *types.QueryPrivateOrdersRequest) (*types.QueryOrdersResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	indexPrefix := types.OrderIndexPrefix(types.OTCOrderDesiredTakerIndexKey, request.DesireAddress)
+
+	orders, pageRes, err := q.paginateOrderIndex(sdkCtx, indexPrefix, request.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+	return &types.QueryOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+// GetOrdersByStatus implements the Query/GetOrdersByStatus gRPC method
+func (q Keeper) GetOrdersByStatus(ctx context.Context, request *types.QueryOrdersByStatusRequest) (*types.QueryOrdersResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	orders, pageRes, err := q.paginateOrderIndex(sdkCtx, types.OrderStatusIndexPrefix(request.Status), request.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+	return &types.QueryOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+// GetOrdersByPair implements the Query/GetOrdersByPair gRPC method
+func (q Keeper) GetOrdersByPair(ctx context.Context, request *types.QueryOrdersByPairRequest) (*types.QueryOrdersResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	indexPrefix := types.OrderIndexPrefix(types.OTCOrderPairIndexKey, request.SellDenom, request.BuyDenom)
+
+	orders, pageRes, err := q.paginateOrderIndex(sdkCtx, indexPrefix, request.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+	return &types.QueryOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+// GetBidsByOrder implements the Query/GetBidsByOrder gRPC method
+func (q Keeper) GetBidsByOrder(ctx context.Context, request *types.QueryBidsByOrderRequest) (*types.QueryBidsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	bidStore := prefix.NewStore(sdkCtx.KVStore(q.storeKey), types.OrderIndexPrefix(types.BidKey, request.OrderId))
+
+	var bids []*types.Bid
+	pageRes, err := query.Paginate(bidStore, request.Pagination, func(key []byte, value []byte) error {
+		var bid types.Bid
+		if err := q.cdc.Unmarshal(value, &bid); err != nil {
 			return err
 		}
-		if order.Takers != nil && order.Takers.TakerAddress == request.TakerAddress {
-			orders = append(orders, &order)
-		}
+		bids = append(bids, &bid)
 		return nil
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
 	}
-	return &types.QueryOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+	return &types.QueryBidsResponse{Bids: bids, Pagination: pageRes}, nil
 }
 
-func (q Keeper) GetPrivateOrders(ctx context.Context, request *types.QueryPrivateOrdersRequest) (*types.QueryOrdersResponse, error) {
-	clientCtx := sdk.UnwrapSDKContext(ctx)
-	store := clientCtx.KVStore(q.storeKey)
-	orderStore := prefix.NewStore(store, types.OTCOrderBookKey)
-	var orders []*types.Order
+// GetBidsByBidder implements the Query/GetBidsByBidder gRPC method
+func (q Keeper) GetBidsByBidder(ctx context.Context, request *types.QueryBidsByBidderRequest) (*types.QueryBidsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	indexStore := prefix.NewStore(sdkCtx.KVStore(q.storeKey), types.OrderIndexPrefix(types.BidBidderIndexKey, request.Bidder))
 
-	pageRes, err := query.Paginate(orderStore, request.Pagination, func(key, value []byte) error {
-		var order types.Order
-		if err := q.cdc.Unmarshal(value, &order); err != nil {
-			return err
-		}
-		if order.Maker.DesiredTaker == request.DesireAddress {
-			orders = append(orders, &order)
+	var bids []*types.Bid
+	pageRes, err := query.Paginate(indexStore, request.Pagination, func(key []byte, value []byte) error {
+		bid, found := q.GetBid(sdkCtx, string(value), request.Bidder)
+		if !found {
+			return fmt.Errorf("bid of %s on order %s not found", request.Bidder, value)
 		}
+		bids = append(bids, &bid)
 		return nil
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
 	}
-	return &types.QueryOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+	return &types.QueryBidsResponse{Bids: bids, Pagination: pageRes}, nil
+}
+
+// CollectedFees implements the Query/CollectedFees gRPC method
+func (q Keeper) CollectedFees(ctx context.Context, request *types.QueryCollectedFeesRequest) (*types.QueryCollectedFeesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if request.Denom != "" {
+		return &types.QueryCollectedFeesResponse{Fees: sdk.NewCoins(q.GetCollectedFee(sdkCtx, request.Denom))}, nil
+	}
+	return &types.QueryCollectedFeesResponse{Fees: q.GetAllCollectedFees(sdkCtx)}, nil
+}
+
+// GetOrder implements the Query/GetOrder gRPC method
+func (q Keeper) GetOrder(ctx context.Context, request *types.QueryOrderRequest) (*types.QueryOrderResponse, error) {
+	if request == nil || request.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// unknown order ids resolve to the first slot of the order book, so the id has to be compared as well.
+	order, found := q.GetAtomicOrder(sdkCtx, request.OrderId)
+	if !found || order.Id != request.OrderId {
+		return nil, status.Errorf(codes.NotFound, "order %s not found", request.OrderId)
+	}
+
+	res := &types.QueryOrderResponse{
+		Order:   &order,
+		Expired: order.Status == types.Status_EXPIRED || (order.Maker != nil && order.Maker.IsExpired(sdkCtx.BlockTime().Unix())),
+	}
+
+	portID, channelID, ok := orderLocalChannel(order)
+	if ok {
+		res.EscrowAddress = types.GetEscrowAddress(portID, channelID).String()
+		if _, clientState, err := q.channelKeeper.GetChannelClientState(sdkCtx, portID, channelID); err == nil {
+			if cs, ok := clientState.(interface{ GetChainID() string }); ok {
+				res.CounterpartyChainId = cs.GetChainID()
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// GetOrderPrice returns the buy token asked by an order at the current block time.
+func (q Keeper) GetOrderPrice(ctx context.Context, request *types.QueryOrderPriceRequest) (*types.QueryOrderPriceResponse, error) {
+	if request == nil || request.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	order, found := q.GetAtomicOrder(sdkCtx, request.OrderId)
+	if !found || order.Id != request.OrderId || order.Maker == nil {
+		return nil, status.Errorf(codes.NotFound, "order %s not found", request.OrderId)
+	}
+	if order.Maker.IsBasket() {
+		return nil, status.Errorf(codes.FailedPrecondition, "order %s is a basket order", request.OrderId)
+	}
+
+	return &types.QueryOrderPriceResponse{
+		Price:   order.Maker.CurrentBuyToken(sdkCtx.BlockTime().Unix()),
+		Auction: order.Maker.PriceSchedule != nil,
+	}, nil
+}
+
+// orderLocalChannel returns the port and channel of the order on this chain.
+// The maker's source channel is used for native orders, the destination channel of the order path otherwise.
+func orderLocalChannel(order types.Order) (string, string, bool) {
+	if order.Side == types.NATIVE {
+		if order.Maker == nil {
+			return "", "", false
+		}
+		return order.Maker.SourcePort, order.Maker.SourceChannel, true
+	}
+	if len(strings.Split(order.Path, "/")) < 8 {
+		return "", "", false
+	}
+	return extractSourcePortForTakerMsg(order.Path), extractSourceChannelForTakerMsg(order.Path), true
 }
 
 // Params implements the Query/Params gRPC method
@@ -147,3 +262,74 @@ func (q Keeper) EscrowAddress(c context.Context, req *types.QueryEscrowAddressRe
 		EscrowAddress: addr.String(),
 	}, nil
 }
+
+// SwapLists implements the Query/SwapLists gRPC method
+func (q Keeper) SwapLists(ctx context.Context, request *types.QuerySwapListsRequest) (*types.QuerySwapListsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	listStore := prefix.NewStore(sdkCtx.KVStore(q.storeKey), types.SwapListPrefix(request.List))
+
+	var entries []types.ListEntry
+	pageRes, err := query.Paginate(listStore, request.Pagination, func(key []byte, value []byte) error {
+		var entry types.ListEntry
+		if err := q.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+	return &types.QuerySwapListsResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// RateLimits implements the Query/RateLimits gRPC method
+func (q Keeper) RateLimits(ctx context.Context, request *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rateLimitStore := prefix.NewStore(sdkCtx.KVStore(q.storeKey), types.RateLimitKey)
+
+	var rateLimits []types.RateLimitUsage
+	pageRes, err := query.Paginate(rateLimitStore, request.Pagination, func(key []byte, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := q.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+		rateLimits = append(rateLimits, q.GetRateLimitUsage(sdkCtx, rateLimit))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+	return &types.QueryRateLimitsResponse{RateLimits: rateLimits, Pagination: pageRes}, nil
+}
+
+// ChannelRateLimits implements the Query/ChannelRateLimits gRPC method
+func (q Keeper) ChannelRateLimits(ctx context.Context, request *types.QueryChannelRateLimitsRequest) (*types.QueryChannelRateLimitsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := host.PortIdentifierValidator(request.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(request.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rateLimitStore := prefix.NewStore(sdkCtx.KVStore(q.storeKey), types.RateLimitChannelPrefix(types.RateLimitKey, request.PortId, request.ChannelId))
+	iterator := sdk.KVStorePrefixIterator(rateLimitStore, []byte{})
+	defer iterator.Close()
+
+	res := &types.QueryChannelRateLimitsResponse{Paused: q.IsChannelPaused(sdkCtx, request.PortId, request.ChannelId)}
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		q.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		res.RateLimits = append(res.RateLimits, q.GetRateLimitUsage(sdkCtx, rateLimit))
+	}
+	return res, nil
+}
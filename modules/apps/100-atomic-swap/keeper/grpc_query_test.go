@@ -4,6 +4,10 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
 	ibctesting "github.com/sideprotocol/ibcswap/v6/testing"
 )
@@ -64,3 +68,110 @@ func (suite *KeeperTestSuite) TestEscrowAddress() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryOrdersByIndex() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+
+	maker1 := suite.chainA.SenderAccount.GetAddress().String()
+	maker2 := suite.chainB.SenderAccount.GetAddress().String()
+	sellToken := sdk.NewCoin("atom", sdk.NewInt(100))
+	buyToken := sdk.NewCoin("osmo", sdk.NewInt(100))
+
+	for i := 0; i < 10; i++ {
+		maker := maker1
+		if i%2 == 1 {
+			maker = maker2
+		}
+		k.AppendAtomicOrder(ctx, types.Order{
+			Id:     fmt.Sprintf("order%d", i),
+			Side:   types.NATIVE,
+			Status: types.Status_SYNC,
+			Maker: &types.MakeSwapMsg{
+				SellToken:    sellToken,
+				BuyToken:     buyToken,
+				MakerAddress: maker,
+			},
+		})
+	}
+
+	// pages are filled from the index instead of being filtered after pagination
+	res, err := k.GetSubmittedOrders(sdk.WrapSDKContext(ctx), &types.QuerySubmittedOrdersRequest{
+		MakerAddress: maker2,
+		Pagination:   &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Orders, 3)
+	suite.Require().Equal(uint64(5), res.Pagination.Total)
+	for _, order := range res.Orders {
+		suite.Require().Equal(maker2, order.Maker.MakerAddress)
+	}
+
+	// the status index follows order updates
+	order, found := k.GetAtomicOrder(ctx, "order3")
+	suite.Require().True(found)
+	order.Status = types.Status_COMPLETE
+	order.Takers = &types.TakeSwapMsg{OrderId: order.Id, TakerAddress: maker1}
+	k.SetAtomicOrder(ctx, order)
+
+	res, err = k.GetOrdersByStatus(sdk.WrapSDKContext(ctx), &types.QueryOrdersByStatusRequest{Status: types.Status_SYNC})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Orders, 9)
+
+	res, err = k.GetOrdersByStatus(sdk.WrapSDKContext(ctx), &types.QueryOrdersByStatusRequest{Status: types.Status_COMPLETE})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Orders, 1)
+	suite.Require().Equal("order3", res.Orders[0].Id)
+
+	res, err = k.GetTookOrders(sdk.WrapSDKContext(ctx), &types.QueryTookOrdersRequest{TakerAddress: maker1})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Orders, 1)
+
+	res, err = k.GetOrdersByPair(sdk.WrapSDKContext(ctx), &types.QueryOrdersByPairRequest{SellDenom: "atom", BuyDenom: "osmo"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Orders, 10)
+
+	res, err = k.GetOrdersByPair(sdk.WrapSDKContext(ctx), &types.QueryOrdersByPairRequest{SellDenom: "osmo", BuyDenom: "atom"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Orders)
+
+	// removed orders leave the indexes
+	k.RemoveOrder(ctx, "order3")
+	res, err = k.GetTookOrders(sdk.WrapSDKContext(ctx), &types.QueryTookOrdersRequest{TakerAddress: maker1})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Orders)
+}
+
+func (suite *KeeperTestSuite) TestQueryOrder() {
+	path := NewSwapPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+
+	k.AppendAtomicOrder(ctx, types.Order{
+		Id:     "order0",
+		Side:   types.NATIVE,
+		Status: types.Status_SYNC,
+		Maker: &types.MakeSwapMsg{
+			SourcePort:          path.EndpointA.ChannelConfig.PortID,
+			SourceChannel:       path.EndpointA.ChannelID,
+			SellToken:           sdk.NewCoin("atom", sdk.NewInt(100)),
+			BuyToken:            sdk.NewCoin("osmo", sdk.NewInt(100)),
+			MakerAddress:        suite.chainA.SenderAccount.GetAddress().String(),
+			ExpirationTimestamp: uint64(ctx.BlockTime().Unix() - 1),
+		},
+	})
+
+	res, err := k.GetOrder(sdk.WrapSDKContext(ctx), &types.QueryOrderRequest{OrderId: "order0"})
+	suite.Require().NoError(err)
+	suite.Require().Equal("order0", res.Order.Id)
+	suite.Require().Equal(suite.chainB.ChainID, res.CounterpartyChainId)
+	suite.Require().Equal(types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID).String(), res.EscrowAddress)
+	suite.Require().True(res.Expired)
+
+	// unknown ids must not resolve to the first order of the book
+	_, err = k.GetOrder(sdk.WrapSDKContext(ctx), &types.QueryOrderRequest{OrderId: "unknown"})
+	suite.Require().Error(err)
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+}
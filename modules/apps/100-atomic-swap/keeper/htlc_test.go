@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+func (suite *KeeperTestSuite) TestHTLCClaim() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	sender := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress()
+	receiver := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	preimage := []byte("secret")
+	hashLock := sha256.Sum256(preimage)
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	timelock := uint64(ctx.BlockTime().Add(time.Hour).Unix())
+
+	msg := types.NewMsgLockHTLC(sender.String(), receiver.String(), sellToken, sdk.NewCoin("btc", sdk.NewInt(1)), "bc1sender", hashLock[:], timelock, ctx.BlockTime().Unix())
+	res, err := k.LockHTLC(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	// the same order can not be locked twice
+	_, err = k.LockHTLC(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrOrderAlreadyExists)
+
+	// the same tokens can be locked under another hash lock
+	otherHashLock := sha256.Sum256([]byte("other secret"))
+	otherMsg := types.NewMsgLockHTLC(sender.String(), receiver.String(), sellToken, sdk.NewCoin("btc", sdk.NewInt(1)), "bc1sender", otherHashLock[:], timelock, ctx.BlockTime().Unix())
+	otherRes, err := k.LockHTLC(sdk.WrapSDKContext(ctx), otherMsg)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(res.OrderId, otherRes.OrderId)
+
+	escrowAddr := types.GetEscrowAddress(types.PortID, types.HTLCChannelID)
+	suite.Require().Equal(sellToken.Add(sellToken), bankKeeper.GetBalance(ctx, escrowAddr, sdk.DefaultBondDenom))
+
+	// HTLC orders are listed with the other orders and are not settled over IBC
+	orderRes, err := k.GetOrder(sdk.WrapSDKContext(ctx), &types.QueryOrderRequest{OrderId: res.OrderId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.HTLCOrder, orderRes.Order.Kind)
+	suite.Require().Equal(escrowAddr.String(), orderRes.EscrowAddress)
+	_, err = k.TakeSwap(sdk.WrapSDKContext(ctx), types.NewMsgTakeSwap(res.OrderId, sdk.NewCoin("btc", sdk.NewInt(1)), receiver.String(), "bc1receiver", suite.chainB.GetTimeoutHeight(), 0, 0))
+	suite.Require().ErrorIs(err, types.ErrInvalidOrderKind)
+
+	_, err = k.ClaimHTLC(sdk.WrapSDKContext(ctx), types.NewMsgClaimHTLC(res.OrderId, receiver.String(), []byte("wrong")))
+	suite.Require().ErrorIs(err, types.ErrInvalidPreimage)
+
+	receiverBalance := bankKeeper.GetBalance(ctx, receiver, sdk.DefaultBondDenom)
+
+	// anyone knowing the preimage can claim on behalf of the receiver
+	_, err = k.ClaimHTLC(sdk.WrapSDKContext(ctx), types.NewMsgClaimHTLC(res.OrderId, sender.String(), preimage))
+	suite.Require().NoError(err)
+	suite.Require().Equal(receiverBalance.Add(sellToken), bankKeeper.GetBalance(ctx, receiver, sdk.DefaultBondDenom))
+	suite.Require().Equal(sellToken, bankKeeper.GetBalance(ctx, escrowAddr, sdk.DefaultBondDenom))
+
+	order, found := k.GetAtomicOrder(ctx, res.OrderId)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Status_COMPLETE, order.Status)
+	suite.Require().Equal(preimage, order.Htlc.Preimage)
+
+	// the order can not be refunded once claimed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	_, err = k.RefundHTLC(sdk.WrapSDKContext(ctx), types.NewMsgRefundHTLC(res.OrderId, sender.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidOrderStatus)
+}
+
+func (suite *KeeperTestSuite) TestHTLCRefund() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	sender := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress()
+	receiver := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	preimage := []byte("secret")
+	hashLock := sha256.Sum256(preimage)
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	timelock := uint64(ctx.BlockTime().Add(time.Hour).Unix())
+
+	senderBalance := bankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+
+	var orderIds []string
+	for i := int64(0); i < 2; i++ {
+		msg := types.NewMsgLockHTLC(sender.String(), receiver.String(), sellToken, sdk.NewCoin("btc", sdk.NewInt(1)), "bc1sender", hashLock[:], timelock, i)
+		res, err := k.LockHTLC(sdk.WrapSDKContext(ctx), msg)
+		suite.Require().NoError(err)
+		orderIds = append(orderIds, res.OrderId)
+	}
+
+	_, err := k.RefundHTLC(sdk.WrapSDKContext(ctx), types.NewMsgRefundHTLC(orderIds[0], sender.String()))
+	suite.Require().ErrorIs(err, types.ErrOrderNotExpired)
+
+	// the timelock is reached, the order can not be claimed anymore
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, err = k.ClaimHTLC(sdk.WrapSDKContext(ctx), types.NewMsgClaimHTLC(orderIds[0], receiver.String(), preimage))
+	suite.Require().ErrorIs(err, types.ErrOrderExpired)
+
+	// the first order is refunded by the message, the second one by the end blocker
+	_, err = k.RefundHTLC(sdk.WrapSDKContext(ctx), types.NewMsgRefundHTLC(orderIds[0], receiver.String()))
+	suite.Require().NoError(err)
+	k.ExpireOrders(ctx)
+
+	for _, orderId := range orderIds {
+		order, found := k.GetAtomicOrder(ctx, orderId)
+		suite.Require().True(found)
+		suite.Require().Equal(types.Status_EXPIRED, order.Status)
+	}
+	suite.Require().Equal(senderBalance, bankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+	suite.Require().True(bankKeeper.GetBalance(ctx, types.GetEscrowAddress(types.PortID, types.HTLCChannelID), sdk.DefaultBondDenom).IsZero())
+}
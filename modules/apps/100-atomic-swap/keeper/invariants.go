@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// RegisterInvariants registers the atomic swap module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow-solvency", EscrowSolvencyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "order-status-timestamps", OrderStatusTimestampInvariant(k))
+}
+
+// AllInvariants runs all invariants of the atomic swap module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := EscrowSolvencyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return OrderStatusTimestampInvariant(k)(ctx)
+	}
+}
+
+// EscrowSolvencyInvariant checks that each escrow account holds at least the sell tokens
+// which have not been released yet by the open orders made on this chain.
+func EscrowSolvencyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		locked := make(map[string]sdk.Coins)
+		for _, status := range []types.Status{types.Status_INITIAL, types.Status_SYNC} {
+			k.iterateOrdersByStatus(ctx, status, func(order types.Order) bool {
+				if order.Side != types.NATIVE || order.Maker == nil {
+					return false
+				}
+				escrowAddr := types.GetEscrowAddress(order.Maker.SourcePort, order.Maker.SourceChannel).String()
+				locked[escrowAddr] = locked[escrowAddr].Add(order.RemainingSellTokens()...)
+				return false
+			})
+		}
+
+		escrows := make([]string, 0, len(locked))
+		for escrow := range locked {
+			escrows = append(escrows, escrow)
+		}
+		sort.Strings(escrows)
+
+		var (
+			msg    string
+			broken bool
+		)
+		for _, escrow := range escrows {
+			escrowAddr := sdk.MustAccAddressFromBech32(escrow)
+			for _, coin := range locked[escrow] {
+				balance := k.bankKeeper.GetBalance(ctx, escrowAddr, coin.Denom)
+				if balance.IsLT(coin) {
+					broken = true
+					msg += fmt.Sprintf("\tescrow %s holds %s, open orders lock %s\n", escrow, balance, coin)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow-solvency",
+			fmt.Sprintf("escrow accounts holding less than the sell tokens of the open orders:\n%s", msg)), broken
+	}
+}
+
+// OrderStatusTimestampInvariant checks that only completed orders have a complete timestamp
+// and only cancelled orders have a cancel timestamp.
+func OrderStatusTimestampInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		for _, order := range k.GetAllOrder(ctx) {
+			if !order.HasConsistentTimestamps() {
+				broken = true
+				msg += fmt.Sprintf("\torder %s in status %s has complete timestamp %d and cancel timestamp %d\n",
+					order.Id, order.Status, order.CompleteTimestamp, order.CancelTimestamp)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "order-status-timestamps",
+			fmt.Sprintf("orders with timestamps inconsistent with their status:\n%s", msg)), broken
+	}
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/keeper"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	sender := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress()
+	receiver := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	hashLock := sha256.Sum256([]byte("secret"))
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	timelock := uint64(ctx.BlockTime().Add(time.Hour).Unix())
+
+	msg := types.NewMsgLockHTLC(sender.String(), receiver.String(), sellToken, sdk.NewCoin("btc", sdk.NewInt(1)), "bc1sender", hashLock[:], timelock, ctx.BlockTime().Unix())
+	res, err := k.LockHTLC(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	_, broken := keeper.AllInvariants(k)(ctx)
+	suite.Require().False(broken)
+
+	// the escrow no longer covers the open order
+	cacheCtx, _ := ctx.CacheContext()
+	escrowAddr := types.GetEscrowAddress(types.PortID, types.HTLCChannelID)
+	suite.Require().NoError(bankKeeper.SendCoins(cacheCtx, escrowAddr, sender, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)))))
+	_, broken = keeper.EscrowSolvencyInvariant(k)(cacheCtx)
+	suite.Require().True(broken)
+
+	// a completed order without a complete timestamp
+	order, found := k.GetAtomicOrder(ctx, res.OrderId)
+	suite.Require().True(found)
+	order.Status = types.Status_COMPLETE
+	k.SetAtomicOrder(ctx, order)
+	_, broken = keeper.OrderStatusTimestampInvariant(k)(ctx)
+	suite.Require().True(broken)
+
+	order.CompleteTimestamp = ctx.BlockTime().Unix()
+	k.SetAtomicOrder(ctx, order)
+	_, broken = keeper.OrderStatusTimestampInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// an open order can not carry a cancel timestamp
+	order.Status = types.Status_SYNC
+	order.CompleteTimestamp = 0
+	order.CancelTimestamp = ctx.BlockTime().Unix()
+	k.SetAtomicOrder(ctx, order)
+	_, broken = keeper.OrderStatusTimestampInvariant(k)(ctx)
+	suite.Require().True(broken)
+}
@@ -6,9 +6,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errormod "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -25,14 +27,20 @@ type Keeper struct {
 	portKeeper    types.PortKeeper
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+	authzKeeper   types.AuthzKeeper
 	scopedKeeper  capabilitykeeper.ScopedKeeper
+
+	// the address allowed to update the swap lists, usually the governance module account
+	authority string
 }
 
 // NewKeeper creates a new IBC transfer Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
-	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, scopedKeeper capabilitykeeper.ScopedKeeper,
+	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, distrKeeper types.DistributionKeeper,
+	authzKeeper types.AuthzKeeper, scopedKeeper capabilitykeeper.ScopedKeeper, authority string,
 ) Keeper {
 	// ensure ibc transfer module account is set
 	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -53,10 +61,18 @@ func NewKeeper(
 		portKeeper:    portKeeper,
 		authKeeper:    authKeeper,
 		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		authzKeeper:   authzKeeper,
 		scopedKeeper:  scopedKeeper,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the swap lists.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
@@ -87,6 +103,18 @@ func (k Keeper) SetPort(ctx sdk.Context, portID string) {
 	store.Set(types.PortKey, []byte(portID))
 }
 
+// GetOpenChannels returns the open channels bound to the swap port.
+func (k Keeper) GetOpenChannels(ctx sdk.Context) []channeltypes.IdentifiedChannel {
+	portID := k.GetPort(ctx)
+	var channels []channeltypes.IdentifiedChannel
+	for _, channel := range k.channelKeeper.GetAllChannels(ctx) {
+		if channel.PortId == portID && channel.State == channeltypes.OPEN {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
@@ -97,3 +125,23 @@ func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Cap
 func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
 	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
 }
+
+// validateBasketChannel checks that the channel has been negotiated with the basket version,
+// so that the counterparty is able to settle basket orders.
+func (k Keeper) validateBasketChannel(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errormod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+	if !types.IsBasketVersion(channel.Version) {
+		return errormod.Wrapf(types.ErrInvalidVersion, "basket orders require channel version %s or %s, got %s", types.VersionBasket, types.Version2, channel.Version)
+	}
+	return nil
+}
+
+// PacketCodec returns the codec of the packets sent and received on a channel, which depends on
+// the negotiated channel version. The packets of an unknown channel are JSON encoded.
+func (k Keeper) PacketCodec(ctx sdk.Context, portID, channelID string) types.PacketCodec {
+	channel, _ := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	return types.NewPacketCodec(channel.Version)
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3. It builds the
+// secondary order book indexes and the expiration queue for the existing orders.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.OTCOrderBookKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		order, err := m.keeper.Unmarshal(iterator.Value())
+		if err != nil {
+			return err
+		}
+		m.keeper.setOrderIndexes(ctx, order, GetBidIDFromBytes(iterator.Key()))
+
+		if order.Maker != nil && order.Maker.ExpirationTimestamp != 0 &&
+			(order.Status == types.Status_INITIAL || order.Status == types.Status_SYNC) {
+			m.keeper.InsertExpiringOrderQueue(ctx, order.Id, order.Maker.ExpirationTimestamp)
+		}
+	}
+	return nil
+}
+
+// Migrate3to4 migrates the store from consensus version 3 to 4. It sets the
+// swap fee params, no fee is charged until governance sets a fee rate.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeySwapFeeRate, uint32(types.DefaultFeeRate))
+	m.keeper.paramSpace.Set(ctx, types.KeyFeeCollector, "")
+	return nil
+}
+
+// Migrate4to5 migrates the store from consensus version 4 to 5. Orders completed or
+// cancelled without a timestamp are given the block time of the upgrade, so that the
+// stored orders satisfy the order status invariant.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.OTCOrderBookKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	updated := make(map[string]types.Order)
+	var keys []string
+	for ; iterator.Valid(); iterator.Next() {
+		order, err := m.keeper.Unmarshal(iterator.Value())
+		if err != nil {
+			return err
+		}
+		switch {
+		case order.Status == types.Status_COMPLETE && order.CompleteTimestamp == 0:
+			order.CompleteTimestamp = ctx.BlockTime().Unix()
+		case order.Status == types.Status_CANCEL && order.CancelTimestamp == 0:
+			order.CancelTimestamp = ctx.BlockTime().Unix()
+		default:
+			continue
+		}
+		keys = append(keys, string(iterator.Key()))
+		updated[string(iterator.Key())] = order
+	}
+
+	for _, key := range keys {
+		order := updated[key]
+		store.Set([]byte(key), m.keeper.cdc.MustMarshal(&order))
+	}
+	return nil
+}
+
+// Migrate5to6 migrates the store from consensus version 5 to 6. It sets the order
+// retention params and adds the completed, cancelled and expired orders to the retention
+// queue. Their retention starts at the upgrade.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyOrderRetentionPeriod, uint64(types.DefaultOrderRetentionPeriod))
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxTerminalOrders, uint64(types.DefaultMaxTerminalOrders))
+	m.keeper.paramSpace.Set(ctx, types.KeyPruneBatchSize, uint32(types.DefaultPruneBatchSize))
+
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.OTCOrderBookKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var closed []types.GenesisOrder
+	for ; iterator.Valid(); iterator.Next() {
+		order, err := m.keeper.Unmarshal(iterator.Value())
+		if err != nil {
+			return err
+		}
+		if order.IsClosed() {
+			closed = append(closed, types.GenesisOrder{Position: GetBidIDFromBytes(iterator.Key()), Order: order})
+		}
+	}
+	for _, entry := range closed {
+		setClosedAt(ctx, &entry.Order)
+		store.Set(GetOrderIDBytes(entry.Position), m.keeper.cdc.MustMarshal(&entry.Order))
+		m.keeper.setOrderRetention(ctx, entry.Order, entry.Position)
+	}
+	return nil
+}
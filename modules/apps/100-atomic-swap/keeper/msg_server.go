@@ -36,7 +36,7 @@ func (k Keeper) fillAtomicOrder(ctx sdk.Context, escrowAddr sdk.AccAddress, orde
 	if msg.SellToken.IsGTE(order.Maker.BuyToken) {
 		order.Takers = msg // types.NewTakerFromMsg(msg)
 		order.Status = types.Status_COMPLETE
-		order.CompleteTimestamp = msg.CreateTimestamp
+		order.CompleteTimestamp = statusTimestamp(ctx, msg.CreateTimestamp)
 	} else {
 		return types.ErrOrderInsufficientAmount
 	}
@@ -110,7 +110,7 @@ func (k Keeper) executeCancel(ctx sdk.Context, msg *types.CancelSwapMsg, step in
 		default:
 			// continue
 			if step != StepSend {
-				order.CancelTimestamp = msg.CreateTimestamp
+				order.CancelTimestamp = statusTimestamp(ctx, msg.CreateTimestamp)
 				order.Status = types.Status_CANCEL
 			}
 
@@ -118,7 +118,7 @@ func (k Keeper) executeCancel(ctx sdk.Context, msg *types.CancelSwapMsg, step in
 			if step == StepAcknowledgement {
 				receiver := sdk.MustAccAddressFromBech32(msg.MakerAddress)
 				escrowAddr := types.GetEscrowAddress(order.Maker.SourcePort, order.Maker.SourceChannel)
-				k.bankKeeper.SendCoins(ctx, escrowAddr, receiver, sdk.NewCoins(order.Maker.SellToken))
+				k.bankKeeper.SendCoins(ctx, escrowAddr, receiver, order.RemainingSellTokens())
 			}
 		}
 	} else {
@@ -146,6 +146,17 @@ func (k Keeper) OnReceivedMake(ctx sdk.Context, packet channeltypes.Packet, orde
 		}
 	}
 
+	if err := k.ValidateSwapRoute(ctx, packet.GetDestPort(), packet.GetDestChannel(), msg.Denoms()...); err != nil {
+		return "", err
+	}
+
+	// basket orders are only exchanged on channels negotiated with the basket version
+	if msg.IsBasket() {
+		if err := k.validateBasketChannel(ctx, packet.GetDestPort(), packet.GetDestChannel()); err != nil {
+			return "", err
+		}
+	}
+
 	order := types.Order{
 		Id:     orderId,
 		Side:   types.REMOTE,
@@ -155,6 +166,7 @@ func (k Keeper) OnReceivedMake(ctx sdk.Context, packet channeltypes.Packet, orde
 	}
 
 	k.AppendAtomicOrder(ctx, order)
+	emitOrderCreated(ctx, order)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -180,47 +192,95 @@ func (k Keeper) OnReceivedMake(ctx sdk.Context, packet channeltypes.Packet, orde
 
 // OnReceivedTake is step 7.1 (Transfer Make Token) of the atomic swap: https://github.com/cosmos/ibc/tree/main/spec/app/ics-100-atomic-swap
 // The step is executed on the Maker chain.
-func (k Keeper) OnReceivedTake(ctx sdk.Context, packet channeltypes.Packet, msg *types.TakeSwapMsg) (string, error) {
-
-	escrowAddr := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+func (k Keeper) OnReceivedTake(ctx sdk.Context, packet channeltypes.Packet, msg *types.TakeSwapMsg) (*types.MsgTakeSwapResponse, error) {
 
 	// check order status
 	order, ok := k.GetAtomicOrder(ctx, msg.OrderId)
 	if !ok {
-		return "", types.ErrOrderDoesNotExists
+		return nil, types.ErrOrderDoesNotExists
+	}
+
+	// HTLC orders are not settled over IBC
+	if order.Kind != types.IBCOrder {
+		return nil, types.ErrInvalidOrderKind
 	}
 
 	if order.Status != types.Status_SYNC {
-		return "", types.ErrInvalidOrderStatus
+		return nil, types.ErrInvalidOrderStatus
+	}
+
+	// Reject takes arriving after the order expired, the taker is refunded by the error acknowledgement.
+	if order.Maker.IsExpired(ctx.BlockTime().Unix()) {
+		return nil, types.ErrOrderExpired
+	}
+
+	if err := k.ValidateSwapRoute(ctx, packet.GetDestPort(), packet.GetDestChannel(), order.Maker.Denoms()...); err != nil {
+		return nil, err
 	}
 
-	if msg.SellToken.Denom != order.Maker.BuyToken.Denom || !msg.SellToken.Amount.Equal(order.Maker.BuyToken.Amount) {
-		return "", types.ErrInvalidSellToken
+	if order.Maker.IsBasket() {
+		if err := order.ValidateBasketFill(msg.SellCoins()); err != nil {
+			return nil, err
+		}
+	} else if err := order.ValidateFill(msg.SellToken, ctx.BlockTime().Unix()); err != nil {
+		return nil, err
+	}
+
+	// the order is reserved for an accepted bid until its settlement is acknowledged
+	if k.hasAcceptedBid(ctx, order.Id) {
+		return nil, types.ErrOrderBidAccepted
 	}
 
 	// If `desiredTaker` is set, only the desiredTaker can accept the order.
 	if order.Maker.DesiredTaker != "" && order.Maker.DesiredTaker != msg.TakerAddress {
-		return "", types.ErrInvalidTakerAddress
+		return nil, types.ErrInvalidTakerAddress
 	}
 
 	takerReceivingAddr, err := sdk.AccAddressFromBech32(msg.TakerReceivingAddress)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	// Send maker.sellToken to taker's receiving address
-	if err = k.bankKeeper.SendCoins(ctx, escrowAddr, takerReceivingAddr, sdk.NewCoins(order.Maker.SellToken)); err != nil {
-		return "", err
+	// An auction order charges its current price, the taker chain refunds the overpayment
+	// once the take is acknowledged.
+	fill := *msg
+	if order.Maker.PriceSchedule != nil {
+		fill.SellToken = order.Maker.CurrentBuyToken(ctx.BlockTime().Unix())
+	}
+
+	released := order.ReleasedSellTokens(fill.SellToken)
+	if order.Maker.IsBasket() {
+		// A basket order is filled at once, all the sell tokens are sent to the taker less the swap fee
+		for _, sellToken := range order.Maker.SellTokens {
+			if err = k.sendProceeds(ctx, packet.GetDestPort(), packet.GetDestChannel(), takerReceivingAddr, sellToken); err != nil {
+				return nil, err
+			}
+		}
+	} else if err = k.sendProceeds(ctx, packet.GetDestPort(), packet.GetDestChannel(), takerReceivingAddr, order.FillSellToken(fill.SellToken.Amount)); err != nil {
+		// Send maker.sellToken to taker's receiving address, pro-rata to the filled amount and less the swap fee
+		return nil, err
 	}
 
 	// Update status of order
-	order.Status = types.Status_COMPLETE
-	order.Takers = msg
-	order.CompleteTimestamp = msg.CreateTimestamp
-	k.SetAtomicOrder(ctx, order)
+	order.Fills = append(order.Fills, &fill)
+	if !order.IsFilled() {
+		k.SetAtomicOrder(ctx, order)
+		emitOrderTaken(ctx, order, &fill, released)
+	} else {
+		order.Status = types.Status_COMPLETE
+		order.Takers = &fill
+		order.CompleteTimestamp = statusTimestamp(ctx, msg.CreateTimestamp)
+		k.SetAtomicOrder(ctx, order)
 
-	// Move Completed assets to bottom
-	k.MoveOrderToBottom(ctx, order.Id)
+		// Move Completed assets to bottom
+		k.MoveOrderToBottom(ctx, order.Id)
+
+		if err := k.refundOrderBids(ctx, order, "", types.ReasonOrderCompleted); err != nil {
+			return nil, err
+		}
+		emitOrderTaken(ctx, order, &fill, released)
+		emitOrderCompleted(ctx, order)
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -241,7 +301,7 @@ func (k Keeper) OnReceivedTake(ctx sdk.Context, packet channeltypes.Packet, msg
 			},
 		),
 	)
-	return order.Id, nil
+	return &types.MsgTakeSwapResponse{OrderId: order.Id, PaidToken: fill.SellToken}, nil
 }
 
 // OnReceivedCancel is the step 12 (Cancel Order) of the atomic swap: https://github.com/cosmos/ibc/tree/main/spec/app/ics-100-atomic-swap.
@@ -257,6 +317,11 @@ func (k Keeper) OnReceivedCancel(ctx sdk.Context, packet channeltypes.Packet, ms
 		return "", types.ErrOrderDoesNotExists
 	}
 
+	// HTLC orders are not settled over IBC
+	if order.Kind != types.IBCOrder {
+		return "", types.ErrInvalidOrderKind
+	}
+
 	if order.Status != types.Status_SYNC && order.Status != types.Status_INITIAL {
 		return "", types.ErrInvalidOrderStatus
 	}
@@ -267,9 +332,15 @@ func (k Keeper) OnReceivedCancel(ctx sdk.Context, packet channeltypes.Packet, ms
 
 	// Update status of order
 	order.Status = types.Status_CANCEL
-	order.CancelTimestamp = msg.CreateTimestamp
+	order.CancelTimestamp = statusTimestamp(ctx, msg.CreateTimestamp)
 	k.SetAtomicOrder(ctx, order)
 
+	// the open bids are locked on this chain
+	if err := k.refundOrderBids(ctx, order, "", types.ReasonCancelled); err != nil {
+		return "", err
+	}
+	emitOrderCancelled(ctx, order, types.ReasonCancelled)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.ModuleName,
@@ -292,12 +363,119 @@ func (k Keeper) OnReceivedCancel(ctx sdk.Context, packet channeltypes.Packet, ms
 	return order.Id, nil
 }
 
+// OnReceivedMakeBid records a bid made on the Taker chain. The step is executed on the Maker chain.
+func (k Keeper) OnReceivedMakeBid(ctx sdk.Context, packet channeltypes.Packet, msg *types.MakeBidMsg) (string, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return "", err
+	}
+
+	order, ok := k.GetAtomicOrder(ctx, msg.OrderId)
+	if !ok || order.Id != msg.OrderId || order.Side != types.NATIVE {
+		return "", types.ErrOrderDoesNotExists
+	}
+
+	// HTLC orders are not settled over IBC
+	if order.Kind != types.IBCOrder {
+		return "", types.ErrInvalidOrderKind
+	}
+
+	if k.hasAcceptedBid(ctx, order.Id) {
+		return "", types.ErrOrderBidAccepted
+	}
+
+	if err := k.ValidateSwapRoute(ctx, packet.GetDestPort(), packet.GetDestChannel(), append(order.Maker.Denoms(), msg.Amount.Denom)...); err != nil {
+		return "", err
+	}
+
+	if err := k.validateBid(ctx, order, msg.Bidder, msg.Amount); err != nil {
+		return "", err
+	}
+
+	bid := types.Bid{
+		OrderId:                order.Id,
+		Bidder:                 msg.Bidder,
+		BidderReceivingAddress: msg.BidderReceivingAddress,
+		Amount:                 msg.Amount,
+		Status:                 types.BID_PLACED,
+		CreateTimestamp:        msg.CreateTimestamp,
+	}
+	k.SetBid(ctx, bid)
+
+	emitBidEvent(ctx, types.GetEventValueWithSuffix(types.EventValueActionMakeBid, types.EventValueSuffixReceived), bid)
+	return order.Id, nil
+}
+
+// OnReceivedAcceptBid settles an accepted bid: the locked bid is sent to the maker, the other bids are refunded
+// and the order is completed. The step is executed on the Taker chain.
+func (k Keeper) OnReceivedAcceptBid(ctx sdk.Context, packet channeltypes.Packet, msg *types.AcceptBidMsg) (string, error) {
+	order, ok := k.GetAtomicOrder(ctx, msg.OrderId)
+	if !ok || order.Id != msg.OrderId || order.Side != types.REMOTE {
+		return "", types.ErrOrderDoesNotExists
+	}
+
+	if order.Status != types.Status_SYNC {
+		return "", types.ErrInvalidOrderStatus
+	}
+
+	if order.Maker.IsExpired(ctx.BlockTime().Unix()) {
+		return "", types.ErrOrderExpired
+	}
+
+	// the acknowledgement of the bid may not have been relayed yet, so initial bids are accepted as well.
+	bid, found := k.GetBid(ctx, order.Id, msg.Bidder)
+	if !found {
+		return "", types.ErrBidNotFound
+	}
+	if !bid.IsOpen() {
+		return "", types.ErrInvalidBidStatus
+	}
+
+	makerReceivingAddr, err := sdk.AccAddressFromBech32(order.Maker.MakerReceivingAddress)
+	if err != nil {
+		return "", err
+	}
+
+	if err := k.sendProceeds(ctx, packet.GetDestPort(), packet.GetDestChannel(), makerReceivingAddr, bid.Amount); err != nil {
+		return "", err
+	}
+
+	if err := k.completeOrderWithBid(ctx, order, bid, msg.CreateTimestamp); err != nil {
+		return "", err
+	}
+
+	emitBidEvent(ctx, types.GetEventValueWithSuffix(types.EventValueActionAcceptBid, types.EventValueSuffixReceived), bid)
+	return order.Id, nil
+}
+
+// OnReceivedCancelBid withdraws a bid which has not been accepted. The step is executed on the Maker chain.
+func (k Keeper) OnReceivedCancelBid(ctx sdk.Context, packet channeltypes.Packet, msg *types.CancelBidMsg) (string, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return "", err
+	}
+
+	bid, found := k.GetBid(ctx, msg.OrderId, msg.Bidder)
+	if !found {
+		return "", types.ErrBidNotFound
+	}
+	if bid.Status != types.BID_PLACED {
+		return "", types.ErrInvalidBidStatus
+	}
+
+	bid.Status = types.BID_CANCELLED
+	k.SetBid(ctx, bid)
+
+	emitBidEvent(ctx, types.GetEventValueWithSuffix(types.EventValueActionCancelBid, types.EventValueSuffixReceived), bid)
+	return bid.OrderId, nil
+}
+
 func createOrder(ctx sdk.Context, msg *types.MakeSwapMsg, channelKeeper types.ChannelKeeper) (*types.Order, error) {
 	channel, found := channelKeeper.GetChannel(ctx, msg.SourcePort, msg.SourceChannel)
 	if !found {
 		return nil, types.ErrNotFoundChannel
 	}
-	pathID := types.GenerateRandomString(ctx.ChainID(), 10)
+	// The path id is derived from the sequence of the make swap packet, every validator must compute the same order id.
+	sequence, _ := channelKeeper.GetNextSequenceSend(ctx, msg.SourcePort, msg.SourceChannel)
+	pathID := fmt.Sprintf("%s-%d", ctx.ChainID(), sequence)
 	path := orderPath(msg.SourcePort, msg.SourceChannel, channel.Counterparty.PortId, channel.Counterparty.ChannelId, pathID)
 	return &types.Order{
 		Id:     generateOrderId(path, msg),
@@ -308,6 +486,16 @@ func createOrder(ctx sdk.Context, msg *types.MakeSwapMsg, channelKeeper types.Ch
 	}, nil
 }
 
+// statusTimestamp returns the timestamp recorded when an order is completed or cancelled by a request
+// created at the given timestamp. The block time is used if the request carries no timestamp, so the
+// timestamps of closed orders are always set.
+func statusTimestamp(ctx sdk.Context, requested int64) int64 {
+	if requested > 0 {
+		return requested
+	}
+	return ctx.BlockTime().Unix()
+}
+
 func orderPath(sourcePort, sourceChannel, destPort, destChannel, pathID string) string {
 	return fmt.Sprintf("channel/%s/port/%s/channel/%s/port/%s/%s", sourceChannel, sourcePort, destChannel, destPort, pathID)
 }
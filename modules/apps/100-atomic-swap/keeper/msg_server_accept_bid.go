@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errormod "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// AcceptBid is called when the maker accepts a bid on its order. It is executed on the Maker chain.
+// The order is locked until the Taker chain acknowledges the settlement of the bid.
+func (k Keeper) AcceptBid(goCtx context.Context, msg *types.AcceptBidMsg) (*types.MsgAcceptBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	order, ok := k.GetAtomicOrder(ctx, msg.OrderId)
+	if !ok || order.Id != msg.OrderId {
+		return nil, types.ErrOrderDoesNotExists
+	}
+
+	if order.Side != types.NATIVE {
+		return nil, errormod.Wrap(types.ErrInvalidOrderStatus, "bids are accepted on the maker chain")
+	}
+
+	// HTLC orders are not settled over IBC
+	if order.Kind != types.IBCOrder {
+		return nil, types.ErrInvalidOrderKind
+	}
+
+	// Make sure the sender is the maker of the order.
+	if order.Maker.MakerAddress != msg.MakerAddress {
+		return nil, types.ErrOrderPermissionIsNotAllowed
+	}
+
+	if order.Status != types.Status_SYNC {
+		return nil, types.ErrInvalidOrderStatus
+	}
+
+	if order.Maker.IsExpired(ctx.BlockTime().Unix()) {
+		return nil, types.ErrOrderExpired
+	}
+
+	if k.hasAcceptedBid(ctx, order.Id) {
+		return nil, types.ErrOrderBidAccepted
+	}
+
+	bid, found := k.GetBid(ctx, order.Id, msg.Bidder)
+	if !found {
+		return nil, types.ErrBidNotFound
+	}
+	if bid.Status != types.BID_PLACED {
+		return nil, types.ErrInvalidBidStatus
+	}
+
+	msgByte, err := k.PacketCodec(ctx, order.Maker.SourcePort, order.Maker.SourceChannel).MarshalMsg(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	packet := types.AtomicSwapPacketData{
+		Type:    types.ACCEPT_BID,
+		Data:    msgByte,
+		OrderId: order.Id,
+		Path:    order.Path,
+		Memo:    "",
+	}
+
+	if _, err := k.SendSwapPacket(ctx, order.Maker.SourcePort, order.Maker.SourceChannel, msg.TimeoutHeight, msg.TimeoutTimestamp, packet); err != nil {
+		return nil, err
+	}
+
+	bid.Status = types.BID_ACCEPTED
+	k.SetBid(ctx, bid)
+
+	emitBidEvent(ctx, types.EventValueActionAcceptBid, bid)
+	return &types.MsgAcceptBidResponse{OrderId: order.Id}, nil
+}
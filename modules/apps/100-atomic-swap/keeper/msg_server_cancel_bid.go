@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errormod "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// CancelBid is called when a bidder withdraws its bid. It is executed on the Taker chain,
+// the bid is refunded once the Maker chain acknowledges the cancellation.
+func (k Keeper) CancelBid(goCtx context.Context, msg *types.CancelBidMsg) (*types.MsgCancelBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	order, ok := k.GetAtomicOrder(ctx, msg.OrderId)
+	if !ok || order.Id != msg.OrderId {
+		return nil, types.ErrOrderDoesNotExists
+	}
+
+	if order.Side != types.REMOTE {
+		return nil, errormod.Wrap(types.ErrInvalidOrderStatus, "bids are cancelled on the taker chain")
+	}
+
+	bid, found := k.GetBid(ctx, order.Id, msg.Bidder)
+	if !found {
+		return nil, types.ErrBidNotFound
+	}
+	// a bid which is not yet recorded by the maker chain can not be cancelled there.
+	if bid.Status != types.BID_PLACED {
+		return nil, types.ErrInvalidBidStatus
+	}
+
+	sourcePort := extractSourcePortForTakerMsg(order.Path)
+	sourceChannel := extractSourceChannelForTakerMsg(order.Path)
+	msgByte, err := k.PacketCodec(ctx, sourcePort, sourceChannel).MarshalMsg(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	packet := types.AtomicSwapPacketData{
+		Type:    types.CANCEL_BID,
+		Data:    msgByte,
+		OrderId: order.Id,
+		Path:    order.Path,
+		Memo:    "",
+	}
+
+	if _, err := k.SendSwapPacket(ctx, sourcePort, sourceChannel, msg.TimeoutHeight, msg.TimeoutTimestamp, packet); err != nil {
+		return nil, err
+	}
+
+	emitBidEvent(ctx, types.EventValueActionCancelBid, bid)
+	return &types.MsgCancelBidResponse{OrderId: order.Id}, nil
+}
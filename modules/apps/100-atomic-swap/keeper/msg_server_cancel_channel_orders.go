@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errormod "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// CancelChannelOrders cancels the open orders of a channel which is already closed.
+// It can be submitted by anyone.
+func (k Keeper) CancelChannelOrders(goCtx context.Context, msg *types.CancelChannelOrdersMsg) (*types.MsgCancelChannelOrdersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId)
+	if !found {
+		return nil, errormod.Wrapf(types.ErrNotFoundChannel, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+	}
+	if channel.State != channeltypes.CLOSED {
+		return nil, errormod.Wrapf(types.ErrChannelNotClosed, "channel %s is %s", msg.ChannelId, channel.State)
+	}
+
+	return &types.MsgCancelChannelOrdersResponse{OrderIds: k.CancelClosedChannelOrders(ctx, msg.PortId, msg.ChannelId)}, nil
+}
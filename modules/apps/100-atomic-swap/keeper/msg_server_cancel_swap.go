@@ -16,16 +16,17 @@ func (k Keeper) CancelSwap(goCtx context.Context, msg *types.CancelSwapMsg) (*ty
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
-	msgbyte, err := types.ModuleCdc.MarshalJSON(msg)
-	if err != nil {
-		return nil, err
-	}
 
 	order, ok := k.GetAtomicOrder(ctx, msg.OrderId)
 	if !ok {
 		return &types.MsgCancelSwapResponse{}, types.ErrOrderDoesNotExists
 	}
 
+	// HTLC orders are not settled over IBC
+	if order.Kind != types.IBCOrder {
+		return &types.MsgCancelSwapResponse{}, types.ErrInvalidOrderKind
+	}
+
 	// Make sure the sender is the maker of the order.
 	if order.Maker.MakerAddress != msg.MakerAddress {
 		return &types.MsgCancelSwapResponse{}, fmt.Errorf("sender is not the maker of the order")
@@ -36,6 +37,16 @@ func (k Keeper) CancelSwap(goCtx context.Context, msg *types.CancelSwapMsg) (*ty
 		return &types.MsgCancelSwapResponse{}, fmt.Errorf("order is not in a valid state for cancellation")
 	}
 
+	// the order is reserved for an accepted bid until its settlement is acknowledged
+	if k.hasAcceptedBid(ctx, order.Id) {
+		return &types.MsgCancelSwapResponse{}, types.ErrOrderBidAccepted
+	}
+
+	msgbyte, err := k.PacketCodec(ctx, order.Maker.SourcePort, order.Maker.SourceChannel).MarshalMsg(msg)
+	if err != nil {
+		return nil, err
+	}
+
 	packet := types.AtomicSwapPacketData{
 		Type: types.CANCEL_SWAP,
 		Data: msgbyte,
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// ClaimHTLC releases the locked tokens of an HTLC order to its receiver once the preimage of the
+// hash lock is revealed. The preimage is stored on the order, so the sender can claim the
+// counterparty side of the swap with it.
+func (k Keeper) ClaimHTLC(goCtx context.Context, msg *types.ClaimHTLCMsg) (*types.MsgClaimHTLCResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	order, ok := k.GetAtomicOrder(ctx, msg.OrderId)
+	if !ok || order.Id != msg.OrderId {
+		return nil, types.ErrOrderDoesNotExists
+	}
+	if order.Kind != types.HTLCOrder {
+		return nil, types.ErrInvalidOrderKind
+	}
+	if order.Status != types.Status_SYNC {
+		return nil, types.ErrInvalidOrderStatus
+	}
+	if order.Maker.IsExpired(ctx.BlockTime().Unix()) {
+		return nil, types.ErrOrderExpired
+	}
+	if !order.Htlc.VerifyPreimage(msg.Preimage) {
+		return nil, types.ErrInvalidPreimage
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(order.Maker.DesiredTaker)
+	if err != nil {
+		return nil, err
+	}
+
+	// the swap fee is deducted from the receiver's proceeds
+	if err := k.sendProceeds(ctx, order.Maker.SourcePort, order.Maker.SourceChannel, receiver, order.Maker.SellToken); err != nil {
+		return nil, err
+	}
+
+	completeTimestamp := ctx.BlockTime().Unix()
+	take := &types.TakeSwapMsg{
+		OrderId:               order.Id,
+		SellToken:             order.Maker.BuyToken,
+		TakerAddress:          order.Maker.DesiredTaker,
+		TakerReceivingAddress: order.Maker.DesiredTaker,
+		CreateTimestamp:       completeTimestamp,
+	}
+	order.Htlc.Preimage = msg.Preimage
+	order.Fills = append(order.Fills, take)
+	order.Takers = take
+	order.Status = types.Status_COMPLETE
+	order.CompleteTimestamp = completeTimestamp
+	k.SetAtomicOrder(ctx, order)
+	// Move Completed assets to bottom
+	k.MoveOrderToBottom(ctx, order.Id)
+	emitOrderTaken(ctx, order, take, sdk.NewCoins(order.Maker.SellToken))
+	emitOrderCompleted(ctx, order)
+
+	emitHTLCEvent(ctx, types.EventValueActionClaimHTLC, order.Id)
+	return &types.MsgClaimHTLCResponse{OrderId: order.Id}, nil
+}
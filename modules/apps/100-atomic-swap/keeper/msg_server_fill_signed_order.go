@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errormod "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// FillSignedOrder takes an order quoted off-chain by its maker, it is executed on the Taker chain. The sender is
+// bound as the taker of the order and its buy tokens are locked in the escrow like a TakeSwap, then the signed
+// order is sent to the maker chain, which opens and settles it on receipt of the packet. The taker is refunded
+// if the maker chain rejects the order.
+func (k Keeper) FillSignedOrder(goCtx context.Context, msg *types.FillSignedOrderMsg) (*types.MsgFillSignedOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	signed := msg.Order
+	makeMsg := msg.MakeSwapMsg()
+	if makeMsg.IsExpired(ctx.BlockTime().Unix()) {
+		return nil, types.ErrOrderExpired
+	}
+
+	// the order is filled on the channel connected to the channel of the order on the maker chain
+	channel, found := k.channelKeeper.GetChannel(ctx, msg.SourcePort, msg.SourceChannel)
+	if !found {
+		return nil, types.ErrNotFoundChannel
+	}
+	if channel.Counterparty.PortId != signed.SourcePort || channel.Counterparty.ChannelId != signed.SourceChannel {
+		return nil, errormod.Wrapf(types.ErrNotFoundChannel, "channel %s is not connected to the channel %s of the order", msg.SourceChannel, signed.SourceChannel)
+	}
+
+	if err := k.ValidateSwapRoute(ctx, msg.SourcePort, msg.SourceChannel, makeMsg.Denoms()...); err != nil {
+		return nil, err
+	}
+
+	if makeMsg.IsBasket() {
+		if err := k.validateBasketChannel(ctx, msg.SourcePort, msg.SourceChannel); err != nil {
+			return nil, err
+		}
+	}
+
+	// The path id is derived from the sequence of the fill packet, a quote rejected by the maker chain can be filled again.
+	sequence, _ := k.channelKeeper.GetNextSequenceSend(ctx, msg.SourcePort, msg.SourceChannel)
+	pathID := fmt.Sprintf("%s-%d", ctx.ChainID(), sequence)
+	path := orderPath(signed.SourcePort, signed.SourceChannel, msg.SourcePort, msg.SourceChannel, pathID)
+	orderId := generateOrderId(path, makeMsg)
+	if _, found := k.GetAtomicOrder(ctx, orderId); found {
+		return nil, types.ErrOrderAlreadyExists
+	}
+
+	// Locks the buy tokens of the order to the escrow account
+	takeMsg := msg.TakeSwapMsg(orderId)
+	takerAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.sendToEscrow(ctx, msg.SourcePort, msg.SourceChannel, takerAddr, takeMsg.SellCoins()); err != nil {
+		return nil, err
+	}
+
+	msgByte, err := k.PacketCodec(ctx, msg.SourcePort, msg.SourceChannel).MarshalMsg(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	packet := types.AtomicSwapPacketData{
+		Type:    types.FILL_SIGNED_ORDER,
+		Data:    msgByte,
+		OrderId: orderId,
+		Path:    path,
+		Memo:    "",
+	}
+
+	if _, err := k.SendSwapPacket(ctx, msg.SourcePort, msg.SourceChannel, msg.TimeoutHeight, msg.TimeoutTimestamp, packet); err != nil {
+		return nil, err
+	}
+
+	// the order is occupied by the sender until the fill is acknowledged
+	order := types.Order{
+		Id:     orderId,
+		Side:   types.REMOTE,
+		Status: types.Status_SYNC,
+		Path:   path,
+		Maker:  makeMsg,
+		Takers: takeMsg,
+	}
+	k.AppendAtomicOrder(ctx, order)
+	emitOrderCreated(ctx, order)
+	emitFillSignedOrder(ctx, order, signed, types.EventValueActionFillSigned)
+
+	return &types.MsgFillSignedOrderResponse{OrderId: orderId}, nil
+}
+
+// OnReceivedFillSignedOrder opens and takes a signed order, it is executed on the Maker chain. The signature is
+// verified against the public key of the maker account and the sell tokens are pulled from the maker through the
+// send authorization it granted to the module account, then the take of the sender is settled like a TakeSwap.
+func (k Keeper) OnReceivedFillSignedOrder(ctx sdk.Context, packet channeltypes.Packet, orderId, path string, msg *types.FillSignedOrderMsg) (*types.MsgTakeSwapResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	signed := msg.Order
+	if signed.ChainId != ctx.ChainID() {
+		return nil, errormod.Wrapf(types.ErrInvalidSignature, "order signed for chain %s", signed.ChainId)
+	}
+	if packet.GetDestPort() != signed.SourcePort || packet.GetDestChannel() != signed.SourceChannel {
+		return nil, errormod.Wrapf(types.ErrNotFoundChannel, "order signed for channel %s", signed.SourceChannel)
+	}
+
+	makeMsg := msg.MakeSwapMsg()
+	if makeMsg.IsExpired(ctx.BlockTime().Unix()) {
+		return nil, types.ErrOrderExpired
+	}
+
+	if k.IsNonceUsed(ctx, signed.MakerAddress, signed.Nonce) {
+		return nil, errormod.Wrapf(types.ErrNonceUsed, "maker %s nonce %d", signed.MakerAddress, signed.Nonce)
+	}
+
+	maker, err := sdk.AccAddressFromBech32(signed.MakerAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.verifyOrderSignature(ctx, maker, signed.GetSignBytes(), msg.Signature); err != nil {
+		return nil, err
+	}
+
+	if err := k.ValidateSwapRoute(ctx, packet.GetDestPort(), packet.GetDestChannel(), makeMsg.Denoms()...); err != nil {
+		return nil, err
+	}
+
+	if makeMsg.IsBasket() {
+		if err := k.validateBasketChannel(ctx, packet.GetDestPort(), packet.GetDestChannel()); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, found := k.GetAtomicOrder(ctx, orderId); found {
+		return nil, types.ErrOrderAlreadyExists
+	}
+
+	// pull the sell tokens from the maker into the escrow
+	escrowAddr := types.GetEscrowAddress(makeMsg.SourcePort, makeMsg.SourceChannel)
+	moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+	send := banktypes.NewMsgSend(maker, escrowAddr, makeMsg.SellCoins())
+	if _, err := k.authzKeeper.DispatchActions(ctx, moduleAddr, []sdk.Msg{send}); err != nil {
+		return nil, errormod.Wrapf(types.ErrFailedMakeSwap, "failed to pull the sell tokens of the maker: %s", err)
+	}
+	k.TrackEscrowInflow(ctx, makeMsg.SourcePort, makeMsg.SourceChannel, makeMsg.SellCoins())
+
+	// the nonce is used as soon as the quote is filled, the state of a rejected fill is not committed
+	k.SetNonceUsed(ctx, signed.MakerAddress, signed.Nonce)
+
+	order := types.Order{
+		Id:     orderId,
+		Side:   types.NATIVE,
+		Status: types.Status_SYNC,
+		Path:   path,
+		Maker:  makeMsg,
+	}
+	k.AppendAtomicOrder(ctx, order)
+	emitOrderCreated(ctx, order)
+
+	res, err := k.OnReceivedTake(ctx, packet, msg.TakeSwapMsg(orderId))
+	if err != nil {
+		return nil, err
+	}
+	emitFillSignedOrder(ctx, order, signed, types.GetEventValueWithSuffix(types.EventValueActionFillSigned, types.EventValueSuffixReceived))
+	return res, nil
+}
+
+func emitFillSignedOrder(ctx sdk.Context, order types.Order, signed types.SignedOrder, action string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.Attribute{
+				Key:   types.AttributeAction,
+				Value: action,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeOrderId,
+				Value: order.Id,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeMaker,
+				Value: signed.MakerAddress,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeNonce,
+				Value: strconv.FormatUint(signed.Nonce, 10),
+			},
+			sdk.Attribute{
+				Key:   types.AttributeName,
+				Value: types.EventOwner,
+			},
+		),
+	)
+}
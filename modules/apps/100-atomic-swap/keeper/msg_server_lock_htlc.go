@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// LockHTLC is called when the sender wants to swap with a chain which is not connected over IBC.
+// The sell token is locked in the HTLC escrow account until the order is claimed with the preimage
+// of its hash lock, or refunded once its timelock is reached.
+func (k Keeper) LockHTLC(goCtx context.Context, msg *types.LockHTLCMsg) (*types.MsgLockHTLCResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if !k.GetSwapEnabled(ctx) {
+		return nil, types.ErrSendDisabled
+	}
+
+	order := createHTLCOrder(msg)
+	// HTLC orders are not carried by a channel, only their denoms are listed
+	if err := k.ValidateSwapDenoms(ctx, order.Maker.Denoms()...); err != nil {
+		return nil, err
+	}
+	if order.Maker.IsExpired(ctx.BlockTime().Unix()) {
+		return nil, types.ErrOrderExpired
+	}
+	if existing, found := k.GetAtomicOrder(ctx, order.Id); found && existing.Id == order.Id {
+		return nil, types.ErrOrderAlreadyExists
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// lock sell token into module
+	if err := k.sendToEscrow(ctx, order.Maker.SourcePort, order.Maker.SourceChannel, sender, sdk.NewCoins(msg.SellToken)); err != nil {
+		return nil, err
+	}
+
+	// the timelock is tracked by the expiration queue, which refunds the order once it is reached
+	k.AppendAtomicOrder(ctx, *order)
+	emitOrderCreated(ctx, *order)
+
+	emitHTLCEvent(ctx, types.EventValueActionLockHTLC, order.Id)
+	return &types.MsgLockHTLCResponse{OrderId: order.Id}, nil
+}
+
+// createHTLCOrder builds the order of an HTLC. The order is open as soon as it is created,
+// its receiver is the desired taker and its timelock the expiration timestamp. The hash lock is
+// part of the order id, so that a maker can lock the same tokens under different secrets.
+func createHTLCOrder(msg *types.LockHTLCMsg) *types.Order {
+	maker := &types.MakeSwapMsg{
+		SourcePort:            types.PortID,
+		SourceChannel:         types.HTLCChannelID,
+		SellToken:             msg.SellToken,
+		BuyToken:              msg.BuyToken,
+		MakerAddress:          msg.Sender,
+		MakerReceivingAddress: msg.SenderReceivingAddress,
+		DesiredTaker:          msg.Receiver,
+		CreateTimestamp:       msg.CreateTimestamp,
+		ExpirationTimestamp:   msg.Timelock,
+	}
+	return &types.Order{
+		Id:     generateOrderId(types.HTLCChannelID+"/"+hex.EncodeToString(msg.HashLock), maker),
+		Side:   types.NATIVE,
+		Status: types.Status_SYNC,
+		Maker:  maker,
+		Kind:   types.HTLCOrder,
+		Htlc:   &types.HashTimeLock{HashLock: msg.HashLock},
+	}
+}
+
+func emitHTLCEvent(ctx sdk.Context, action, orderId string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.Attribute{
+				Key:   types.AttributeAction,
+				Value: action,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeOrderId,
+				Value: orderId,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeName,
+				Value: types.EventOwner,
+			},
+		),
+	)
+}
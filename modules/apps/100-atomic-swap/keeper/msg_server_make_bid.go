@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errormod "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// MakeBid is called when a taker wants to propose a different price for an order.
+// It is executed on the Taker chain, the bid is locked in the escrow account and sent to the Maker chain.
+func (k Keeper) MakeBid(goCtx context.Context, msg *types.MakeBidMsg) (*types.MsgMakeBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	order, ok := k.GetAtomicOrder(ctx, msg.OrderId)
+	if !ok || order.Id != msg.OrderId {
+		return nil, types.ErrOrderDoesNotExists
+	}
+
+	// bids are locked on the taker chain, like the sell token of a take.
+	if order.Side != types.REMOTE {
+		return nil, errormod.Wrap(types.ErrInvalidOrderStatus, "bids are made on the taker chain")
+	}
+
+	if err := k.validateBid(ctx, order, msg.Bidder, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// Checks if the order has already been taken
+	if order.Takers != nil {
+		return nil, types.ErrAlreadyOrderTook
+	}
+
+	bidderAddr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	sourcePort := extractSourcePortForTakerMsg(order.Path)
+	sourceChannel := extractSourceChannelForTakerMsg(order.Path)
+	if err := k.ValidateSwapRoute(ctx, sourcePort, sourceChannel, append(order.Maker.Denoms(), msg.Amount.Denom)...); err != nil {
+		return nil, err
+	}
+
+	// Locks the bid to the escrow account
+	if err := k.sendToEscrow(ctx, sourcePort, sourceChannel, bidderAddr, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	msgByte, err := k.PacketCodec(ctx, sourcePort, sourceChannel).MarshalMsg(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	packet := types.AtomicSwapPacketData{
+		Type:    types.MAKE_BID,
+		Data:    msgByte,
+		OrderId: order.Id,
+		Path:    order.Path,
+		Memo:    "",
+	}
+
+	if _, err := k.SendSwapPacket(ctx, sourcePort, sourceChannel, msg.TimeoutHeight, msg.TimeoutTimestamp, packet); err != nil {
+		return nil, err
+	}
+
+	bid := types.Bid{
+		OrderId:                order.Id,
+		Bidder:                 msg.Bidder,
+		BidderReceivingAddress: msg.BidderReceivingAddress,
+		Amount:                 msg.Amount,
+		Status:                 types.BID_INITIAL,
+		CreateTimestamp:        msg.CreateTimestamp,
+	}
+	k.SetBid(ctx, bid)
+
+	emitBidEvent(ctx, types.EventValueActionMakeBid, bid)
+	return &types.MsgMakeBidResponse{OrderId: order.Id}, nil
+}
@@ -18,9 +18,8 @@ func (k Keeper) MakeSwap(goCtx context.Context, msg *types.MakeSwapMsg) (*types.
 		return nil, err
 	}
 
-	msgByte, err0 := types.ModuleCdc.MarshalJSON(msg)
-	if err0 != nil {
-		return nil, err0
+	if msg.IsExpired(ctx.BlockTime().Unix()) {
+		return nil, types.ErrOrderExpired
 	}
 
 	sender, err1 := sdk.AccAddressFromBech32(msg.MakerAddress)
@@ -28,17 +27,41 @@ func (k Keeper) MakeSwap(goCtx context.Context, msg *types.MakeSwapMsg) (*types.
 		return nil, err1
 	}
 
-	balance := k.bankKeeper.GetBalance(ctx, sender, msg.SellToken.Denom)
-	if balance.Amount.LT(msg.SellToken.Amount) {
-		return &types.MsgMakeSwapResponse{}, errors.New("insufficient balance")
+	if err := k.ValidateSwapRoute(ctx, msg.SourcePort, msg.SourceChannel, msg.Denoms()...); err != nil {
+		return nil, err
+	}
+
+	if msg.IsBasket() {
+		if err := k.validateBasketChannel(ctx, msg.SourcePort, msg.SourceChannel); err != nil {
+			return nil, err
+		}
 	}
 
-	escrowAddr := types.GetEscrowAddress(msg.SourcePort, msg.SourceChannel)
+	sellCoins := msg.SellCoins()
+	for _, sellToken := range sellCoins {
+		balance := k.bankKeeper.GetBalance(ctx, sender, sellToken.Denom)
+		if balance.Amount.LT(sellToken.Amount) {
+			return &types.MsgMakeSwapResponse{}, errors.New("insufficient balance")
+		}
+	}
 
-	// lock sell token into module
-	if err := k.bankKeeper.SendCoins(
-		ctx, sender, escrowAddr, sdk.NewCoins(msg.SellToken),
-	); err != nil {
+	// lock sell tokens into module
+	if err := k.sendToEscrow(ctx, msg.SourcePort, msg.SourceChannel, sender, sellCoins); err != nil {
+		return nil, err
+	}
+
+	order, err := k.openOrder(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgMakeSwapResponse{OrderId: order.Id}, nil
+}
+
+// openOrder creates the order of a make swap message whose sell tokens have been locked,
+// and sends the make swap packet to the taker chain.
+func (k Keeper) openOrder(ctx sdk.Context, msg *types.MakeSwapMsg) (*types.Order, error) {
+	msgByte, err := k.PacketCodec(ctx, msg.SourcePort, msg.SourceChannel).MarshalMsg(msg)
+	if err != nil {
 		return nil, err
 	}
 
@@ -60,6 +83,7 @@ func (k Keeper) MakeSwap(goCtx context.Context, msg *types.MakeSwapMsg) (*types.
 	}
 
 	k.AppendAtomicOrder(ctx, *order)
+	emitOrderCreated(ctx, *order)
 
 	// Event have to follow
 	/*
@@ -83,5 +107,5 @@ func (k Keeper) MakeSwap(goCtx context.Context, msg *types.MakeSwapMsg) (*types.
 			},
 		),
 	)
-	return &types.MsgMakeSwapResponse{OrderId: order.Id}, nil
+	return order, nil
 }
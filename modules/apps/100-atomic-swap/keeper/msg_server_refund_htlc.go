@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// RefundHTLC returns the locked tokens of an HTLC order to its sender once the timelock is reached.
+// Expired orders are also refunded by the EndBlocker, the message allows to refund them right away.
+func (k Keeper) RefundHTLC(goCtx context.Context, msg *types.RefundHTLCMsg) (*types.MsgRefundHTLCResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	order, ok := k.GetAtomicOrder(ctx, msg.OrderId)
+	if !ok || order.Id != msg.OrderId {
+		return nil, types.ErrOrderDoesNotExists
+	}
+	if order.Kind != types.HTLCOrder {
+		return nil, types.ErrInvalidOrderKind
+	}
+	if order.Status != types.Status_SYNC {
+		return nil, types.ErrInvalidOrderStatus
+	}
+	if !order.Maker.IsExpired(ctx.BlockTime().Unix()) {
+		return nil, types.ErrOrderNotExpired
+	}
+
+	// the refund is the expiration of the order
+	if err := k.expireOrder(ctx, order); err != nil {
+		return nil, err
+	}
+	k.RemoveFromExpiringOrderQueue(ctx, order.Id, order.Maker.ExpirationTimestamp)
+
+	emitHTLCEvent(ctx, types.EventValueActionRefundHTLC, order.Id)
+	return &types.MsgRefundHTLCResponse{OrderId: order.Id}, nil
+}
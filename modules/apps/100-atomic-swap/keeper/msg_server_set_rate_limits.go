@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errormod "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// SetRateLimits sets the quotas of the escrow outflows by channel and denom. It can only be
+// executed by the governance module account.
+func (k Keeper) SetRateLimits(goCtx context.Context, msg *types.SetRateLimitsMsg) (*types.MsgSetRateLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if msg.Authority != k.authority {
+		return nil, errormod.Wrapf(errormod.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	for _, rateLimit := range msg.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.Attribute{
+				Key:   types.AttributeAction,
+				Value: types.EventValueActionSetRateLimits,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeName,
+				Value: types.EventOwner,
+			},
+		),
+	)
+	return &types.MsgSetRateLimitsResponse{}, nil
+}
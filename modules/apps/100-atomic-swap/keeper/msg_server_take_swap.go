@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errormod "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
 )
 
@@ -17,32 +18,48 @@ func (k Keeper) TakeSwap(goCtx context.Context, msg *types.TakeSwapMsg) (*types.
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
-	msgByte, err0 := types.ModuleCdc.MarshalJSON(msg)
-	if err0 != nil {
-		return nil, err0
-	}
 
 	order, ok := k.GetAtomicOrder(ctx, msg.OrderId)
 	if !ok {
 		return nil, types.ErrOrderDoesNotExists
 	}
 
+	// HTLC orders are not settled over IBC
+	if order.Kind != types.IBCOrder {
+		return nil, types.ErrInvalidOrderKind
+	}
+
 	sourceChannel := extractSourceChannelForTakerMsg(order.Path)
 	sourcePort := extractSourcePortForTakerMsg(order.Path)
 
-	escrowAddr := types.GetEscrowAddress(sourcePort, sourceChannel)
+	if err := k.ValidateSwapRoute(ctx, sourcePort, sourceChannel, order.Maker.Denoms()...); err != nil {
+		return nil, err
+	}
 
 	if order.Status != types.Status_SYNC && order.Status != types.Status_INITIAL {
 		return nil, errors.New("order is not in valid state")
 	}
 
+	if order.Maker.IsExpired(ctx.BlockTime().Unix()) {
+		return nil, types.ErrOrderExpired
+	}
+
 	// Make sure the maker's buy token matches the taker's sell token
-	if order.Maker.BuyToken.Denom != msg.SellToken.Denom && !order.Maker.BuyToken.Amount.Equal(msg.SellToken.Amount) {
-		return &types.MsgTakeSwapResponse{}, errors.New("invalid sell token")
+	if order.Maker.IsBasket() {
+		if err := order.ValidateBasketFill(msg.SellCoins()); err != nil {
+			return &types.MsgTakeSwapResponse{}, err
+		}
+	} else if order.Maker.AllowPartialFill || order.Maker.PriceSchedule != nil {
+		if err := order.ValidateFill(msg.SellToken, ctx.BlockTime().Unix()); err != nil {
+			return &types.MsgTakeSwapResponse{}, err
+		}
+	} else if order.Maker.BuyToken.Denom != msg.SellToken.Denom || !order.Maker.BuyToken.Amount.Equal(msg.SellToken.Amount) {
+		return &types.MsgTakeSwapResponse{}, errormod.Wrapf(types.ErrInvalidSellToken, "expected %s, got %s", order.Maker.BuyToken, msg.SellToken)
 	}
 
-	// Checks if the order has already been taken
-	if order.Takers != nil {
+	// Checks if the order has already been taken, partially filled orders accept concurrent takes
+	// and the maker chain rejects the ones exceeding the remaining amount.
+	if order.Takers != nil && !order.Maker.AllowPartialFill {
 		return &types.MsgTakeSwapResponse{}, errors.New("order has already been taken")
 	}
 
@@ -61,16 +78,24 @@ func (k Keeper) TakeSwap(goCtx context.Context, msg *types.TakeSwapMsg) (*types.
 		return &types.MsgTakeSwapResponse{}, types.ErrFailedMakeSwap
 	}
 
-	balance := k.bankKeeper.GetBalance(ctx, takerAddr, msg.SellToken.Denom)
-	if balance.Amount.LT(msg.SellToken.Amount) {
-		return &types.MsgTakeSwapResponse{}, errors.New("insufficient balance")
+	sellCoins := msg.SellCoins()
+	for _, sellToken := range sellCoins {
+		balance := k.bankKeeper.GetBalance(ctx, takerAddr, sellToken.Denom)
+		if balance.Amount.LT(sellToken.Amount) {
+			return &types.MsgTakeSwapResponse{}, errors.New("insufficient balance")
+		}
 	}
 
-	// Locks the sellToken to the escrow account
-	if err = k.bankKeeper.SendCoins(ctx, takerAddr, escrowAddr, sdk.NewCoins(msg.SellToken)); err != nil {
+	// Locks the sell tokens to the escrow account
+	if err = k.sendToEscrow(ctx, sourcePort, sourceChannel, takerAddr, sellCoins); err != nil {
 		return &types.MsgTakeSwapResponse{}, err
 	}
 
+	msgByte, err := k.PacketCodec(ctx, sourcePort, sourceChannel).MarshalMsg(msg)
+	if err != nil {
+		return nil, err
+	}
+
 	packet := types.AtomicSwapPacketData{
 		Type: types.TAKE_SWAP,
 		Data: msgByte,
@@ -83,8 +108,11 @@ func (k Keeper) TakeSwap(goCtx context.Context, msg *types.TakeSwapMsg) (*types.
 
 	// Update order state
 	// Mark that the order has been occupied
-	order.Takers = msg
-	k.SetAtomicOrder(ctx, order)
+	if !order.Maker.AllowPartialFill {
+		order.Takers = msg
+		k.SetAtomicOrder(ctx, order)
+	}
+	emitOrderTaken(ctx, order, msg, order.ReleasedSellTokens(msg.SellToken))
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.ModuleName,
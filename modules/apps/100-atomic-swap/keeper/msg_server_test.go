@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -74,3 +77,79 @@ func (suite *KeeperTestSuite) TestMsgSwap() {
 		}
 	}
 }
+
+// TestOrderIdDerivation pins the derivation of the order id, which every validator must compute the same:
+// the path id is the chain id with the sequence of the make swap packet.
+func (suite *KeeperTestSuite) TestOrderIdDerivation() {
+	path := NewSwapPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	maker := suite.chainA.SenderAccount.GetAddress().String()
+	msg := types.NewMsgMakeSwap(
+		types.PortID, path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)),
+		maker, maker, "",
+		suite.chainB.GetTimeoutHeight(), 0,
+		ctx.BlockTime().Unix(),
+	)
+	msgBytes, err := types.ModuleCdc.MarshalJSON(msg)
+	suite.Require().NoError(err)
+
+	var orderIds []string
+	for sequence := uint64(1); sequence <= 2; sequence++ {
+		res, err := k.MakeSwap(sdk.WrapSDKContext(ctx), msg)
+		suite.Require().NoError(err)
+
+		orderPath := fmt.Sprintf("channel/%s/port/%s/channel/%s/port/%s/%s-%d",
+			path.EndpointA.ChannelID, types.PortID, path.EndpointB.ChannelID, types.PortID, ctx.ChainID(), sequence)
+		hash := sha256.Sum256(append([]byte(orderPath), msgBytes...))
+		suite.Require().Equal(hex.EncodeToString(hash[:])[:20], res.OrderId)
+
+		order, found := k.GetAtomicOrder(ctx, res.OrderId)
+		suite.Require().True(found)
+		suite.Require().Equal(orderPath, order.Path)
+		orderIds = append(orderIds, res.OrderId)
+	}
+
+	// the same make swap message opens a new order with every packet
+	suite.Require().NotEqual(orderIds[0], orderIds[1])
+}
+
+func (suite *KeeperTestSuite) TestTakeSwapSellToken() {
+	path := NewSwapPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// chain B is the taker chain of an order made on chain A
+	ctx := suite.chainB.GetContext()
+	k := suite.chainB.GetSimApp().AtomicSwapKeeper
+	maker := suite.chainA.SenderAccount.GetAddress().String()
+	taker := suite.chainB.SenderAccount.GetAddress().String()
+	buyToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))
+	makeMsg := types.NewMsgMakeSwap(
+		types.PortID, path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), buyToken,
+		maker, taker, "",
+		suite.chainB.GetTimeoutHeight(), 0,
+		ctx.BlockTime().Unix(),
+	)
+	k.AppendAtomicOrder(ctx, types.Order{
+		Id:     "order",
+		Side:   types.REMOTE,
+		Status: types.Status_SYNC,
+		Path:   fmt.Sprintf("channel/%s/port/%s/channel/%s/port/%s/id", path.EndpointA.ChannelID, types.PortID, path.EndpointB.ChannelID, types.PortID),
+		Maker:  makeMsg,
+	})
+
+	take := func(sellToken sdk.Coin) error {
+		_, err := k.TakeSwap(sdk.WrapSDKContext(ctx), types.NewMsgTakeSwap("order", sellToken, taker, "receiver", suite.chainA.GetTimeoutHeight(), 0, ctx.BlockTime().Unix()))
+		return err
+	}
+
+	// the sell token of the taker must match the buy token of the maker in denom and amount
+	suite.Require().ErrorIs(take(sdk.NewCoin("osmo", buyToken.Amount)), types.ErrInvalidSellToken)
+	suite.Require().ErrorIs(take(sdk.NewCoin(buyToken.Denom, sdk.NewInt(49))), types.ErrInvalidSellToken)
+	suite.Require().ErrorIs(take(sdk.NewCoin("osmo", sdk.NewInt(49))), types.ErrInvalidSellToken)
+	suite.Require().NoError(take(buyToken))
+}
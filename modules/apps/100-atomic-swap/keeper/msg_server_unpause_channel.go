@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errormod "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// UnpauseChannel resumes the escrow outflows of a channel paused by its rate limits and starts
+// a new window for them. It can only be executed by the governance module account.
+func (k Keeper) UnpauseChannel(goCtx context.Context, msg *types.UnpauseChannelMsg) (*types.MsgUnpauseChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if msg.Authority != k.authority {
+		return nil, errormod.Wrapf(errormod.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	if !k.IsChannelPaused(ctx, msg.PortId, msg.ChannelId) {
+		return nil, errormod.Wrapf(errormod.ErrInvalidRequest, "port ID (%s) channel ID (%s) is not paused", msg.PortId, msg.ChannelId)
+	}
+
+	k.SetChannelPaused(ctx, msg.PortId, msg.ChannelId, false)
+	// the net outflow which paused the channel would pause it again on the next outflow
+	k.resetChannelFlows(ctx, msg.PortId, msg.ChannelId)
+
+	emitChannelPauseEvent(ctx, types.EventValueActionUnpauseChannel, msg.PortId, msg.ChannelId, "")
+	return &types.MsgUnpauseChannelResponse{}, nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errormod "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// UpdateSwapLists adds and removes entries of the swap allowlist and denylist. It can only be
+// executed by the governance module account.
+func (k Keeper) UpdateSwapLists(goCtx context.Context, msg *types.UpdateSwapListsMsg) (*types.MsgUpdateSwapListsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if msg.Authority != k.authority {
+		return nil, errormod.Wrapf(errormod.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	for _, entry := range msg.Remove {
+		k.RemoveListEntry(ctx, entry)
+	}
+	for _, entry := range msg.Add {
+		k.SetListEntry(ctx, entry)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.Attribute{
+				Key:   types.AttributeAction,
+				Value: types.EventValueActionUpdateSwapLists,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeName,
+				Value: types.EventOwner,
+			},
+		),
+	)
+	return &types.MsgUpdateSwapListsResponse{}, nil
+}
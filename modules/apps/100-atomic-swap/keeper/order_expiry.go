@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// InsertExpiringOrderQueue adds an order to the time-ordered expiration queue.
+func (k Keeper) InsertExpiringOrderQueue(ctx sdk.Context, orderId string, expiration uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderExpirationQueueKey)
+	store.Set(types.OrderExpirationQueueKey(expiration, orderId), []byte(orderId))
+}
+
+// RemoveFromExpiringOrderQueue removes an order from the expiration queue.
+func (k Keeper) RemoveFromExpiringOrderQueue(ctx sdk.Context, orderId string, expiration uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderExpirationQueueKey)
+	store.Delete(types.OrderExpirationQueueKey(expiration, orderId))
+}
+
+// IterateExpiredOrdersQueue iterates over the orders whose expiration timestamp is
+// at or before the given timestamp and calls cb for each of them until cb returns true.
+func (k Keeper) IterateExpiredOrdersQueue(ctx sdk.Context, endTime uint64, cb func(orderId string, expiration uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderExpirationQueueKey)
+	iterator := store.Iterator(nil, types.OrderExpirationQueuePrefix(endTime+1))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		expiration := GetBidIDFromBytes(iterator.Key()[:8])
+		if cb(string(iterator.Value()), expiration) {
+			break
+		}
+	}
+}
+
+// ExpireOrders is called in the EndBlocker. It pops the orders whose expiration timestamp
+// has been reached from the queue, at most MaxExpiredOrdersPerBlock of them, and moves them
+// to the EXPIRED status. On the maker chain the unfilled sell token is refunded to the maker
+// from the channel escrow. An order which fails to expire is retried after ExpiredOrderRetryDelay.
+func (k Keeper) ExpireOrders(ctx sdk.Context) {
+	blockTime := ctx.BlockTime().Unix()
+	if blockTime < 0 {
+		return
+	}
+
+	type expiredEntry struct {
+		orderId    string
+		expiration uint64
+	}
+	var expired []expiredEntry
+	k.IterateExpiredOrdersQueue(ctx, uint64(blockTime), func(orderId string, expiration uint64) bool {
+		expired = append(expired, expiredEntry{orderId, expiration})
+		return len(expired) >= types.MaxExpiredOrdersPerBlock
+	})
+
+	for _, entry := range expired {
+		k.RemoveFromExpiringOrderQueue(ctx, entry.orderId, entry.expiration)
+		order, found := k.GetAtomicOrder(ctx, entry.orderId)
+		if !found || order.Id != entry.orderId {
+			continue
+		}
+		// the refunds of an order are applied all together or not at all
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.expireOrder(cacheCtx, order); err != nil {
+			// the order is queued again for a later block, so that it does not hold the head of the queue
+			retry := uint64(blockTime) + types.ExpiredOrderRetryDelay
+			k.InsertExpiringOrderQueue(ctx, order.Id, retry)
+			k.Logger(ctx).Error("failed to expire order", "order_id", order.Id, "retry", retry, "error", err)
+			continue
+		}
+		write()
+	}
+}
+
+func (k Keeper) expireOrder(ctx sdk.Context, order types.Order) error {
+	if order.Status != types.Status_INITIAL && order.Status != types.Status_SYNC {
+		return nil
+	}
+
+	var refund sdk.Coins
+	switch order.Side {
+	case types.NATIVE:
+		// the order is resolved by the settlement of the accepted bid.
+		if k.hasAcceptedBid(ctx, order.Id) {
+			return nil
+		}
+		// refund the locked sell token to the maker on the maker chain.
+		makerAddr, err := sdk.AccAddressFromBech32(order.Maker.MakerAddress)
+		if err != nil {
+			return err
+		}
+		refund = order.RemainingSellTokens()
+		if err := k.refundFromEscrow(ctx, order.Maker.SourcePort, order.Maker.SourceChannel, makerAddr, refund); err != nil {
+			return err
+		}
+	case types.REMOTE:
+		// a take is in flight, the order is resolved by its acknowledgement.
+		if order.Takers != nil {
+			return nil
+		}
+	}
+
+	order.Status = types.Status_EXPIRED
+	k.SetAtomicOrder(ctx, order)
+
+	if err := k.refundOrderBids(ctx, order, "", types.ReasonExpired); err != nil {
+		return err
+	}
+	if order.Side == types.NATIVE {
+		emitOrderRefunded(ctx, order, order.Maker.MakerAddress, refund, types.ReasonExpired)
+	}
+	emitOrderCancelled(ctx, order, types.ReasonExpired)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.Attribute{
+				Key:   types.AttributeAction,
+				Value: types.EventValueActionExpireOrder,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeOrderId,
+				Value: order.Id,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeName,
+				Value: types.EventOwner,
+			},
+		),
+	)
+	return nil
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+	ibctesting "github.com/sideprotocol/ibcswap/v6/testing"
+)
+
+func (suite *KeeperTestSuite) TestExpireOrders() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	maker := suite.chainA.SenderAccount.GetAddress()
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	escrowAddr := types.GetEscrowAddress(types.PortID, ibctesting.FirstChannelID)
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, maker, escrowAddr, sdk.NewCoins(sellToken)))
+
+	expiration := ctx.BlockTime().Add(time.Hour).Unix()
+	makeMsg := types.NewMsgMakeSwap(
+		types.PortID, ibctesting.FirstChannelID,
+		sellToken, sellToken,
+		maker.String(), maker.String(), "",
+		suite.chainB.GetTimeoutHeight(), 0,
+		ctx.BlockTime().Unix(),
+	)
+	makeMsg.ExpirationTimestamp = uint64(expiration)
+	k.AppendAtomicOrder(ctx, types.Order{
+		Id:     "expiring",
+		Side:   types.NATIVE,
+		Status: types.Status_SYNC,
+		Maker:  makeMsg,
+	})
+
+	// not expired yet
+	k.ExpireOrders(ctx)
+	order, found := k.GetAtomicOrder(ctx, "expiring")
+	suite.Require().True(found)
+	suite.Require().Equal(types.Status_SYNC, order.Status)
+
+	balanceBefore := bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom)
+
+	ctx = ctx.WithBlockTime(time.Unix(expiration, 0))
+	k.ExpireOrders(ctx)
+	order, found = k.GetAtomicOrder(ctx, "expiring")
+	suite.Require().True(found)
+	suite.Require().Equal(types.Status_EXPIRED, order.Status)
+
+	// the sell token is refunded to the maker
+	balanceAfter := bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceBefore.Add(sellToken), balanceAfter)
+	suite.Require().True(bankKeeper.GetBalance(ctx, escrowAddr, sdk.DefaultBondDenom).IsZero())
+
+	// the order is popped from the queue and is not refunded twice
+	k.ExpireOrders(ctx.WithBlockTime(time.Unix(expiration+1, 0)))
+	suite.Require().Equal(balanceAfter, bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom))
+}
+
+func (suite *KeeperTestSuite) TestExpireOrdersPerBlock() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+
+	expiration := ctx.BlockTime().Add(time.Hour).Unix()
+	for i := 0; i < types.MaxExpiredOrdersPerBlock+1; i++ {
+		k.AppendAtomicOrder(ctx, types.Order{
+			Id:     fmt.Sprintf("expiring%d", i),
+			Side:   types.REMOTE,
+			Status: types.Status_SYNC,
+			Maker:  &types.MakeSwapMsg{ExpirationTimestamp: uint64(expiration)},
+		})
+	}
+
+	expiredCount := func() int {
+		count := 0
+		for _, order := range k.GetAllOrder(ctx) {
+			if order.Status == types.Status_EXPIRED {
+				count++
+			}
+		}
+		return count
+	}
+
+	// the orders beyond the limit are expired in the next block
+	ctx = ctx.WithBlockTime(time.Unix(expiration, 0))
+	k.ExpireOrders(ctx)
+	suite.Require().Equal(types.MaxExpiredOrdersPerBlock, expiredCount())
+
+	k.ExpireOrders(ctx)
+	suite.Require().Equal(types.MaxExpiredOrdersPerBlock+1, expiredCount())
+}
+
+func (suite *KeeperTestSuite) TestExpireOrdersRetry() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	maker := suite.chainA.SenderAccount.GetAddress()
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	escrowAddr := types.GetEscrowAddress(types.PortID, ibctesting.FirstChannelID)
+
+	expiration := ctx.BlockTime().Add(time.Hour).Unix()
+	makeMsg := types.NewMsgMakeSwap(
+		types.PortID, ibctesting.FirstChannelID,
+		sellToken, sellToken,
+		maker.String(), maker.String(), "",
+		suite.chainB.GetTimeoutHeight(), 0,
+		ctx.BlockTime().Unix(),
+	)
+	makeMsg.ExpirationTimestamp = uint64(expiration)
+	k.AppendAtomicOrder(ctx, types.Order{
+		Id:     "unfunded",
+		Side:   types.NATIVE,
+		Status: types.Status_SYNC,
+		Maker:  makeMsg,
+	})
+
+	// the refund fails as the escrow does not hold the sell token, the order stays open
+	ctx = ctx.WithBlockTime(time.Unix(expiration, 0))
+	k.ExpireOrders(ctx)
+	order, found := k.GetAtomicOrder(ctx, "unfunded")
+	suite.Require().True(found)
+	suite.Require().Equal(types.Status_SYNC, order.Status)
+
+	// the order is not retried before the retry delay
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, maker, escrowAddr, sdk.NewCoins(sellToken)))
+	k.ExpireOrders(ctx.WithBlockTime(time.Unix(expiration+types.ExpiredOrderRetryDelay-1, 0)))
+	order, _ = k.GetAtomicOrder(ctx, "unfunded")
+	suite.Require().Equal(types.Status_SYNC, order.Status)
+
+	// the order is expired and refunded by the retry
+	balance := bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom)
+	k.ExpireOrders(ctx.WithBlockTime(time.Unix(expiration+types.ExpiredOrderRetryDelay, 0)))
+	order, _ = k.GetAtomicOrder(ctx, "unfunded")
+	suite.Require().Equal(types.Status_EXPIRED, order.Status)
+	suite.Require().Equal(balance.Add(sellToken), bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom))
+}
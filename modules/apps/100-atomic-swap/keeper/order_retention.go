@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// GetTerminalOrderCount returns the number of completed, cancelled and expired orders in the store.
+func (k Keeper) GetTerminalOrderCount(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.TerminalOrderCountKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setTerminalOrderCount(ctx sdk.Context, count uint64) {
+	ctx.KVStore(k.storeKey).Set(types.TerminalOrderCountKey, sdk.Uint64ToBigEndian(count))
+}
+
+// setOrderRetention adds a completed, cancelled or expired order stored at the given position
+// of the order book to the retention queue, sorted by the block time at which it was closed.
+func (k Keeper) setOrderRetention(ctx sdk.Context, order types.Order, count uint64) {
+	closeTimestamp, closed := order.CloseTimestamp()
+	if !closed {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := append(types.OrderRetentionQueuePrefix(closeTimestamp), GetOrderIDBytes(count)...)
+	if store.Has(key) {
+		return
+	}
+	store.Set(key, []byte(order.Id))
+	k.setTerminalOrderCount(ctx, k.GetTerminalOrderCount(ctx)+1)
+}
+
+// removeOrderRetention removes an order stored at the given position of the order book from the retention queue.
+func (k Keeper) removeOrderRetention(ctx sdk.Context, order types.Order, count uint64) {
+	closeTimestamp, closed := order.CloseTimestamp()
+	if !closed {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := append(types.OrderRetentionQueuePrefix(closeTimestamp), GetOrderIDBytes(count)...)
+	if !store.Has(key) {
+		return
+	}
+	store.Delete(key)
+	if terminalCount := k.GetTerminalOrderCount(ctx); terminalCount > 0 {
+		k.setTerminalOrderCount(ctx, terminalCount-1)
+	}
+}
+
+// IterateRetentionQueue iterates over the completed, cancelled and expired orders from the oldest to the
+// newest closed one and calls cb with their position in the order book until cb returns true.
+func (k Keeper) IterateRetentionQueue(ctx sdk.Context, cb func(count uint64, closeTimestamp uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderRetentionQueueKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if cb(GetBidIDFromBytes(key[8:]), GetBidIDFromBytes(key[:8])) {
+			break
+		}
+	}
+}
+
+// PruneOrders is called in the EndBlocker. It removes the completed, cancelled and expired orders
+// closed for longer than the retention period, and the oldest ones beyond the max number
+// of terminal orders. At most prune_batch_size orders are removed per block, the pruned
+// orders are summarized in an archive event.
+func (k Keeper) PruneOrders(ctx sdk.Context) {
+	batchSize := k.GetPruneBatchSize(ctx)
+	if batchSize == 0 {
+		return
+	}
+	retentionPeriod := k.GetOrderRetentionPeriod(ctx)
+	maxTerminalOrders := k.GetMaxTerminalOrders(ctx)
+	terminalCount := k.GetTerminalOrderCount(ctx)
+	blockTime := ctx.BlockTime().Unix()
+
+	var positions []uint64
+	k.IterateRetentionQueue(ctx, func(count uint64, closeTimestamp uint64) bool {
+		expired := retentionPeriod != 0 && blockTime >= 0 && closeTimestamp+retentionPeriod <= uint64(blockTime)
+		excess := maxTerminalOrders != 0 && terminalCount > maxTerminalOrders
+		// the queue is sorted by closing time, the newer orders are kept too
+		if !expired && !excess {
+			return true
+		}
+		positions = append(positions, count)
+		terminalCount--
+		return uint32(len(positions)) >= batchSize
+	})
+	if len(positions) == 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderBookKey)
+	archived := make([]types.Order, 0, len(positions))
+	for _, count := range positions {
+		bz := store.Get(GetOrderIDBytes(count))
+		if bz == nil {
+			continue
+		}
+		order := k.MustUnmarshalOrder(bz)
+		k.pruneOrder(ctx, order, count)
+		archived = append(archived, order)
+	}
+	emitOrdersArchived(ctx, archived)
+}
+
+// pruneOrder removes an order stored at the given position of the order book with its indexes and bids.
+func (k Keeper) pruneOrder(ctx sdk.Context, order types.Order, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderBookKey)
+	if order.Maker != nil && order.Maker.ExpirationTimestamp != 0 {
+		k.RemoveFromExpiringOrderQueue(ctx, order.Id, order.Maker.ExpirationTimestamp)
+	}
+	k.removeOrderIndexes(ctx, order, count)
+	store.Delete(GetOrderIDBytes(count))
+	if position, found := k.getOrderPosition(ctx, order.Id); found && position == count {
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.OTCOrderBookKeyIndexKey).Delete([]byte(order.Id))
+	}
+	k.removeOrderBids(ctx, order.Id)
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+func (suite *KeeperTestSuite) TestPruneOrders() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+
+	params := k.GetParams(ctx)
+	params.OrderRetentionPeriod = 3600
+	params.MaxTerminalOrders = 3
+	params.PruneBatchSize = 2
+	k.SetParams(ctx, params)
+
+	// the orders are retained from the block time at which they are closed
+	now := ctx.BlockTime()
+	closedAt := func(seconds int64) sdk.Context {
+		return ctx.WithBlockTime(now.Add(time.Duration(seconds) * time.Second))
+	}
+	k.AppendAtomicOrder(ctx, types.Order{Id: "open", Side: types.NATIVE, Status: types.Status_SYNC})
+	k.AppendAtomicOrder(closedAt(-7200), types.Order{Id: "old", Side: types.NATIVE, Status: types.Status_COMPLETE, CompleteTimestamp: now.Unix()})
+	k.AppendAtomicOrder(closedAt(-100), types.Order{Id: "cancelled", Side: types.NATIVE, Status: types.Status_CANCEL, CancelTimestamp: now.Unix()})
+	k.AppendAtomicOrder(closedAt(-7300), types.Order{Id: "older", Side: types.NATIVE, Status: types.Status_EXPIRED})
+	for i, id := range []string{"recent0", "recent1", "recent2"} {
+		// the timestamps given by the requests do not shorten the retention
+		k.AppendAtomicOrder(closedAt(-int64(10-i)), types.Order{Id: id, Side: types.REMOTE, Status: types.Status_COMPLETE, CompleteTimestamp: 1})
+	}
+	suite.Require().Equal(uint64(6), k.GetTerminalOrderCount(ctx))
+
+	bidder := suite.chainA.SenderAccount.GetAddress().String()
+	k.SetBid(ctx, types.Bid{OrderId: "old", Bidder: bidder, Amount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)), Status: types.BID_COMPLETE})
+
+	archivedIds := func(ctx sdk.Context) []string {
+		var ids []string
+		for _, event := range suite.typedEvents(ctx) {
+			if archived, ok := event.(*types.EventOrdersArchived); ok {
+				for _, order := range archived.Orders {
+					ids = append(ids, order.OrderId)
+				}
+			}
+		}
+		return ids
+	}
+
+	// the orders closed before the retention period are pruned first, one batch per block
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.PruneOrders(ctx)
+	suite.Require().Equal([]string{"older", "old"}, archivedIds(ctx))
+	for _, id := range []string{"older", "old"} {
+		_, found := k.GetAtomicOrder(ctx, id)
+		suite.Require().False(found)
+	}
+	_, found := k.GetBid(ctx, "old", bidder)
+	suite.Require().False(found)
+	suite.Require().Equal(uint64(4), k.GetTerminalOrderCount(ctx))
+
+	// the oldest closed orders beyond the max number of terminal orders are pruned
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.PruneOrders(ctx)
+	suite.Require().Equal([]string{"cancelled"}, archivedIds(ctx))
+	suite.Require().Equal(uint64(3), k.GetTerminalOrderCount(ctx))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.PruneOrders(ctx)
+	suite.Require().Empty(archivedIds(ctx))
+
+	var remaining []string
+	for _, order := range k.GetAllOrder(ctx) {
+		remaining = append(remaining, order.Id)
+	}
+	suite.Require().Equal([]string{"open", "recent0", "recent1", "recent2"}, remaining)
+
+	statusOrders, err := k.GetOrdersByStatus(sdk.WrapSDKContext(ctx), &types.QueryOrdersByStatusRequest{Status: types.Status_COMPLETE})
+	suite.Require().NoError(err)
+	suite.Require().Len(statusOrders.Orders, 3)
+
+	// closing an order adds it to the retention queue
+	order, found := k.GetAtomicOrder(ctx, "open")
+	suite.Require().True(found)
+	order.Status = types.Status_CANCEL
+	order.CancelTimestamp = 1
+	k.SetAtomicOrder(ctx, order)
+	suite.Require().Equal(uint64(4), k.GetTerminalOrderCount(ctx))
+
+	order, found = k.GetAtomicOrder(ctx, "open")
+	suite.Require().True(found)
+	suite.Require().Equal(now.Unix(), order.ClosedAt)
+
+	// the order keeps its closing time when it is updated later
+	k.SetAtomicOrder(closedAt(100), order)
+	order, found = k.GetAtomicOrder(ctx, "open")
+	suite.Require().True(found)
+	suite.Require().Equal(now.Unix(), order.ClosedAt)
+	suite.Require().Equal(uint64(4), k.GetTerminalOrderCount(ctx))
+}
@@ -19,9 +19,47 @@ func (k Keeper) GetSwapMaxFeeRate(ctx sdk.Context) uint32 {
 	return res
 }
 
+// GetSwapFeeRate retrieves the fee rate charged on the proceeds of a swap from the paramstore
+func (k Keeper) GetSwapFeeRate(ctx sdk.Context) uint32 {
+	var res uint32
+	k.paramSpace.Get(ctx, types.KeySwapFeeRate, &res)
+	return res
+}
+
+// GetFeeCollector retrieves the address receiving the swap fees from the paramstore
+func (k Keeper) GetFeeCollector(ctx sdk.Context) string {
+	var res string
+	k.paramSpace.Get(ctx, types.KeyFeeCollector, &res)
+	return res
+}
+
+// GetOrderRetentionPeriod retrieves the number of seconds the closed orders are kept from the paramstore
+func (k Keeper) GetOrderRetentionPeriod(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyOrderRetentionPeriod, &res)
+	return res
+}
+
+// GetMaxTerminalOrders retrieves the max number of closed orders kept from the paramstore
+func (k Keeper) GetMaxTerminalOrders(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMaxTerminalOrders, &res)
+	return res
+}
+
+// GetPruneBatchSize retrieves the max number of orders pruned per block from the paramstore
+func (k Keeper) GetPruneBatchSize(ctx sdk.Context) uint32 {
+	var res uint32
+	k.paramSpace.Get(ctx, types.KeyPruneBatchSize, &res)
+	return res
+}
+
 // GetParams returns the total set of ibc-transfer parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetSwapEnabled(ctx), k.GetSwapMaxFeeRate(ctx))
+	return types.NewParams(
+		k.GetSwapEnabled(ctx), k.GetSwapMaxFeeRate(ctx), k.GetSwapFeeRate(ctx), k.GetFeeCollector(ctx),
+		k.GetOrderRetentionPeriod(ctx), k.GetMaxTerminalOrders(ctx), k.GetPruneBatchSize(ctx),
+	)
 }
 
 // SetParams sets the total set of ibc-transfer parameters.
@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errormod "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// SetRateLimit sets the quota of the net outflow of a denom from the escrow account of a channel.
+// A rate limit with a zero max outflow is removed with its flow.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.RateLimitStoreKey(types.RateLimitKey, rateLimit.PortId, rateLimit.ChannelId, rateLimit.Denom)
+	flowKey := types.RateLimitStoreKey(types.RateLimitFlowKey, rateLimit.PortId, rateLimit.ChannelId, rateLimit.Denom)
+	if rateLimit.MaxOutflow.IsZero() {
+		store.Delete(key)
+		store.Delete(flowKey)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&rateLimit))
+}
+
+// GetRateLimit returns the rate limit of a denom on a channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, portID, channelID, denom string) (types.RateLimit, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RateLimitStoreKey(types.RateLimitKey, portID, channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// GetRateLimitUsage returns the flow of a rate limit in the current window.
+func (k Keeper) GetRateLimitUsage(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimitUsage {
+	return types.RateLimitUsage{RateLimit: rateLimit, Flow: k.getRateLimitFlow(ctx, rateLimit)}
+}
+
+// GetAllRateLimitUsages returns all the rate limits with their flow in the current window.
+func (k Keeper) GetAllRateLimitUsages(ctx sdk.Context) (list []types.RateLimitUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		list = append(list, k.GetRateLimitUsage(ctx, rateLimit))
+	}
+	return
+}
+
+// getRateLimitFlow returns the flow of a rate limit, it is empty once the window of the stored flow has elapsed.
+func (k Keeper) getRateLimitFlow(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimitFlow {
+	blockTime := uint64(ctx.BlockTime().Unix())
+	bz := ctx.KVStore(k.storeKey).Get(types.RateLimitStoreKey(types.RateLimitFlowKey, rateLimit.PortId, rateLimit.ChannelId, rateLimit.Denom))
+	if bz == nil {
+		return types.NewRateLimitFlow(blockTime)
+	}
+	var flow types.RateLimitFlow
+	k.cdc.MustUnmarshal(bz, &flow)
+	if blockTime >= flow.WindowStart+rateLimit.Window {
+		return types.NewRateLimitFlow(blockTime)
+	}
+	return flow
+}
+
+func (k Keeper) setRateLimitFlow(ctx sdk.Context, rateLimit types.RateLimit, flow types.RateLimitFlow) {
+	key := types.RateLimitStoreKey(types.RateLimitFlowKey, rateLimit.PortId, rateLimit.ChannelId, rateLimit.Denom)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&flow))
+}
+
+// resetChannelFlows removes the flows of the rate limits of a channel.
+func (k Keeper) resetChannelFlows(ctx sdk.Context, portID, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitChannelPrefix(types.RateLimitFlowKey, portID, channelID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IsChannelPaused returns true if the escrow outflows of the channel are paused.
+func (k Keeper) IsChannelPaused(ctx sdk.Context, portID, channelID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.PausedChannelStoreKey(portID, channelID))
+}
+
+// SetChannelPaused pauses or resumes the escrow outflows of a channel.
+func (k Keeper) SetChannelPaused(ctx sdk.Context, portID, channelID string, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if paused {
+		store.Set(types.PausedChannelStoreKey(portID, channelID), []byte{1})
+	} else {
+		store.Delete(types.PausedChannelStoreKey(portID, channelID))
+	}
+}
+
+// GetPausedChannels returns the paused channels as port_id/channel_id.
+func (k Keeper) GetPausedChannels(ctx sdk.Context) (channels []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedChannelKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		channels = append(channels, string(iterator.Key()))
+	}
+	return
+}
+
+// TrackEscrowOutflow checks the outflow of coins from the escrow account of a channel against its rate
+// limits and records it. It is also used by the interchain swap module.
+//
+// The outflows are rejected while the channel is paused, and an outflow taking the net outflow of the
+// window above the quota is rejected. A rejected outflow reverts the state changes of its packet, the
+// packets received on the channel pause it with PauseRateLimitedChannel.
+func (k Keeper) TrackEscrowOutflow(ctx sdk.Context, portID, channelID string, coins sdk.Coins) error {
+	if k.IsChannelPaused(ctx, portID, channelID) {
+		return errormod.Wrapf(types.ErrChannelPaused, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	for _, coin := range coins {
+		rateLimit, found := k.GetRateLimit(ctx, portID, channelID, coin.Denom)
+		if !found {
+			continue
+		}
+		flow := k.getRateLimitFlow(ctx, rateLimit)
+		flow.Outflow = flow.Outflow.Add(coin.Amount)
+		if flow.NetOutflow().GT(rateLimit.MaxOutflow) {
+			return errormod.Wrapf(types.ErrRateLimitExceeded, "outflow %s on port ID (%s) channel ID (%s) above the quota %s", coin, portID, channelID, rateLimit.MaxOutflow)
+		}
+		k.setRateLimitFlow(ctx, rateLimit, flow)
+	}
+	return nil
+}
+
+// PauseRateLimitedChannel pauses the escrow outflows of the channel of a received packet which was rejected
+// for exceeding the rate limits, and writes the error acknowledgement of the packet. The core handler discards
+// the state changes of a packet acknowledged with an error, the acknowledgement is written here so that the
+// packet is acknowledged asynchronously and the pause is kept.
+func (k Keeper) PauseRateLimitedChannel(ctx sdk.Context, packet channeltypes.Packet, ack ibcexported.Acknowledgement) error {
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(packet.GetDestPort(), packet.GetDestChannel()))
+	if !ok {
+		return errormod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+	if err := k.ics4Wrapper.WriteAcknowledgement(ctx, channelCap, packet, ack); err != nil {
+		return err
+	}
+
+	if !k.IsChannelPaused(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
+		k.SetChannelPaused(ctx, packet.GetDestPort(), packet.GetDestChannel(), true)
+		k.Logger(ctx).Error("escrow outflows paused", "port", packet.GetDestPort(), "channel", packet.GetDestChannel(), "sequence", packet.GetSequence())
+		emitChannelPauseEvent(ctx, types.EventValueActionPauseChannel, packet.GetDestPort(), packet.GetDestChannel(), "")
+	}
+	return nil
+}
+
+// TrackEscrowInflow records the inflow of coins into the escrow account of a channel, which offsets
+// the outflows of the window. It is also used by the interchain swap module.
+func (k Keeper) TrackEscrowInflow(ctx sdk.Context, portID, channelID string, coins sdk.Coins) {
+	for _, coin := range coins {
+		rateLimit, found := k.GetRateLimit(ctx, portID, channelID, coin.Denom)
+		if !found {
+			continue
+		}
+		flow := k.getRateLimitFlow(ctx, rateLimit)
+		flow.Inflow = flow.Inflow.Add(coin.Amount)
+		k.setRateLimitFlow(ctx, rateLimit, flow)
+	}
+}
+
+// TrackEscrowRefund records the refund of coins from the escrow account of a channel to the account
+// which locked them. It is also used by the interchain swap module. The refunds are neither limited nor
+// paused, so that the timeouts, acknowledgements and expirations returning the coins of their owner are
+// always processed: they offset the inflow of the window instead, down to zero.
+func (k Keeper) TrackEscrowRefund(ctx sdk.Context, portID, channelID string, coins sdk.Coins) {
+	for _, coin := range coins {
+		rateLimit, found := k.GetRateLimit(ctx, portID, channelID, coin.Denom)
+		if !found {
+			continue
+		}
+		flow := k.getRateLimitFlow(ctx, rateLimit)
+		flow.Inflow = sdk.MaxInt(flow.Inflow.Sub(coin.Amount), sdk.ZeroInt())
+		k.setRateLimitFlow(ctx, rateLimit, flow)
+	}
+}
+
+// refundFromEscrow returns coins from the escrow account of a channel to the account which locked them.
+func (k Keeper) refundFromEscrow(ctx sdk.Context, portID, channelID string, receiver sdk.AccAddress, coins sdk.Coins) error {
+	k.TrackEscrowRefund(ctx, portID, channelID, coins)
+	return k.bankKeeper.SendCoins(ctx, types.GetEscrowAddress(portID, channelID), receiver, coins)
+}
+
+// sendToEscrow locks coins into the escrow account of a channel.
+func (k Keeper) sendToEscrow(ctx sdk.Context, portID, channelID string, sender sdk.AccAddress, coins sdk.Coins) error {
+	if err := k.bankKeeper.SendCoins(ctx, sender, types.GetEscrowAddress(portID, channelID), coins); err != nil {
+		return err
+	}
+	k.TrackEscrowInflow(ctx, portID, channelID, coins)
+	return nil
+}
+
+func emitChannelPauseEvent(ctx sdk.Context, action, portID, channelID, denom string) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeAction, action),
+		sdk.NewAttribute(types.AttributeKeyPortId, portID),
+		sdk.NewAttribute(types.AttributeKeyChannelId, channelID),
+	}
+	if denom != "" {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyDenom, denom))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.ModuleName, attributes...))
+}
@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+	ibctesting "github.com/sideprotocol/ibcswap/v6/testing"
+)
+
+func (suite *KeeperTestSuite) TestRateLimits() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	authority := k.GetAuthority()
+	channelID := ibctesting.FirstChannelID
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount)))
+	}
+	channelUsage := func() *types.QueryChannelRateLimitsResponse {
+		res, err := k.ChannelRateLimits(sdk.WrapSDKContext(ctx), &types.QueryChannelRateLimitsRequest{PortId: types.PortID, ChannelId: channelID})
+		suite.Require().NoError(err)
+		return res
+	}
+
+	// only the governance module account sets the quotas
+	rateLimit := types.NewRateLimit(types.PortID, channelID, sdk.DefaultBondDenom, sdk.NewInt(100), 3600)
+	_, err := k.SetRateLimits(sdk.WrapSDKContext(ctx), types.NewMsgSetRateLimits(suite.chainA.SenderAccount.GetAddress().String(), []types.RateLimit{rateLimit}))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = k.SetRateLimits(sdk.WrapSDKContext(ctx), types.NewMsgSetRateLimits(authority, []types.RateLimit{rateLimit}))
+	suite.Require().NoError(err)
+
+	// an outflow larger than the quota is rejected
+	suite.Require().ErrorIs(k.TrackEscrowOutflow(ctx, types.PortID, channelID, stake(200)), types.ErrRateLimitExceeded)
+
+	// the inflows offset the outflows of the window
+	k.TrackEscrowInflow(ctx, types.PortID, channelID, stake(50))
+	suite.Require().NoError(k.TrackEscrowOutflow(ctx, types.PortID, channelID, stake(120)))
+	suite.Require().False(channelUsage().Paused)
+
+	// the outflow taking the net outflow above the quota is rejected and not recorded
+	suite.Require().ErrorIs(k.TrackEscrowOutflow(ctx, types.PortID, channelID, stake(40)), types.ErrRateLimitExceeded)
+	suite.Require().NoError(k.TrackEscrowOutflow(ctx, types.PortID, channelID, stake(30)))
+	res := channelUsage()
+	suite.Require().False(res.Paused)
+	suite.Require().Len(res.RateLimits, 1)
+	suite.Require().Equal(sdk.NewInt(150), res.RateLimits[0].Flow.Outflow)
+	suite.Require().Equal(sdk.NewInt(50), res.RateLimits[0].Flow.Inflow)
+	k.SetChannelPaused(ctx, types.PortID, channelID, true)
+
+	// the refunds are processed while the channel is paused, they offset the inflow of the window
+	k.TrackEscrowRefund(ctx, types.PortID, channelID, stake(20))
+	suite.Require().Equal(sdk.NewInt(30), channelUsage().RateLimits[0].Flow.Inflow)
+	k.TrackEscrowRefund(ctx, types.PortID, channelID, stake(40))
+	suite.Require().True(channelUsage().RateLimits[0].Flow.Inflow.IsZero())
+
+	// every outflow of the channel is rejected until the channel is unpaused
+	osmo := sdk.NewCoins(sdk.NewCoin("osmo", sdk.NewInt(1)))
+	suite.Require().ErrorIs(k.TrackEscrowOutflow(ctx, types.PortID, channelID, osmo), types.ErrChannelPaused)
+	suite.Require().NoError(k.TrackEscrowOutflow(ctx, types.PortID, "channel-9", osmo))
+
+	_, err = k.UnpauseChannel(sdk.WrapSDKContext(ctx), types.NewMsgUnpauseChannel(suite.chainA.SenderAccount.GetAddress().String(), types.PortID, channelID))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = k.UnpauseChannel(sdk.WrapSDKContext(ctx), types.NewMsgUnpauseChannel(authority, types.PortID, channelID))
+	suite.Require().NoError(err)
+	res = channelUsage()
+	suite.Require().False(res.Paused)
+	suite.Require().True(res.RateLimits[0].Flow.Outflow.IsZero())
+
+	// the flow is reset once the window elapsed
+	suite.Require().NoError(k.TrackEscrowOutflow(ctx, types.PortID, channelID, stake(90)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	suite.Require().True(channelUsage().RateLimits[0].Flow.Outflow.IsZero())
+
+	// an outflow larger than the quota is rejected in the new window too
+	suite.Require().ErrorIs(k.TrackEscrowOutflow(ctx, types.PortID, channelID, stake(200)), types.ErrRateLimitExceeded)
+	suite.Require().True(channelUsage().RateLimits[0].Flow.Outflow.IsZero())
+
+	// a zero max outflow removes the rate limit
+	rateLimit.MaxOutflow = sdk.ZeroInt()
+	_, err = k.SetRateLimits(sdk.WrapSDKContext(ctx), types.NewMsgSetRateLimits(authority, []types.RateLimit{rateLimit}))
+	suite.Require().NoError(err)
+	all, err := k.RateLimits(sdk.WrapSDKContext(ctx), &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(all.RateLimits)
+}
+
+func (suite *KeeperTestSuite) TestExpireOrdersOnPausedChannel() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	maker := suite.chainA.SenderAccount.GetAddress()
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	escrowAddr := types.GetEscrowAddress(types.PortID, ibctesting.FirstChannelID)
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, maker, escrowAddr, sdk.NewCoins(sellToken)))
+
+	expiration := ctx.BlockTime().Add(time.Hour).Unix()
+	makeMsg := types.NewMsgMakeSwap(
+		types.PortID, ibctesting.FirstChannelID,
+		sellToken, sellToken,
+		maker.String(), maker.String(), "",
+		suite.chainB.GetTimeoutHeight(), 0,
+		ctx.BlockTime().Unix(),
+	)
+	makeMsg.ExpirationTimestamp = uint64(expiration)
+	k.AppendAtomicOrder(ctx, types.Order{Id: "paused", Side: types.NATIVE, Status: types.Status_SYNC, Maker: makeMsg})
+	k.SetChannelPaused(ctx, types.PortID, ibctesting.FirstChannelID, true)
+
+	// the maker is refunded while the channel is paused
+	ctx = ctx.WithBlockTime(time.Unix(expiration, 0))
+	k.ExpireOrders(ctx)
+	order, found := k.GetAtomicOrder(ctx, "paused")
+	suite.Require().True(found)
+	suite.Require().Equal(types.Status_EXPIRED, order.Status)
+	suite.Require().True(bankKeeper.GetAllBalances(ctx, escrowAddr).IsZero())
+	suite.Require().True(k.IsChannelPaused(ctx, types.PortID, ibctesting.FirstChannelID))
+}
+
+func (suite *KeeperTestSuite) TestRefundsOnPausedChannel() {
+	path := NewSwapPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	maker := suite.chainA.SenderAccount.GetAddress()
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	escrowAddr := types.GetEscrowAddress(types.PortID, path.EndpointA.ChannelID)
+	k.SetRateLimit(ctx, types.NewRateLimit(types.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, sdk.NewInt(10), 3600))
+
+	makeOrder := func() *types.AtomicSwapPacketData {
+		msg := types.NewMsgMakeSwap(
+			types.PortID, path.EndpointA.ChannelID,
+			sellToken, sdk.NewCoin("osmo", sdk.NewInt(50)),
+			maker.String(), maker.String(), "",
+			suite.chainB.GetTimeoutHeight(), 0,
+			ctx.BlockTime().Unix(),
+		)
+		res, err := k.MakeSwap(sdk.WrapSDKContext(ctx), msg)
+		suite.Require().NoError(err)
+		data, err := types.ModuleCdc.MarshalJSON(msg)
+		suite.Require().NoError(err)
+		return &types.AtomicSwapPacketData{Type: types.MAKE_SWAP, Data: data, OrderId: res.OrderId}
+	}
+	timedOut, rejected := makeOrder(), makeOrder()
+
+	// the orders were escrowed in an earlier window and the channel is paused
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	k.SetChannelPaused(ctx, types.PortID, path.EndpointA.ChannelID, true)
+	balance := bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom)
+
+	// the timeout and the error acknowledgement refund the maker above the quota
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: path.EndpointA.ChannelID}
+	suite.Require().NoError(k.OnTimeoutPacket(ctx, packet, timedOut))
+	errAck := types.NewErrorAcknowledgement(types.ErrOrderDoesNotExists)
+	suite.Require().NoError(k.OnAcknowledgementPacket(ctx, packet, rejected, errAck))
+
+	suite.Require().Equal(balance.Add(sellToken).Add(sellToken), bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom))
+	suite.Require().True(bankKeeper.GetBalance(ctx, escrowAddr, sdk.DefaultBondDenom).IsZero())
+	for _, data := range []*types.AtomicSwapPacketData{timedOut, rejected} {
+		order, found := k.GetAtomicOrder(ctx, data.OrderId)
+		suite.Require().True(found)
+		suite.Require().Equal(types.Status_CANCEL, order.Status)
+	}
+
+	// the other outflows stay paused
+	suite.Require().True(k.IsChannelPaused(ctx, types.PortID, path.EndpointA.ChannelID))
+	suite.Require().ErrorIs(k.TrackEscrowOutflow(ctx, types.PortID, path.EndpointA.ChannelID, sdk.NewCoins(sellToken)), types.ErrChannelPaused)
+}
+
+func (suite *KeeperTestSuite) TestForgedAcknowledgements() {
+	path := NewSwapPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	maker := suite.chainA.SenderAccount.GetAddress()
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	escrowAddr := types.GetEscrowAddress(types.PortID, path.EndpointA.ChannelID)
+	k.SetRateLimit(ctx, types.NewRateLimit(types.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, sdk.NewInt(10), 3600))
+
+	// the escrow holds the tokens of an open order
+	makeMsg := types.NewMsgMakeSwap(
+		types.PortID, path.EndpointA.ChannelID,
+		sellToken, sdk.NewCoin("osmo", sdk.NewInt(50)),
+		maker.String(), maker.String(), "",
+		suite.chainB.GetTimeoutHeight(), 0,
+		ctx.BlockTime().Unix(),
+	)
+	_, err := k.MakeSwap(sdk.WrapSDKContext(ctx), makeMsg)
+	suite.Require().NoError(err)
+	makeData, err := types.ModuleCdc.MarshalJSON(makeMsg)
+	suite.Require().NoError(err)
+	balance := bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom)
+
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: path.EndpointA.ChannelID}
+	errAck := types.NewErrorAcknowledgement(types.ErrOrderDoesNotExists)
+	assertEscrowed := func() {
+		suite.Require().Equal(balance, bankKeeper.GetBalance(ctx, maker, sdk.DefaultBondDenom))
+		suite.Require().Equal(sellToken, bankKeeper.GetBalance(ctx, escrowAddr, sdk.DefaultBondDenom))
+	}
+
+	// an error acknowledgement of an order which was never escrowed refunds nothing
+	suite.Require().Error(k.OnAcknowledgementPacket(ctx, packet, &types.AtomicSwapPacketData{Type: types.MAKE_SWAP, Data: makeData, OrderId: "forged"}, errAck))
+	assertEscrowed()
+
+	// neither does the refund of a take which was never locked on this chain
+	takeData, err := types.ModuleCdc.MarshalJSON(&types.TakeSwapMsg{OrderId: "forged", SellToken: sellToken, TakerAddress: maker.String()})
+	suite.Require().NoError(err)
+	suite.Require().Error(k.OnAcknowledgementPacket(ctx, packet, &types.AtomicSwapPacketData{Type: types.TAKE_SWAP, Data: takeData}, errAck))
+	assertEscrowed()
+
+	// nor the acknowledgement of the cancellation of an order which does not exist or is settled
+	cancelData, err := types.ModuleCdc.MarshalJSON(&types.CancelSwapMsg{OrderId: "forged", MakerAddress: maker.String()})
+	suite.Require().NoError(err)
+	suite.Require().Error(k.OnAcknowledgementPacket(ctx, packet, &types.AtomicSwapPacketData{Type: types.CANCEL_SWAP, Data: cancelData}, channeltypes.NewResultAcknowledgement([]byte{byte(1)})))
+	assertEscrowed()
+
+	k.AppendAtomicOrder(ctx, types.Order{Id: "settled", Side: types.NATIVE, Status: types.Status_COMPLETE, Maker: makeMsg})
+	suite.Require().NoError(k.OnAcknowledgementPacket(ctx, packet, &types.AtomicSwapPacketData{Type: types.MAKE_SWAP, Data: makeData, OrderId: "settled"}, errAck))
+	cancelData, err = types.ModuleCdc.MarshalJSON(&types.CancelSwapMsg{OrderId: "settled", MakerAddress: maker.String()})
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.OnAcknowledgementPacket(ctx, packet, &types.AtomicSwapPacketData{Type: types.CANCEL_SWAP, Data: cancelData}, channeltypes.NewResultAcknowledgement([]byte{byte(1)})))
+	assertEscrowed()
+	order, _ := k.GetAtomicOrder(ctx, "settled")
+	suite.Require().Equal(types.Status_COMPLETE, order.Status)
+}
@@ -64,7 +64,7 @@ func (k Keeper) SendSwapPacket(
 	//	timeoutTimestamp,
 	//)
 
-	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, swapPacket.GetBytes())
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, k.PacketCodec(ctx, sourcePort, sourceChannel).MarshalPacketData(swapPacket))
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +83,7 @@ func (k Keeper) SendSwapPacket(
 }
 
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.AtomicSwapPacketData) ([]byte, error) {
+	cdc := k.PacketCodec(ctx, packet.DestinationPort, packet.DestinationChannel)
 
 	var resp []byte
 	var errResp error
@@ -90,7 +91,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	case types.MAKE_SWAP:
 		var msg types.MakeSwapMsg
 
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 			return nil, err
 		}
 
@@ -98,47 +99,88 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		if err != nil {
 			return nil, err
 		}
-		resp, errResp = types.ModuleCdc.MarshalJSON(&types.MsgMakeSwapResponse{OrderId: orderId})
+		resp, errResp = cdc.MarshalMsg(&types.MsgMakeSwapResponse{OrderId: orderId})
 	case types.TAKE_SWAP:
 		var msg types.TakeSwapMsg
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 			return nil, err
 		}
 
-		orderId, err2 := k.OnReceivedTake(ctx, packet, &msg)
+		res, err2 := k.OnReceivedTake(ctx, packet, &msg)
 		if err2 != nil {
 			return nil, err2
 		}
-		resp, errResp = types.ModuleCdc.MarshalJSON(&types.MsgTakeSwapResponse{OrderId: orderId})
+		resp, errResp = cdc.MarshalMsg(res)
 	case types.CANCEL_SWAP:
 		var msg types.CancelSwapMsg
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 			return nil, err
 		}
 		orderId, err2 := k.OnReceivedCancel(ctx, packet, &msg)
 		if err2 != nil {
 			return nil, err2
 		}
-		resp, errResp = types.ModuleCdc.MarshalJSON(&types.MsgCancelSwapResponse{OrderId: orderId})
+		resp, errResp = cdc.MarshalMsg(&types.MsgCancelSwapResponse{OrderId: orderId})
+	case types.MAKE_BID:
+		var msg types.MakeBidMsg
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+			return nil, err
+		}
+		orderId, err2 := k.OnReceivedMakeBid(ctx, packet, &msg)
+		if err2 != nil {
+			return nil, err2
+		}
+		resp, errResp = cdc.MarshalMsg(&types.MsgMakeBidResponse{OrderId: orderId})
+	case types.ACCEPT_BID:
+		var msg types.AcceptBidMsg
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+			return nil, err
+		}
+		orderId, err2 := k.OnReceivedAcceptBid(ctx, packet, &msg)
+		if err2 != nil {
+			return nil, err2
+		}
+		resp, errResp = cdc.MarshalMsg(&types.MsgAcceptBidResponse{OrderId: orderId})
+	case types.CANCEL_BID:
+		var msg types.CancelBidMsg
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+			return nil, err
+		}
+		orderId, err2 := k.OnReceivedCancelBid(ctx, packet, &msg)
+		if err2 != nil {
+			return nil, err2
+		}
+		resp, errResp = cdc.MarshalMsg(&types.MsgCancelBidResponse{OrderId: orderId})
+	case types.FILL_SIGNED_ORDER:
+		var msg types.FillSignedOrderMsg
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+			return nil, err
+		}
+		res, err2 := k.OnReceivedFillSignedOrder(ctx, packet, data.OrderId, data.Path, &msg)
+		if err2 != nil {
+			return nil, err2
+		}
+		resp, errResp = cdc.MarshalMsg(res)
 	default:
 		return nil, types.ErrUnknownDataPacket
 	}
 
-	ctx.EventManager().EmitTypedEvents(&data)
 	return resp, errResp
 }
 
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data *types.AtomicSwapPacketData, ack channeltypes.Acknowledgement) error {
+	cdc := k.PacketCodec(ctx, packet.SourcePort, packet.SourceChannel)
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		ackError, _ := types.ParseErrorAcknowledgement(ack)
+		return k.refundPacketToken(ctx, packet, data, types.ReasonErrorAck, ackError)
 	default:
 		switch data.Type {
 		case types.MAKE_SWAP:
 			// This is the step 4 (Acknowledge Make Packet) of the atomic swap: https://github.com/liangping/ibc/blob/atomic-swap/spec/app/ics-100-atomic-swap/ibcswap.png
 			// This logic is executed when Taker chain acknowledge the make swap packet.
 			var msg types.MakeSwapMsg
-			if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 				return err
 			}
 			order, ok := k.GetAtomicOrder(ctx, data.OrderId)
@@ -146,8 +188,12 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 				return types.ErrOrderDoesNotExists
 				//return nil
 			}
-			order.Status = types.Status_SYNC
-			k.SetAtomicOrder(ctx, order)
+			// the order may have been expired and refunded before the acknowledgement arrived
+			if order.Status == types.Status_INITIAL {
+				order.Status = types.Status_SYNC
+				k.SetAtomicOrder(ctx, order)
+				emitOrderSynced(ctx, order)
+			}
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.ModuleName,
@@ -173,71 +219,53 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 			// This is the step 9 (Transfer Take Token & Close order): https://github.com/cosmos/ibc/tree/main/spec/app/ics-100-atomic-swap
 			// The step is executed on the Taker chain.
 			takeMsg := &types.TakeSwapMsg{}
-			if err := types.ModuleCdc.UnmarshalJSON(data.Data, takeMsg); err != nil {
+			if err := cdc.UnmarshalMsg(data.Data, takeMsg); err != nil {
 				return err
 			}
-
-			order, _ := k.GetAtomicOrder(ctx, takeMsg.OrderId)
-			escrowAddr := types.GetEscrowAddress(types.PortID, packet.SourceChannel)
-
-			makerReceivingAddr, err := sdk.AccAddressFromBech32(order.Maker.MakerReceivingAddress)
-			if err != nil {
-				return err
-			}
-
-			if err = k.bankKeeper.SendCoins(ctx, escrowAddr, makerReceivingAddr, sdk.NewCoins(takeMsg.SellToken)); err != nil {
+			return k.onTakeAcknowledged(ctx, packet, takeMsg, ack)
+		case types.FILL_SIGNED_ORDER:
+			// The maker chain opened and settled the signed order, the taker's tokens are paid to the maker
+			// like the take of an order. The step is executed on the Taker chain.
+			var msg types.FillSignedOrderMsg
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 				return err
 			}
-
-			order.Status = types.Status_COMPLETE
-			order.Takers = takeMsg
-			order.CompleteTimestamp = takeMsg.CreateTimestamp
-			k.SetAtomicOrder(ctx, order)
-			// Move Completed assets to bottom
-			k.MoveOrderToBottom(ctx, order.Id)
-
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					types.ModuleName,
-					sdk.Attribute{
-						Key: types.AttributeAction,
-						Value: types.GetEventValueWithSuffix(
-							types.EventValueActionTakeOrder, types.EventValueSuffixAcknowledged,
-						),
-					},
-					sdk.Attribute{
-						Key:   types.AttributeOrderId,
-						Value: order.Id,
-					},
-					sdk.Attribute{
-						Key:   types.AttributeName,
-						Value: types.EventOwner,
-					},
-				),
-			)
-			return nil
+			return k.onTakeAcknowledged(ctx, packet, msg.TakeSwapMsg(data.OrderId), ack)
 		case types.CANCEL_SWAP:
 			// This is the step 14 (Cancel & refund) of the atomic swap: https://github.com/cosmos/ibc/tree/main/spec/app/ics-100-atomic-swap
 			// It is executed on the Maker chain.
 
 			var msg types.CancelSwapMsg
-			if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 				return err
 			}
 
-			order, _ := k.GetAtomicOrder(ctx, msg.OrderId)
-			escrowAddr := types.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
+			order, found := k.GetAtomicOrder(ctx, msg.OrderId)
+			if !found || order.Side != types.NATIVE {
+				return types.ErrOrderDoesNotExists
+			}
+			// expired and filled orders have been refunded or settled already
+			if !isOrderOpen(order) {
+				return nil
+			}
 			makerAddr, err := sdk.AccAddressFromBech32(order.Maker.MakerAddress)
 			if err != nil {
 				return err
 			}
 
-			if err = k.bankKeeper.SendCoins(ctx, escrowAddr, makerAddr, sdk.NewCoins(order.Maker.SellToken)); err != nil {
+			// only the unfilled remainder is still locked in the escrow
+			refund := order.RemainingSellTokens()
+			if err = k.refundFromEscrow(ctx, packet.SourcePort, packet.SourceChannel, makerAddr, refund); err != nil {
 				return err
 			}
 			order.Status = types.Status_CANCEL
-			order.CancelTimestamp = msg.CreateTimestamp
+			order.CancelTimestamp = statusTimestamp(ctx, msg.CreateTimestamp)
 			k.SetAtomicOrder(ctx, order)
+			if err := k.refundOrderBids(ctx, order, "", types.ReasonCancelled); err != nil {
+				return err
+			}
+			emitOrderRefunded(ctx, order, order.Maker.MakerAddress, refund, types.ReasonCancelled)
+			emitOrderCancelled(ctx, order, types.ReasonCancelled)
 
 			// emit events
 			ctx.EventManager().EmitEvent(
@@ -260,18 +288,177 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 				),
 			)
 			return nil
+		case types.MAKE_BID:
+			// The Maker chain recorded the bid, it can be accepted from now on.
+			var msg types.MakeBidMsg
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+				return err
+			}
+			bid, found := k.GetBid(ctx, msg.OrderId, msg.Bidder)
+			// the bid may have been refunded already, if the order was closed in the meantime
+			if found && bid.Status == types.BID_INITIAL {
+				bid.Status = types.BID_PLACED
+				k.SetBid(ctx, bid)
+			}
+			return nil
+		case types.ACCEPT_BID:
+			// The Taker chain sent the bid to the maker, the sell token is sent to the bidder
+			// and the order is completed. It is executed on the Maker chain.
+			var msg types.AcceptBidMsg
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+				return err
+			}
+			order, ok := k.GetAtomicOrder(ctx, msg.OrderId)
+			if !ok {
+				return types.ErrOrderDoesNotExists
+			}
+			bid, found := k.GetBid(ctx, msg.OrderId, msg.Bidder)
+			if !found || bid.Status != types.BID_ACCEPTED {
+				return types.ErrInvalidBidStatus
+			}
+
+			bidderReceivingAddr, err := sdk.AccAddressFromBech32(bid.BidderReceivingAddress)
+			if err != nil {
+				return err
+			}
+			if err := k.sendProceeds(ctx, packet.SourcePort, packet.SourceChannel, bidderReceivingAddr, order.RemainingSellToken()); err != nil {
+				return err
+			}
+
+			if err := k.completeOrderWithBid(ctx, order, bid, msg.CreateTimestamp); err != nil {
+				return err
+			}
+			emitBidEvent(ctx, types.GetEventValueWithSuffix(types.EventValueActionAcceptBid, types.EventValueSuffixAcknowledged), bid)
+			return nil
+		case types.CANCEL_BID:
+			// The Maker chain withdrew the bid, it is refunded on the Taker chain.
+			var msg types.CancelBidMsg
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+				return err
+			}
+			order, ok := k.GetAtomicOrder(ctx, msg.OrderId)
+			if !ok {
+				return types.ErrOrderDoesNotExists
+			}
+			bid, found := k.GetBid(ctx, msg.OrderId, msg.Bidder)
+			// the bid may have been settled or refunded while the cancellation was in flight
+			if !found || bid.Status != types.BID_PLACED {
+				return nil
+			}
+			if err := k.refundBid(ctx, order, bid, types.ReasonCancelled); err != nil {
+				return err
+			}
+			bid.Status = types.BID_CANCELLED
+			k.SetBid(ctx, bid)
+			emitBidEvent(ctx, types.GetEventValueWithSuffix(types.EventValueActionCancelBid, types.EventValueSuffixAcknowledged), bid)
+			return nil
 		default:
 			return errors.New("unknown data packet")
 		}
 	}
 }
 
+// onTakeAcknowledged pays the sell tokens of an acknowledged take to the maker and closes the order once it is
+// filled. It is executed on the Taker chain.
+func (k Keeper) onTakeAcknowledged(ctx sdk.Context, packet channeltypes.Packet, takeMsg *types.TakeSwapMsg, ack channeltypes.Acknowledgement) error {
+	cdc := k.PacketCodec(ctx, packet.SourcePort, packet.SourceChannel)
+	order, _ := k.GetAtomicOrder(ctx, takeMsg.OrderId)
+
+	makerReceivingAddr, err := sdk.AccAddressFromBech32(order.Maker.MakerReceivingAddress)
+	if err != nil {
+		return err
+	}
+
+	// An auction order is charged the price computed by the maker chain,
+	// the rest of the locked sell token is refunded to the taker.
+	fill := *takeMsg
+	var res types.MsgTakeSwapResponse
+	if order.Maker.IsBasket() {
+		// the whole basket is paid to the maker
+		for _, sellToken := range takeMsg.SellCoins() {
+			if err := k.sendProceeds(ctx, packet.SourcePort, packet.SourceChannel, makerReceivingAddr, sellToken); err != nil {
+				return err
+			}
+		}
+	} else if err := cdc.UnmarshalMsg(ack.GetResult(), &res); err == nil &&
+		res.PaidToken.Denom == takeMsg.SellToken.Denom && res.PaidToken.Amount.LT(takeMsg.SellToken.Amount) {
+		fill.SellToken = res.PaidToken
+		takerAddr, err := sdk.AccAddressFromBech32(takeMsg.TakerAddress)
+		if err != nil {
+			return err
+		}
+		overpayment := sdk.NewCoins(takeMsg.SellToken.Sub(res.PaidToken))
+		if err := k.refundFromEscrow(ctx, packet.SourcePort, packet.SourceChannel, takerAddr, overpayment); err != nil {
+			return err
+		}
+		emitOrderRefunded(ctx, order, takeMsg.TakerAddress, overpayment, types.ReasonOverpayment)
+	}
+
+	// the swap fee is deducted from the maker's proceeds
+	if !order.Maker.IsBasket() {
+		if err = k.sendProceeds(ctx, packet.SourcePort, packet.SourceChannel, makerReceivingAddr, fill.SellToken); err != nil {
+			return err
+		}
+	}
+
+	order.Fills = append(order.Fills, &fill)
+	if !order.IsFilled() {
+		// release the occupation, the order stays open for the remaining amount
+		order.Takers = nil
+		k.SetAtomicOrder(ctx, order)
+	} else {
+		order.Status = types.Status_COMPLETE
+		order.Takers = &fill
+		order.CompleteTimestamp = statusTimestamp(ctx, takeMsg.CreateTimestamp)
+		k.SetAtomicOrder(ctx, order)
+		// Move Completed assets to bottom
+		k.MoveOrderToBottom(ctx, order.Id)
+
+		// the open bids are locked on this chain
+		if err := k.refundOrderBids(ctx, order, "", types.ReasonOrderCompleted); err != nil {
+			return err
+		}
+		emitOrderCompleted(ctx, order)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.Attribute{
+				Key: types.AttributeAction,
+				Value: types.GetEventValueWithSuffix(
+					types.EventValueActionTakeOrder, types.EventValueSuffixAcknowledged,
+				),
+			},
+			sdk.Attribute{
+				Key:   types.AttributeOrderId,
+				Value: order.Id,
+			},
+			sdk.Attribute{
+				Key:   types.AttributeName,
+				Value: types.EventOwner,
+			},
+		),
+	)
+	return nil
+}
+
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data *types.AtomicSwapPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+	if err := k.refundPacketToken(ctx, packet, data, types.ReasonTimeout, nil); err != nil {
+		return err
+	}
+
+	// packets timed out on close release the orders they kept open on the closed channel
+	if channel, found := k.channelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel); found && channel.State == channeltypes.CLOSED {
+		k.CancelClosedChannelOrders(ctx, packet.SourcePort, packet.SourceChannel)
+	}
+	return nil
 }
 
-func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data *types.AtomicSwapPacketData) error {
-	escrowAddr := types.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
+// refundPacketToken refunds the tokens locked by a packet that timed out or was rejected by the counterparty
+// chain. The error of an error acknowledgement is recorded on the order and in the refund events.
+func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data *types.AtomicSwapPacketData, reason string, ackError *types.ErrorAcknowledgement) error {
+	cdc := k.PacketCodec(ctx, packet.SourcePort, packet.SourceChannel)
 	switch data.Type {
 	case types.MAKE_SWAP:
 		// This is the step 3.2 (Refund) of the atomic swap: https://github.com/liangping/ibc/blob/atomic-swap/spec/app/ics-100-atomic-swap/ibcswap.png
@@ -279,7 +466,7 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 		// and locked tokens form the first step (see the picture on the link above) MUST be returned to the account of
 		// the maker on the maker chain.
 		makeMsg := &types.MakeSwapMsg{}
-		if err := types.ModuleCdc.Unmarshal(data.Data, makeMsg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, makeMsg); err != nil {
 			return err
 		}
 
@@ -288,23 +475,35 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 			return err
 		}
 
-		// send tokens back to maker
-		err = k.bankKeeper.SendCoins(ctx, escrowAddr, makerAddr, sdk.NewCoins(makeMsg.SellToken))
-		if err != nil {
-			return err
-		}
 		order, found := k.GetAtomicOrder(ctx, data.OrderId)
-		if !found {
+		if !found || order.Side != types.NATIVE {
 			return fmt.Errorf("order not found for ID %s", data.OrderId)
 		}
+		// expired orders, orders cancelled on a closed channel and filled orders no longer hold an escrow
+		if !isOrderOpen(order) {
+			return nil
+		}
+
+		// send the tokens still locked for the order back to maker
+		refund := order.RemainingSellTokens()
+		err = k.refundFromEscrow(ctx, packet.SourcePort, packet.SourceChannel, makerAddr, refund)
+		if err != nil {
+			return err
+		}
 		order.Status = types.Status_CANCEL
+		order.CancelTimestamp = ctx.BlockTime().Unix()
+		if ackError != nil {
+			order.AckError = ackError
+		}
 		k.SetAtomicOrder(ctx, order)
+		emitOrderRefunded(ctx, order, makeMsg.MakerAddress, refund, reason)
+		emitOrderCancelled(ctx, order, reason)
 
 	case types.TAKE_SWAP:
 		// This is the step 7.2 (Unlock order and refund) of the atomic swap: https://github.com/cosmos/ibc/tree/main/spec/app/ics-100-atomic-swap
 		// This step is executed on the Taker chain when Take Swap request timeout.
 		takeMsg := &types.TakeSwapMsg{}
-		if err := types.ModuleCdc.Unmarshal(data.Data, takeMsg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, takeMsg); err != nil {
 			return err
 		}
 
@@ -313,23 +512,121 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 			return err
 		}
 
+		// the tokens of the take were locked for an order of the taker chain
+		order, found := k.GetAtomicOrder(ctx, takeMsg.OrderId)
+		if !found || order.Side != types.REMOTE {
+			return fmt.Errorf("order not found for ID %s", takeMsg.OrderId)
+		}
+
 		// send tokens back to taker
-		err = k.bankKeeper.SendCoins(ctx, escrowAddr, takerAddr, sdk.NewCoins(takeMsg.SellToken))
+		err = k.refundFromEscrow(ctx, packet.SourcePort, packet.SourceChannel, takerAddr, takeMsg.SellCoins())
 		if err != nil {
 			return err
 		}
+		if ackError != nil {
+			order.AckError = ackError
+			k.SetAtomicOrder(ctx, order)
+		}
+		emitOrderRefunded(ctx, order, takeMsg.TakerAddress, takeMsg.SellCoins(), reason)
+		// the order may have been completed by an accepted bid in the meantime
+		if order.Status == types.Status_SYNC || order.Status == types.Status_INITIAL {
+			order.Takers = nil // release the occupation
+			k.SetAtomicOrder(ctx, order)
 
-		order, found := k.GetAtomicOrder(ctx, takeMsg.OrderId)
-		if !found {
-			return fmt.Errorf("order not found for ID %s", takeMsg.OrderId)
+			// the order expiration is skipped while a take is in flight
+			if order.Maker.IsExpired(ctx.BlockTime().Unix()) {
+				return k.expireOrder(ctx, order)
+			}
+		}
+	case types.FILL_SIGNED_ORDER:
+		// The maker chain did not open the signed order, the order is cancelled and the taker is refunded.
+		// This step is executed on the Taker chain.
+		var msg types.FillSignedOrderMsg
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+			return err
+		}
+
+		takerAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+		if err != nil {
+			return err
+		}
+
+		order, found := k.GetAtomicOrder(ctx, data.OrderId)
+		if !found || order.Side != types.REMOTE || order.Takers == nil {
+			return fmt.Errorf("order not found for ID %s", data.OrderId)
+		}
+		// the fill has been refunded already
+		if !isOrderOpen(order) {
+			return nil
+		}
+
+		refund := order.Takers.SellCoins()
+		if err := k.refundFromEscrow(ctx, packet.SourcePort, packet.SourceChannel, takerAddr, refund); err != nil {
+			return err
+		}
+		order.Status = types.Status_CANCEL
+		order.CancelTimestamp = ctx.BlockTime().Unix()
+		if ackError != nil {
+			order.AckError = ackError
 		}
-		order.Takers = nil // release the occupation
 		k.SetAtomicOrder(ctx, order)
+		emitOrderRefunded(ctx, order, msg.Sender, refund, reason)
+		emitOrderCancelled(ctx, order, reason)
 	case types.CANCEL_SWAP:
 		// do nothing, only send tokens back when cancel msg is acknowledged.
+	case types.MAKE_BID:
+		// The bid was not recorded by the Maker chain, it is refunded on the Taker chain.
+		var msg types.MakeBidMsg
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+			return err
+		}
+		order, ok := k.GetAtomicOrder(ctx, msg.OrderId)
+		if !ok {
+			return types.ErrOrderDoesNotExists
+		}
+		bid, found := k.GetBid(ctx, msg.OrderId, msg.Bidder)
+		if !found || bid.Status != types.BID_INITIAL {
+			return nil
+		}
+		if err := k.refundBid(ctx, order, bid, reason); err != nil {
+			return err
+		}
+		bid.Status = types.BID_REFUNDED
+		k.SetBid(ctx, bid)
+		emitBidEvent(ctx, types.EventValueActionRefundBid, bid)
+	case types.ACCEPT_BID:
+		// The bid could not be settled on the Taker chain, the order is released on the Maker chain.
+		var msg types.AcceptBidMsg
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+			return err
+		}
+		bid, found := k.GetBid(ctx, msg.OrderId, msg.Bidder)
+		if !found || bid.Status != types.BID_ACCEPTED {
+			return nil
+		}
+		bid.Status = types.BID_PLACED
+		k.SetBid(ctx, bid)
+
+		order, ok := k.GetAtomicOrder(ctx, msg.OrderId)
+		if ok && ackError != nil {
+			order.AckError = ackError
+			k.SetAtomicOrder(ctx, order)
+		}
+		// the order expiration is skipped while a bid is accepted
+		if ok && order.Maker.IsExpired(ctx.BlockTime().Unix()) {
+			return k.expireOrder(ctx, order)
+		}
+	case types.CANCEL_BID:
+		// do nothing, the bid stays open.
 	default:
 		return errors.New("unknown data packet")
 	}
 
 	return nil
 }
+
+// isOrderOpen returns true if the order still holds tokens in the escrow account. The refunds are not rate
+// limited, they are bounded by the tokens locked for an open order.
+func isOrderOpen(order types.Order) bool {
+	return order.Status == types.Status_INITIAL || order.Status == types.Status_SYNC
+}
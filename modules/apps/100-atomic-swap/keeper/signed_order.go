@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errormod "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// IsNonceUsed returns true if a signed order of the maker with the given nonce has been filled.
+func (k Keeper) IsNonceUsed(ctx sdk.Context, maker string, nonce uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.SignedOrderNonceStoreKey(maker, nonce))
+}
+
+// SetNonceUsed marks the nonce of a maker's signed order as used, so that the order can not be replayed.
+func (k Keeper) SetNonceUsed(ctx sdk.Context, maker string, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set(types.SignedOrderNonceStoreKey(maker, nonce), []byte{0x01})
+}
+
+// GetAllSignedOrderNonces returns the used nonces of the signed orders of all the makers.
+func (k Keeper) GetAllSignedOrderNonces(ctx sdk.Context) (nonces []types.SignedOrderNonce) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignedOrderNonceKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the length prefixed maker address followed by the nonce
+		key := iterator.Key()
+		makerLen := int(key[0])
+		nonces = append(nonces, types.SignedOrderNonce{
+			Maker: string(key[1 : 1+makerLen]),
+			Nonce: sdk.BigEndianToUint64(key[1+makerLen:]),
+		})
+	}
+	return
+}
+
+// verifyOrderSignature checks the signature of an order against the public key of the maker account.
+func (k Keeper) verifyOrderSignature(ctx sdk.Context, maker sdk.AccAddress, signBytes, signature []byte) error {
+	account := k.authKeeper.GetAccount(ctx, maker)
+	if account == nil {
+		return errormod.Wrapf(types.ErrInvalidSignature, "maker account %s not found", maker)
+	}
+	pubKey := account.GetPubKey()
+	if pubKey == nil {
+		return errormod.Wrapf(types.ErrInvalidSignature, "maker account %s has no public key", maker)
+	}
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return errormod.Wrap(types.ErrInvalidSignature, "signature verification failed")
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+func (suite *KeeperTestSuite) TestFillSignedOrder() {
+	path := NewSwapPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// chain A is the maker chain, chain B the taker chain
+	ctxA, ctxB := suite.chainA.GetContext(), suite.chainB.GetContext()
+	appA, appB := suite.chainA.GetSimApp(), suite.chainB.GetSimApp()
+	kA, kB := appA.AtomicSwapKeeper, appB.AtomicSwapKeeper
+
+	makerKey := suite.chainA.SenderAccounts[0].SenderPrivKey
+	maker := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress()
+	makerReceiving := suite.chainB.SenderAccounts[0].SenderAccount.GetAddress()
+	taker := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	takerReceiving := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+	buyToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(500))
+
+	// the maker allows the module to pull the sell tokens of two orders
+	expiration := ctxA.BlockTime().Add(time.Hour)
+	authorization := banktypes.NewSendAuthorization(sdk.NewCoins(sellToken.Add(sellToken)))
+	suite.Require().NoError(appA.AuthzKeeper.SaveGrant(ctxA, appA.AccountKeeper.GetModuleAddress(types.ModuleName), maker, authorization, &expiration))
+
+	signedOrder := func(nonce uint64) types.SignedOrder {
+		return types.SignedOrder{
+			ChainId:               ctxA.ChainID(),
+			SourcePort:            types.PortID,
+			SourceChannel:         path.EndpointA.ChannelID,
+			MakerAddress:          maker.String(),
+			MakerReceivingAddress: makerReceiving.String(),
+			SellTokens:            sdk.NewCoins(sellToken),
+			BuyTokens:             sdk.NewCoins(buyToken),
+			Nonce:                 nonce,
+			ExpirationTimestamp:   uint64(ctxA.BlockTime().Add(time.Minute).Unix()),
+		}
+	}
+	sign := func(order types.SignedOrder) []byte {
+		signature, err := makerKey.Sign(order.GetSignBytes())
+		suite.Require().NoError(err)
+		return signature
+	}
+	fillMsg := func(order types.SignedOrder, signature []byte) *types.FillSignedOrderMsg {
+		return types.NewMsgFillSignedOrder(
+			types.PortID, path.EndpointB.ChannelID,
+			taker.String(), takerReceiving.String(), order, signature,
+			suite.chainA.GetTimeoutHeight(), 0,
+		)
+	}
+	// fill sends the fill packet on the taker chain
+	fill := func(msg *types.FillSignedOrderMsg) (*types.AtomicSwapPacketData, error) {
+		res, err := kB.FillSignedOrder(sdk.WrapSDKContext(ctxB), msg)
+		if err != nil {
+			return nil, err
+		}
+		order, found := kB.GetAtomicOrder(ctxB, res.OrderId)
+		suite.Require().True(found)
+		data, err := types.ModuleCdc.MarshalJSON(msg)
+		suite.Require().NoError(err)
+		return &types.AtomicSwapPacketData{Type: types.FILL_SIGNED_ORDER, Data: data, OrderId: order.Id, Path: order.Path}, nil
+	}
+	// receive settles the fill packet on the maker chain
+	recvPacket := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: path.EndpointA.ChannelID}
+	receive := func(msg *types.FillSignedOrderMsg, data *types.AtomicSwapPacketData) (*types.MsgTakeSwapResponse, error) {
+		return kA.OnReceivedFillSignedOrder(ctxA, recvPacket, data.OrderId, data.Path, msg)
+	}
+	ackPacket := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: path.EndpointB.ChannelID}
+
+	order := signedOrder(1)
+	signature := sign(order)
+
+	// an order reserved for another taker can not be filled by the sender
+	reserved := signedOrder(1)
+	reserved.DesiredTaker = suite.chainB.SenderAccounts[2].SenderAccount.GetAddress().String()
+	_, err := fill(fillMsg(reserved, sign(reserved)))
+	suite.Require().ErrorIs(err, types.ErrInvalidTakerAddress)
+
+	// the sender is bound as the taker and its buy tokens are locked on the taker chain
+	escrowB := types.GetEscrowAddress(types.PortID, path.EndpointB.ChannelID)
+	escrowBalanceB := appB.BankKeeper.GetBalance(ctxB, escrowB, buyToken.Denom)
+	msg := fillMsg(order, signature)
+	data, err := fill(msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(escrowBalanceB.Add(buyToken), appB.BankKeeper.GetBalance(ctxB, escrowB, buyToken.Denom))
+	remote, found := kB.GetAtomicOrder(ctxB, data.OrderId)
+	suite.Require().True(found)
+	suite.Require().Equal(types.REMOTE, remote.Side)
+	suite.Require().Equal(taker.String(), remote.Maker.DesiredTaker)
+	suite.Require().Equal(taker.String(), remote.Takers.TakerAddress)
+
+	// the signature covers the whole order
+	tampered := fillMsg(order, signature)
+	tampered.Order.BuyTokens = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)))
+	_, err = receive(tampered, data)
+	suite.Require().ErrorIs(err, types.ErrInvalidSignature)
+
+	// an order signed by another key is rejected
+	otherSignature, err := suite.chainA.SenderAccounts[1].SenderPrivKey.Sign(order.GetSignBytes())
+	suite.Require().NoError(err)
+	_, err = receive(fillMsg(order, otherSignature), data)
+	suite.Require().ErrorIs(err, types.ErrInvalidSignature)
+
+	// an order signed for another chain is rejected
+	otherChain := signedOrder(1)
+	otherChain.ChainId = suite.chainB.ChainID
+	_, err = receive(fillMsg(otherChain, sign(otherChain)), data)
+	suite.Require().ErrorIs(err, types.ErrInvalidSignature)
+
+	// the maker chain pulls the sell tokens of the maker and releases them to the taker in the same packet
+	makerBalance := appA.BankKeeper.GetBalance(ctxA, maker, sellToken.Denom)
+	takerBalance := appA.BankKeeper.GetBalance(ctxA, takerReceiving, sellToken.Denom)
+	res, err := receive(msg, data)
+	suite.Require().NoError(err)
+	suite.Require().Equal(buyToken, res.PaidToken)
+	suite.Require().True(kA.IsNonceUsed(ctxA, maker.String(), 1))
+	suite.Require().Equal(makerBalance.Sub(sellToken), appA.BankKeeper.GetBalance(ctxA, maker, sellToken.Denom))
+	suite.Require().Equal(takerBalance.Add(sellToken), appA.BankKeeper.GetBalance(ctxA, takerReceiving, sellToken.Denom))
+
+	native, found := kA.GetAtomicOrder(ctxA, data.OrderId)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NATIVE, native.Side)
+	suite.Require().Equal(types.Status_COMPLETE, native.Status)
+	suite.Require().Equal(taker.String(), native.Takers.TakerAddress)
+	suite.Require().Equal(order.ExpirationTimestamp, native.Maker.ExpirationTimestamp)
+
+	// the acknowledgement pays the buy tokens to the maker on the taker chain
+	makerReceivingBalance := appB.BankKeeper.GetBalance(ctxB, makerReceiving, buyToken.Denom)
+	resData, err := types.ModuleCdc.MarshalJSON(res)
+	suite.Require().NoError(err)
+	suite.Require().NoError(kB.OnAcknowledgementPacket(ctxB, ackPacket, data, channeltypes.NewResultAcknowledgement(resData)))
+	suite.Require().Equal(makerReceivingBalance.Add(buyToken), appB.BankKeeper.GetBalance(ctxB, makerReceiving, buyToken.Denom))
+	suite.Require().Equal(escrowBalanceB, appB.BankKeeper.GetBalance(ctxB, escrowB, buyToken.Denom))
+	remote, _ = kB.GetAtomicOrder(ctxB, data.OrderId)
+	suite.Require().Equal(types.Status_COMPLETE, remote.Status)
+
+	// a filled quote can not be replayed
+	replay, err := fill(fillMsg(order, signature))
+	suite.Require().NoError(err)
+	_, err = receive(msg, replay)
+	suite.Require().ErrorIs(err, types.ErrNonceUsed)
+
+	// the taker is refunded when the fill is rejected or times out
+	takerBalanceB := appB.BankKeeper.GetBalance(ctxB, taker, buyToken.Denom)
+	suite.Require().NoError(kB.OnTimeoutPacket(ctxB, ackPacket, replay))
+	suite.Require().Equal(takerBalanceB.Add(buyToken), appB.BankKeeper.GetBalance(ctxB, taker, buyToken.Denom))
+	cancelled, _ := kB.GetAtomicOrder(ctxB, replay.OrderId)
+	suite.Require().Equal(types.Status_CANCEL, cancelled.Status)
+
+	// the allowance limits the tokens pulled from the maker
+	second := fillMsg(signedOrder(2), sign(signedOrder(2)))
+	data, err = fill(second)
+	suite.Require().NoError(err)
+	_, err = receive(second, data)
+	suite.Require().NoError(err)
+	third := fillMsg(signedOrder(3), sign(signedOrder(3)))
+	data, err = fill(third)
+	suite.Require().NoError(err)
+	_, err = receive(third, data)
+	suite.Require().ErrorIs(err, types.ErrFailedMakeSwap)
+	suite.Require().False(kA.IsNonceUsed(ctxA, maker.String(), 3))
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errormod "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctmtypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// SetListEntry adds an entry to the swap allowlist or denylist.
+func (k Keeper) SetListEntry(ctx sdk.Context, entry types.ListEntry) {
+	ctx.KVStore(k.storeKey).Set(types.SwapListEntryKey(entry), k.cdc.MustMarshal(&entry))
+}
+
+// RemoveListEntry removes an entry from the swap allowlist or denylist.
+func (k Keeper) RemoveListEntry(ctx sdk.Context, entry types.ListEntry) {
+	ctx.KVStore(k.storeKey).Delete(types.SwapListEntryKey(entry))
+}
+
+// HasListEntry returns true if the entry is in its swap list.
+func (k Keeper) HasListEntry(ctx sdk.Context, entry types.ListEntry) bool {
+	return ctx.KVStore(k.storeKey).Has(types.SwapListEntryKey(entry))
+}
+
+// GetAllListEntries returns the entries of both swap lists.
+func (k Keeper) GetAllListEntries(ctx sdk.Context) (list []types.ListEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SwapListKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.ListEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		list = append(list, entry)
+	}
+	return
+}
+
+// hasListEntries returns true if the swap list has entries of the given kind.
+func (k Keeper) hasListEntries(ctx sdk.Context, list types.SwapList, kind types.ListEntryKind) bool {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SwapListKindPrefix(list, kind))
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// isListed returns false if the value is denied, or if the allowlist has entries of its kind but not the value.
+func (k Keeper) isListed(ctx sdk.Context, kind types.ListEntryKind, value string) bool {
+	if k.HasListEntry(ctx, types.NewListEntry(types.DENYLIST, kind, value)) {
+		return false
+	}
+	return !k.hasListEntries(ctx, types.ALLOWLIST, kind) ||
+		k.HasListEntry(ctx, types.NewListEntry(types.ALLOWLIST, kind, value))
+}
+
+// ValidateSwapDenoms checks that the swap lists allow the denoms to be swapped.
+func (k Keeper) ValidateSwapDenoms(ctx sdk.Context, denoms ...string) error {
+	for _, denom := range denoms {
+		if !k.isListed(ctx, types.DenomEntry, denom) {
+			return errormod.Wrapf(types.ErrDenomNotAllowed, "denom %s", denom)
+		}
+	}
+	return nil
+}
+
+// ValidateSwapRoute checks that the swap lists allow the denoms to be swapped over the channel and
+// with the counterparty chain of the channel. It is also used by the interchain swap module.
+func (k Keeper) ValidateSwapRoute(ctx sdk.Context, portID, channelID string, denoms ...string) error {
+	if err := k.ValidateSwapDenoms(ctx, denoms...); err != nil {
+		return err
+	}
+	if !k.isListed(ctx, types.ChannelEntry, types.ChannelListValue(portID, channelID)) {
+		return errormod.Wrapf(types.ErrChannelNotAllowed, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	// the counterparty chain is only looked up when the lists have chain entries
+	if !k.hasListEntries(ctx, types.DENYLIST, types.ChainIdEntry) && !k.hasListEntries(ctx, types.ALLOWLIST, types.ChainIdEntry) {
+		return nil
+	}
+	chainID, found := k.getCounterpartyChainID(ctx, portID, channelID)
+	if !found || !k.isListed(ctx, types.ChainIdEntry, chainID) {
+		return errormod.Wrapf(types.ErrChainNotAllowed, "counterparty chain (%s) of channel ID (%s)", chainID, channelID)
+	}
+	return nil
+}
+
+// getCounterpartyChainID returns the chain id of the tendermint client of the channel.
+func (k Keeper) getCounterpartyChainID(ctx sdk.Context, portID, channelID string) (string, bool) {
+	_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return "", false
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return "", false
+	}
+	return tmClientState.ChainId, true
+}
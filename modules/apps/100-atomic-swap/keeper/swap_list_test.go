@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+func (suite *KeeperTestSuite) TestSwapLists() {
+	path := NewSwapPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().AtomicSwapKeeper
+	maker := suite.chainA.SenderAccount.GetAddress().String()
+	authority := k.GetAuthority()
+	channel := types.ChannelListValue(types.PortID, path.EndpointA.ChannelID)
+
+	makeSwap := func() error {
+		msg := types.NewMsgMakeSwap(
+			types.PortID, path.EndpointA.ChannelID,
+			sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), sdk.NewCoin("osmo", sdk.NewInt(50)),
+			maker, maker, "",
+			suite.chainB.GetTimeoutHeight(), 0,
+			ctx.BlockTime().Unix(),
+		)
+		_, err := k.MakeSwap(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+	update := func(add, remove []types.ListEntry) {
+		_, err := k.UpdateSwapLists(sdk.WrapSDKContext(ctx), types.NewMsgUpdateSwapLists(authority, add, remove))
+		suite.Require().NoError(err)
+	}
+
+	// only the governance module account updates the lists
+	deniedDenom := types.NewListEntry(types.DENYLIST, types.DenomEntry, "osmo")
+	_, err := k.UpdateSwapLists(sdk.WrapSDKContext(ctx), types.NewMsgUpdateSwapLists(maker, []types.ListEntry{deniedDenom}, nil))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	update([]types.ListEntry{deniedDenom}, nil)
+	suite.Require().ErrorIs(makeSwap(), types.ErrDenomNotAllowed)
+
+	res, err := k.SwapLists(sdk.WrapSDKContext(ctx), &types.QuerySwapListsRequest{List: types.DENYLIST})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ListEntry{deniedDenom}, res.Entries)
+	res, err = k.SwapLists(sdk.WrapSDKContext(ctx), &types.QuerySwapListsRequest{List: types.ALLOWLIST})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Entries)
+
+	// once an allowlist has channel entries, the other channels are rejected
+	otherChannel := types.NewListEntry(types.ALLOWLIST, types.ChannelEntry, types.ChannelListValue(types.PortID, "channel-9"))
+	update([]types.ListEntry{otherChannel}, []types.ListEntry{deniedDenom})
+	suite.Require().ErrorIs(makeSwap(), types.ErrChannelNotAllowed)
+
+	// the counterparty chain of the channel is checked too
+	allowedChannel := types.NewListEntry(types.ALLOWLIST, types.ChannelEntry, channel)
+	otherChain := types.NewListEntry(types.ALLOWLIST, types.ChainIdEntry, "other-chain")
+	update([]types.ListEntry{allowedChannel, otherChain}, nil)
+	suite.Require().ErrorIs(makeSwap(), types.ErrChainNotAllowed)
+
+	update([]types.ListEntry{types.NewListEntry(types.ALLOWLIST, types.ChainIdEntry, suite.chainB.ChainID)}, nil)
+	suite.Require().NoError(makeSwap())
+
+	// the denylist takes precedence over the allowlist
+	update([]types.ListEntry{types.NewListEntry(types.DENYLIST, types.ChannelEntry, channel)}, nil)
+	suite.Require().ErrorIs(makeSwap(), types.ErrChannelNotAllowed)
+}
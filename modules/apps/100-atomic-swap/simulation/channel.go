@@ -0,0 +1,109 @@
+package simulation
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	ibctypes "github.com/cosmos/ibc-go/v6/modules/core/types"
+	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
+	tmhash "github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+const (
+	// loopbackChainID is the chain id tracked by the client of the loopback channel.
+	loopbackChainID = "simulation-app"
+	// loopbackTrustingPeriod keeps the client of the loopback channel active during the whole simulation.
+	loopbackTrustingPeriod = 10 * 365 * 24 * time.Hour
+)
+
+// RandomizedLoopbackChannel adds an open swap channel connected to itself to the IBC genesis, so that
+// the simulation operations can send swap packets. The client, connection and channel are appended to
+// the IBC genesis, and the channel capability is given to both the IBC and the atomic swap modules.
+// It must be run after the IBC, capability and atomic swap genesis states have been generated.
+func RandomizedLoopbackChannel(simState *module.SimulationState) {
+	var swapGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &swapGenesis)
+	portID := swapGenesis.PortId
+
+	var ibcGenesis ibctypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[host.ModuleName], &ibcGenesis)
+
+	clientGenesis := &ibcGenesis.ClientGenesis
+	clientID := clienttypes.FormatClientIdentifier(exported.Tendermint, clientGenesis.NextClientSequence)
+	height := clienttypes.NewHeight(clienttypes.ParseChainID(loopbackChainID), 1)
+	clientState := ibctm.NewClientState(
+		loopbackChainID, ibctm.DefaultTrustLevel, loopbackTrustingPeriod, 2*loopbackTrustingPeriod, 10*time.Second,
+		height, commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"}, false, false,
+	)
+	consensusState := ibctm.NewConsensusState(
+		simState.GenTimestamp, commitmenttypes.NewMerkleRoot(tmhash.Sum([]byte(loopbackChainID))), tmhash.Sum([]byte(clientID)),
+	)
+	clientGenesis.Clients = append(clientGenesis.Clients, clienttypes.NewIdentifiedClientState(clientID, clientState))
+	clientGenesis.ClientsConsensus = append(clientGenesis.ClientsConsensus, clienttypes.NewClientConsensusStates(
+		clientID, []clienttypes.ConsensusStateWithHeight{clienttypes.NewConsensusStateWithHeight(height, consensusState)},
+	))
+	clientGenesis.NextClientSequence++
+
+	connectionGenesis := &ibcGenesis.ConnectionGenesis
+	connectionID := connectiontypes.FormatConnectionIdentifier(connectionGenesis.NextConnectionSequence)
+	connectionGenesis.Connections = append(connectionGenesis.Connections, connectiontypes.NewIdentifiedConnection(
+		connectionID,
+		connectiontypes.NewConnectionEnd(
+			connectiontypes.OPEN, clientID,
+			connectiontypes.NewCounterparty(clientID, connectionID, commitmenttypes.NewMerklePrefix([]byte(host.StoreKey))),
+			connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()), 0,
+		),
+	))
+	connectionGenesis.ClientConnectionPaths = append(connectionGenesis.ClientConnectionPaths,
+		connectiontypes.NewConnectionPaths(clientID, []string{connectionID}))
+	connectionGenesis.NextConnectionSequence++
+
+	// the packets of the channel are JSON or protobuf encoded depending on the negotiated version
+	version := types.Version
+	if simState.Rand.Intn(2) == 0 {
+		version = types.Version2
+	}
+	channelGenesis := &ibcGenesis.ChannelGenesis
+	channelID := channeltypes.FormatChannelIdentifier(channelGenesis.NextChannelSequence)
+	channelGenesis.Channels = append(channelGenesis.Channels, channeltypes.NewIdentifiedChannel(
+		portID, channelID,
+		channeltypes.NewChannel(
+			channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(portID, channelID),
+			[]string{connectionID}, version,
+		),
+	))
+	channelGenesis.SendSequences = append(channelGenesis.SendSequences, channeltypes.NewPacketSequence(portID, channelID, 1))
+	channelGenesis.RecvSequences = append(channelGenesis.RecvSequences, channeltypes.NewPacketSequence(portID, channelID, 1))
+	channelGenesis.AckSequences = append(channelGenesis.AckSequences, channeltypes.NewPacketSequence(portID, channelID, 1))
+	channelGenesis.NextChannelSequence++
+
+	simState.GenState[host.ModuleName] = simState.Cdc.MustMarshalJSON(&ibcGenesis)
+
+	var capabilityGenesis capabilitytypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[capabilitytypes.ModuleName], &capabilityGenesis)
+
+	capabilityName := host.ChannelCapabilityPath(portID, channelID)
+	owners := capabilitytypes.NewCapabilityOwners()
+	if err := owners.Set(capabilitytypes.NewOwner(host.ModuleName, capabilityName)); err != nil {
+		panic(err)
+	}
+	if err := owners.Set(capabilitytypes.NewOwner(types.ModuleName, capabilityName)); err != nil {
+		panic(err)
+	}
+	capabilityGenesis.Owners = append(capabilityGenesis.Owners, capabilitytypes.GenesisOwners{
+		Index:       capabilityGenesis.Index,
+		IndexOwners: *owners,
+	})
+	capabilityGenesis.Index++
+
+	simState.GenState[capabilitytypes.ModuleName] = simState.Cdc.MustMarshalJSON(&capabilityGenesis)
+}
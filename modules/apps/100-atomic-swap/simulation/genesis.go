@@ -44,9 +44,36 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { swapMaxFeeRate = RadomInt(r) },
 	)
 
+	var swapFeeRate uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, string(types.KeySwapFeeRate), &swapFeeRate, simState.Rand,
+		func(r *rand.Rand) { swapFeeRate = uint32(r.Int63n(int64(swapMaxFeeRate) + 1)) },
+	)
+
+	var orderRetentionPeriod uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, string(types.KeyOrderRetentionPeriod), &orderRetentionPeriod, simState.Rand,
+		func(r *rand.Rand) { orderRetentionPeriod = uint64(r.Int63n(types.DefaultOrderRetentionPeriod + 1)) },
+	)
+
+	var maxTerminalOrders uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, string(types.KeyMaxTerminalOrders), &maxTerminalOrders, simState.Rand,
+		func(r *rand.Rand) { maxTerminalOrders = uint64(r.Int63n(1001)) },
+	)
+
+	var pruneBatchSize uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, string(types.KeyPruneBatchSize), &pruneBatchSize, simState.Rand,
+		func(r *rand.Rand) { pruneBatchSize = RadomInt(r) },
+	)
+
 	transferGenesis := types.GenesisState{
 		PortId: portID,
-		Params: types.NewParams(swapEnabled, swapMaxFeeRate),
+		Params: types.NewParams(
+			swapEnabled, swapMaxFeeRate, swapFeeRate, "",
+			orderRetentionPeriod, maxTerminalOrders, pruneBatchSize,
+		),
 	}
 
 	bz, err := json.MarshalIndent(&transferGenesis, "", " ")
@@ -0,0 +1,442 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/keeper"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgMakeSwap   = "op_weight_msg_make_swap"
+	OpWeightMsgTakeSwap   = "op_weight_msg_take_swap"
+	OpWeightMsgCancelSwap = "op_weight_msg_cancel_swap"
+
+	DefaultWeightMsgMakeSwap   = 100
+	DefaultWeightMsgTakeSwap   = 60
+	DefaultWeightMsgCancelSwap = 20
+)
+
+// packetTimeout is the timeout of the packets sent by the simulation operations.
+const packetTimeout = time.Hour
+
+// WeightedOperations returns all the operations from the atomic swap module with their respective weights.
+// The operations run on the open channels of the swap port, the simulated genesis opens a loopback channel.
+// The counterparty chain is simulated by the operations: they deliver the packets it sends and acknowledge
+// or time out the packets sent to it, and check the module invariants after each step.
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, k keeper.Keeper, bk types.BankKeeper) simulation.WeightedOperations {
+	var weightMsgMakeSwap, weightMsgTakeSwap, weightMsgCancelSwap int
+	appParams.GetOrGenerate(cdc, OpWeightMsgMakeSwap, &weightMsgMakeSwap, nil,
+		func(_ *rand.Rand) { weightMsgMakeSwap = DefaultWeightMsgMakeSwap },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgTakeSwap, &weightMsgTakeSwap, nil,
+		func(_ *rand.Rand) { weightMsgTakeSwap = DefaultWeightMsgTakeSwap },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelSwap, &weightMsgCancelSwap, nil,
+		func(_ *rand.Rand) { weightMsgCancelSwap = DefaultWeightMsgCancelSwap },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgMakeSwap, SimulateMsgMakeSwap(k, bk)),
+		simulation.NewWeightedOperation(weightMsgTakeSwap, SimulateMsgTakeSwap(k, bk)),
+		simulation.NewWeightedOperation(weightMsgCancelSwap, SimulateMsgCancelSwap(k)),
+	}
+}
+
+// SimulateMsgMakeSwap generates a MakeSwapMsg with random values. The order is made either on this
+// chain, and acknowledged, rejected or timed out by the counterparty, or on the counterparty chain.
+func SimulateMsgMakeSwap(k keeper.Keeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		channel, found := randomChannel(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMakeSwap, "no open swap channel"), nil, nil
+		}
+
+		maker, _ := simtypes.RandomAcc(r, accs)
+		sellToken, found := randomCoin(r, bk.SpendableCoins(ctx, maker.Address))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMakeSwap, "maker has no spendable coins"), nil, nil
+		}
+		other, _ := simtypes.RandomAcc(r, accs)
+		buyToken, found := randomCoin(r, bk.SpendableCoins(ctx, other.Address))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMakeSwap, "no buy token"), nil, nil
+		}
+
+		// the tokens of an order made on the counterparty chain are swapped the other way round
+		remote := r.Intn(2) == 0
+		if remote {
+			sellToken, buyToken = buyToken, sellToken
+		}
+
+		var desiredTaker string
+		if r.Intn(5) == 0 {
+			taker, _ := simtypes.RandomAcc(r, accs)
+			desiredTaker = taker.Address.String()
+		}
+		// the maker receives the buy token on the other chain
+		makerReceivingAddress := counterpartyAddress(r)
+		if remote {
+			makerReceivingAddress = maker.Address.String()
+		}
+
+		msg := types.NewMsgMakeSwap(
+			channel.PortId, channel.ChannelId, sellToken, buyToken, maker.Address.String(), makerReceivingAddress,
+			desiredTaker, clienttypes.ZeroHeight(), packetTimeoutTimestamp(ctx), ctx.BlockTime().Unix(),
+		)
+		msg.AllowPartialFill = r.Intn(2) == 0
+		if r.Intn(2) == 0 {
+			msg.ExpirationTimestamp = uint64(ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 48)) * time.Hour).Unix())
+		}
+
+		if remote {
+			return simulateReceiveMake(r, ctx, k, channel, msg)
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		res, err := k.MakeSwap(sdk.WrapSDKContext(cacheCtx), msg)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMakeSwap, err.Error()), nil, nil
+		}
+		write()
+		if err := checkInvariants(ctx, k); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMakeSwap, ""), nil, err
+		}
+
+		order, _ := k.GetAtomicOrder(ctx, res.OrderId)
+		data := types.AtomicSwapPacketData{
+			Type:    types.MAKE_SWAP,
+			Data:    mustMarshalPacketMsg(channel, msg),
+			OrderId: order.Id,
+			Path:    order.Path,
+		}
+		result := mustMarshalPacketMsg(channel, &types.MsgMakeSwapResponse{OrderId: order.Id})
+		if err := simulatePacketOutcome(r, ctx, k, channel, data, result); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMakeSwap, ""), nil, err
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, types.TypeMsgMakeSwap, "", true, nil), nil, nil
+	}
+}
+
+// simulateReceiveMake delivers an order made on the counterparty chain.
+func simulateReceiveMake(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, channel channeltypes.IdentifiedChannel, msg *types.MakeSwapMsg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msg.SourcePort = channel.Counterparty.PortId
+	msg.SourceChannel = channel.Counterparty.ChannelId
+	path := fmt.Sprintf("channel/%s/port/%s/channel/%s/port/%s/%s",
+		msg.SourceChannel, msg.SourcePort, channel.ChannelId, channel.PortId, simtypes.RandStringOfLength(r, 10))
+	data := types.AtomicSwapPacketData{
+		Type:    types.MAKE_SWAP,
+		Data:    mustMarshalPacketMsg(channel, msg),
+		OrderId: randomOrderID(r),
+		Path:    path,
+	}
+
+	if err := receivePacket(ctx, k, channel, data); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMakeSwap, err.Error()), nil, nil
+	}
+	if err := checkInvariants(ctx, k); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMakeSwap, ""), nil, err
+	}
+	return simtypes.NewOperationMsgBasic(types.ModuleName, types.TypeMsgMakeSwap, "received", true, nil), nil, nil
+}
+
+// SimulateMsgTakeSwap takes a random open order. An order made on the counterparty chain is taken with a
+// TakeSwapMsg, an order made on this chain is taken on the counterparty chain.
+func SimulateMsgTakeSwap(k keeper.Keeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		order, found := randomOrder(r, ctx, k, func(order types.Order) bool {
+			return order.Status == types.Status_SYNC && order.Takers == nil &&
+				!order.Maker.IsExpired(ctx.BlockTime().Unix())
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTakeSwap, "no open order"), nil, nil
+		}
+		channel, found := orderChannel(ctx, k, order)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTakeSwap, "order channel is not open"), nil, nil
+		}
+
+		taker, _ := simtypes.RandomAcc(r, accs)
+		if order.Maker.DesiredTaker != "" {
+			var found bool
+			if taker, found = simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(order.Maker.DesiredTaker)); !found {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTakeSwap, "desired taker is not a simulation account"), nil, nil
+			}
+		}
+		sellToken, found := randomFill(r, ctx, order)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTakeSwap, "no valid fill amount"), nil, nil
+		}
+
+		if order.Side == types.NATIVE {
+			// the taker chain sends the take to the maker chain
+			receiver, _ := simtypes.RandomAcc(r, accs)
+			msg := &types.TakeSwapMsg{
+				OrderId:               order.Id,
+				SellToken:             sellToken,
+				TakerAddress:          taker.Address.String(),
+				TakerReceivingAddress: receiver.Address.String(),
+				CreateTimestamp:       ctx.BlockTime().Unix(),
+			}
+			data := types.AtomicSwapPacketData{
+				Type:    types.TAKE_SWAP,
+				Data:    mustMarshalPacketMsg(channel, msg),
+				OrderId: order.Id,
+			}
+			if err := receivePacket(ctx, k, channel, data); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTakeSwap, err.Error()), nil, nil
+			}
+			if err := checkInvariants(ctx, k); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTakeSwap, ""), nil, err
+			}
+			return simtypes.NewOperationMsgBasic(types.ModuleName, types.TypeMsgTakeSwap, "received", true, nil), nil, nil
+		}
+
+		if bk.SpendableCoins(ctx, taker.Address).AmountOf(sellToken.Denom).LT(sellToken.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTakeSwap, "insufficient funds"), nil, nil
+		}
+
+		msg := types.NewMsgTakeSwap(
+			order.Id, sellToken, taker.Address.String(), counterpartyAddress(r),
+			clienttypes.ZeroHeight(), packetTimeoutTimestamp(ctx), ctx.BlockTime().Unix(),
+		)
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.TakeSwap(sdk.WrapSDKContext(cacheCtx), msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTakeSwap, err.Error()), nil, nil
+		}
+		write()
+		if err := checkInvariants(ctx, k); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTakeSwap, ""), nil, err
+		}
+
+		data := types.AtomicSwapPacketData{
+			Type: types.TAKE_SWAP,
+			Data: mustMarshalPacketMsg(channel, msg),
+		}
+		result := mustMarshalPacketMsg(channel, &types.MsgTakeSwapResponse{OrderId: order.Id, PaidToken: sellToken})
+		if err := simulatePacketOutcome(r, ctx, k, channel, data, result); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTakeSwap, ""), nil, err
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, types.TypeMsgTakeSwap, "", true, nil), nil, nil
+	}
+}
+
+// SimulateMsgCancelSwap cancels a random open order. An order made on this chain is cancelled with a
+// CancelSwapMsg, an order made on the counterparty chain is cancelled by its maker on that chain.
+func SimulateMsgCancelSwap(k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		order, found := randomOrder(r, ctx, k, func(order types.Order) bool {
+			return (order.Status == types.Status_INITIAL || order.Status == types.Status_SYNC) && order.Takers == nil
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelSwap, "no open order"), nil, nil
+		}
+		channel, found := orderChannel(ctx, k, order)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelSwap, "order channel is not open"), nil, nil
+		}
+
+		msg := types.NewMsgCancelSwap(order.Maker.MakerAddress, order.Id, clienttypes.ZeroHeight(), packetTimeoutTimestamp(ctx))
+		msg.CreateTimestamp = ctx.BlockTime().Unix()
+		data := types.AtomicSwapPacketData{
+			Type: types.CANCEL_SWAP,
+			Data: mustMarshalPacketMsg(channel, msg),
+		}
+
+		if order.Side == types.REMOTE {
+			if err := receivePacket(ctx, k, channel, data); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelSwap, err.Error()), nil, nil
+			}
+			if err := checkInvariants(ctx, k); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelSwap, ""), nil, err
+			}
+			return simtypes.NewOperationMsgBasic(types.ModuleName, types.TypeMsgCancelSwap, "received", true, nil), nil, nil
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.CancelSwap(sdk.WrapSDKContext(cacheCtx), msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelSwap, err.Error()), nil, nil
+		}
+		write()
+		if err := checkInvariants(ctx, k); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelSwap, ""), nil, err
+		}
+
+		result := mustMarshalPacketMsg(channel, &types.MsgCancelSwapResponse{OrderId: order.Id})
+		if err := simulatePacketOutcome(r, ctx, k, channel, data, result); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelSwap, ""), nil, err
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, types.TypeMsgCancelSwap, "", true, nil), nil, nil
+	}
+}
+
+// simulatePacketOutcome acknowledges, rejects or times out a packet sent to the counterparty chain,
+// and checks the invariants once the outcome has been processed.
+func simulatePacketOutcome(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, channel channeltypes.IdentifiedChannel,
+	data types.AtomicSwapPacketData, result []byte,
+) error {
+	// the packet handlers do not depend on the sequence of the packet
+	packet := channeltypes.NewPacket(
+		types.NewPacketCodec(channel.Version).MarshalPacketData(data), 0, channel.PortId, channel.ChannelId, channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		clienttypes.ZeroHeight(), packetTimeoutTimestamp(ctx),
+	)
+
+	var err error
+	switch outcome := r.Intn(10); {
+	case outcome < 8:
+		err = k.OnAcknowledgementPacket(ctx, packet, &data, channeltypes.NewResultAcknowledgement(result))
+	case outcome < 9:
+		err = k.OnAcknowledgementPacket(ctx, packet, &data, types.NewErrorAcknowledgement(errors.New("rejected by the counterparty")))
+	default:
+		err = k.OnTimeoutPacket(ctx, packet, &data)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to process the outcome of a %s packet: %w", data.Type, err)
+	}
+	return checkInvariants(ctx, k)
+}
+
+// mustMarshalPacketMsg encodes a message carried by a packet or the result of an acknowledgement
+// with the encoding of the channel version.
+func mustMarshalPacketMsg(channel channeltypes.IdentifiedChannel, msg codec.ProtoMarshaler) []byte {
+	bz, err := types.NewPacketCodec(channel.Version).MarshalMsg(msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// receivePacket delivers a packet sent by the counterparty chain. The state changes are discarded if the
+// packet is rejected, as they would be with an error acknowledgement.
+func receivePacket(ctx sdk.Context, k keeper.Keeper, channel channeltypes.IdentifiedChannel, data types.AtomicSwapPacketData) error {
+	packet := channeltypes.NewPacket(
+		types.NewPacketCodec(channel.Version).MarshalPacketData(data), 0, channel.Counterparty.PortId, channel.Counterparty.ChannelId, channel.PortId, channel.ChannelId,
+		clienttypes.ZeroHeight(), packetTimeoutTimestamp(ctx),
+	)
+
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := k.OnRecvPacket(cacheCtx, packet, data); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+// checkInvariants returns an error if any of the module invariants is broken.
+func checkInvariants(ctx sdk.Context, k keeper.Keeper) error {
+	if msg, broken := keeper.AllInvariants(k)(ctx); broken {
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// randomChannel returns a random open channel of the swap port.
+func randomChannel(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (channeltypes.IdentifiedChannel, bool) {
+	channels := k.GetOpenChannels(ctx)
+	if len(channels) == 0 {
+		return channeltypes.IdentifiedChannel{}, false
+	}
+	return channels[r.Intn(len(channels))], true
+}
+
+// orderChannel returns the open channel the order is settled on.
+func orderChannel(ctx sdk.Context, k keeper.Keeper, order types.Order) (channeltypes.IdentifiedChannel, bool) {
+	// the path of an order made on the counterparty chain ends with the local channel and port
+	portID, channelID := order.Maker.SourcePort, order.Maker.SourceChannel
+	if order.Side == types.REMOTE {
+		parts := strings.Split(order.Path, "/")
+		portID, channelID = parts[7], parts[5]
+	}
+	for _, channel := range k.GetOpenChannels(ctx) {
+		if channel.PortId == portID && channel.ChannelId == channelID {
+			return channel, true
+		}
+	}
+	return channeltypes.IdentifiedChannel{}, false
+}
+
+// randomOrder returns a random IBC order matching the filter.
+func randomOrder(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, filter func(order types.Order) bool) (types.Order, bool) {
+	var orders []types.Order
+	for _, order := range k.GetAllOrder(ctx) {
+		if order.Kind == types.IBCOrder && filter(order) {
+			orders = append(orders, order)
+		}
+	}
+	if len(orders) == 0 {
+		return types.Order{}, false
+	}
+	return orders[r.Intn(len(orders))], true
+}
+
+// randomFill returns a random buy token amount accepted by the order.
+func randomFill(r *rand.Rand, ctx sdk.Context, order types.Order) (sdk.Coin, bool) {
+	sellToken := order.Maker.CurrentBuyToken(ctx.BlockTime().Unix())
+	if order.Maker.AllowPartialFill {
+		amount, err := simtypes.RandPositiveInt(r, order.RemainingBuyAmount())
+		if err != nil {
+			return sdk.Coin{}, false
+		}
+		sellToken.Amount = amount
+	}
+	if err := order.ValidateFill(sellToken, ctx.BlockTime().Unix()); err != nil {
+		return sdk.Coin{}, false
+	}
+	return sellToken, true
+}
+
+// randomCoin returns a random amount of a random coin.
+func randomCoin(r *rand.Rand, coins sdk.Coins) (sdk.Coin, bool) {
+	if coins.Empty() {
+		return sdk.Coin{}, false
+	}
+	coin := coins[r.Intn(len(coins))]
+	amount, err := simtypes.RandPositiveInt(r, coin.Amount)
+	if err != nil {
+		return sdk.Coin{}, false
+	}
+	return sdk.NewCoin(coin.Denom, amount), true
+}
+
+// randomOrderID returns a random order id, as generated by the counterparty chain.
+func randomOrderID(r *rand.Rand) string {
+	bz := make([]byte, 10)
+	r.Read(bz)
+	return hex.EncodeToString(bz)
+}
+
+// counterpartyAddress returns a random address on the counterparty chain.
+func counterpartyAddress(r *rand.Rand) string {
+	return "counterparty" + simtypes.RandStringOfLength(r, 20)
+}
+
+// packetTimeoutTimestamp returns the timeout timestamp of the packets sent at the block time.
+func packetTimeoutTimestamp(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockTime().Add(packetTimeout).UnixNano())
+}
@@ -0,0 +1,63 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/keeper"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/simulation"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+	ibctesting "github.com/sideprotocol/ibcswap/v6/testing"
+)
+
+// TestWeightedOperations runs the atomic swap operations on an open swap channel and checks
+// that the orders go through their whole lifecycle with the invariants holding.
+func TestWeightedOperations(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.AtomicSwapPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.AtomicSwapPort
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+	coordinator.Setup(path)
+
+	app := chainA.GetSimApp()
+	ctx := chainA.GetContext()
+	k := app.AtomicSwapKeeper
+
+	var accs []simtypes.Account
+	for _, sender := range chainA.SenderAccounts {
+		accs = append(accs, simtypes.Account{
+			PrivKey: sender.SenderPrivKey,
+			PubKey:  sender.SenderPrivKey.PubKey(),
+			Address: sender.SenderAccount.GetAddress(),
+		})
+	}
+
+	operations := simulation.WeightedOperations(make(simtypes.AppParams), app.AppCodec(), k, app.BankKeeper)
+	require.Len(t, operations, 3)
+
+	r := rand.New(rand.NewSource(1))
+	executed := make(map[string]int)
+	for i := 0; i < 200; i++ {
+		operation := operations[r.Intn(len(operations))]
+		opMsg, _, err := operation.Op()(r, app.GetBaseApp(), ctx, accs, ctx.ChainID())
+		require.NoError(t, err)
+		if opMsg.OK {
+			executed[opMsg.Name]++
+		}
+	}
+
+	require.Positive(t, executed[types.TypeMsgMakeSwap])
+	require.Positive(t, executed[types.TypeMsgTakeSwap])
+	require.Positive(t, executed[types.TypeMsgCancelSwap])
+
+	_, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
 	ibctesting "github.com/sideprotocol/ibcswap/v6/testing"
 )
@@ -77,6 +78,102 @@ func (suite *SwapTestSuite) TestHandleMsgSwap() {
 
 }
 
+// TestProtoEncodedPackets relays a make swap over a channel negotiated with the v2 version,
+// whose packets and acknowledgements are protobuf encoded.
+func (suite *SwapTestSuite) TestProtoEncodedPackets() {
+	path := NewSwapTestPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Version = types.Version2
+	path.EndpointB.ChannelConfig.Version = types.Version2
+	suite.coordinator.Setup(path)
+
+	maker := suite.chainA.SenderAccount.GetAddress().String()
+	msg := types.NewMsgMakeSwap(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)),
+		maker, maker, "",
+		suite.chainB.GetTimeoutHeight(), 0, suite.chainA.GetContext().BlockTime().Unix())
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// the packet data and the message it carries are not JSON encoded
+	var data types.AtomicSwapPacketData
+	suite.Require().Error(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+	data, err = types.NewPacketCodec(types.Version2).UnmarshalPacketData(packet.GetData())
+	suite.Require().NoError(err)
+	var makeMsg types.MakeSwapMsg
+	suite.Require().NoError(types.ModuleCdc.Unmarshal(data.Data, &makeMsg))
+	suite.Require().Equal(msg.SellToken, makeMsg.SellToken)
+
+	// the protobuf encoded acknowledgement is processed by the maker chain
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	order, found := suite.chainB.GetSimApp().AtomicSwapKeeper.GetAtomicOrder(suite.chainB.GetContext(), data.OrderId)
+	suite.Require().True(found)
+	suite.Require().Equal(types.REMOTE, order.Side)
+	order, found = suite.chainA.GetSimApp().AtomicSwapKeeper.GetAtomicOrder(suite.chainA.GetContext(), data.OrderId)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Status_SYNC, order.Status)
+}
+
+// TestRateLimitedPacket relays a take whose release of the sell tokens exceeds the rate limit of the
+// maker chain: the take is rejected and refunded, and the channel is paused on the maker chain.
+func (suite *SwapTestSuite) TestRateLimitedPacket() {
+	path := NewSwapTestPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	maker := suite.chainA.SenderAccount.GetAddress().String()
+	taker := suite.chainB.SenderAccount.GetAddress()
+	sellToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	buyToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))
+	makeMsg := types.NewMsgMakeSwap(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		sellToken, buyToken, maker, taker.String(), "",
+		suite.chainB.GetTimeoutHeight(), 0, suite.chainA.GetContext().BlockTime().Unix())
+	res, err := suite.chainA.SendMsgs(makeMsg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+	data, err := types.NewPacketCodec(types.Version).UnmarshalPacketData(packet.GetData())
+	suite.Require().NoError(err)
+
+	appA, appB := suite.chainA.GetSimApp(), suite.chainB.GetSimApp()
+	appA.AtomicSwapKeeper.SetRateLimit(suite.chainA.GetContext(), types.NewRateLimit(types.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, sdk.NewInt(10), 3600))
+	escrowA := types.GetEscrowAddress(types.PortID, path.EndpointA.ChannelID)
+	takerBalance := appB.BankKeeper.GetBalance(suite.chainB.GetContext(), taker, sdk.DefaultBondDenom)
+
+	// the taker chain locks the tokens of the taker and sends the take
+	ctxB := suite.chainB.GetContext()
+	takeMsg := types.NewMsgTakeSwap(data.OrderId, buyToken, taker.String(), maker,
+		suite.chainA.GetTimeoutHeight(), 0, ctxB.BlockTime().Unix())
+	suite.Require().NoError(appB.BankKeeper.SendCoins(ctxB, taker, types.GetEscrowAddress(types.PortID, path.EndpointB.ChannelID), sdk.NewCoins(buyToken)))
+	takeData, err := types.ModuleCdc.MarshalJSON(takeMsg)
+	suite.Require().NoError(err)
+	takePacket := types.AtomicSwapPacketData{Type: types.TAKE_SWAP, Data: takeData, OrderId: data.OrderId}
+	sequence, err := appB.AtomicSwapKeeper.SendSwapPacket(ctxB, types.PortID, path.EndpointB.ChannelID, suite.chainA.GetTimeoutHeight(), 0, takePacket)
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	packet = channeltypes.NewPacket(types.NewPacketCodec(types.Version).MarshalPacketData(takePacket), *sequence,
+		types.PortID, path.EndpointB.ChannelID, types.PortID, path.EndpointA.ChannelID, suite.chainA.GetTimeoutHeight(), 0)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	// the order is not settled on the maker chain, whose channel is paused
+	ctxA := suite.chainA.GetContext()
+	suite.Require().True(appA.AtomicSwapKeeper.IsChannelPaused(ctxA, types.PortID, path.EndpointA.ChannelID))
+	order, found := appA.AtomicSwapKeeper.GetAtomicOrder(ctxA, data.OrderId)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Status_SYNC, order.Status)
+	suite.Require().Equal(sellToken, appA.BankKeeper.GetBalance(ctxA, escrowA, sdk.DefaultBondDenom))
+
+	// the error acknowledgement refunds the taker
+	suite.Require().Equal(takerBalance, appB.BankKeeper.GetBalance(suite.chainB.GetContext(), taker, sdk.DefaultBondDenom))
+	order, found = appB.AtomicSwapKeeper.GetAtomicOrder(suite.chainB.GetContext(), data.OrderId)
+	suite.Require().True(found)
+	suite.Require().NotNil(order.AckError)
+}
+
 func TestSwapTestSuite(t *testing.T) {
 	suite.Run(t, new(SwapTestSuite))
 }
@@ -12,6 +12,17 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MakeSwapMsg{}, "cosmos-sdk/MsgMakeSwap", nil)
 	cdc.RegisterConcrete(&TakeSwapMsg{}, "cosmos-sdk/MsgTakeSwap", nil)
 	cdc.RegisterConcrete(&CancelSwapMsg{}, "cosmos-sdk/MsgCancelSwap", nil)
+	cdc.RegisterConcrete(&MakeBidMsg{}, "cosmos-sdk/MsgMakeBid", nil)
+	cdc.RegisterConcrete(&AcceptBidMsg{}, "cosmos-sdk/MsgAcceptBid", nil)
+	cdc.RegisterConcrete(&CancelBidMsg{}, "cosmos-sdk/MsgCancelBid", nil)
+	cdc.RegisterConcrete(&LockHTLCMsg{}, "cosmos-sdk/MsgLockHTLC", nil)
+	cdc.RegisterConcrete(&ClaimHTLCMsg{}, "cosmos-sdk/MsgClaimHTLC", nil)
+	cdc.RegisterConcrete(&RefundHTLCMsg{}, "cosmos-sdk/MsgRefundHTLC", nil)
+	cdc.RegisterConcrete(&CancelChannelOrdersMsg{}, "cosmos-sdk/MsgCancelChannelOrders", nil)
+	cdc.RegisterConcrete(&FillSignedOrderMsg{}, "cosmos-sdk/MsgFillSignedOrder", nil)
+	cdc.RegisterConcrete(&UpdateSwapListsMsg{}, "cosmos-sdk/MsgUpdateSwapLists", nil)
+	cdc.RegisterConcrete(&SetRateLimitsMsg{}, "cosmos-sdk/MsgSetRateLimits", nil)
+	cdc.RegisterConcrete(&UnpauseChannelMsg{}, "cosmos-sdk/MsgUnpauseChannel", nil)
 }
 
 // RegisterInterfaces register the ibc transfer module interfaces to protobuf
@@ -20,6 +31,17 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MakeSwapMsg{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &TakeSwapMsg{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &CancelSwapMsg{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MakeBidMsg{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &AcceptBidMsg{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &CancelBidMsg{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &LockHTLCMsg{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &ClaimHTLCMsg{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &RefundHTLCMsg{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &CancelChannelOrdersMsg{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &FillSignedOrderMsg{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &UpdateSwapListsMsg{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &SetRateLimitsMsg{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &UnpauseChannelMsg{})
 	//registry.RegisterImplementations((*sdk.Msg)(nil), &MsgMakeSwapResponse{})
 	//registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTakeSwapResponse{})
 	//registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelSwapResponse{})
@@ -30,4 +30,28 @@ var (
 	ErrInvalidTakerAddress         = sdkerrors.Register(ModuleName, 23, "invalid taker address")
 	ErrAlreadyOrderTook            = sdkerrors.Register(ModuleName, 24, "already order took")
 	ErrNotFoundOrder               = sdkerrors.Register(ModuleName, 25, "did not find order")
+	ErrOrderExpired                = sdkerrors.Register(ModuleName, 26, "order has expired")
+	ErrBidNotFound                 = sdkerrors.Register(ModuleName, 27, "bid does not exist")
+	ErrBidAlreadyExists            = sdkerrors.Register(ModuleName, 28, "bidder has an open bid on the order")
+	ErrInvalidBidStatus            = sdkerrors.Register(ModuleName, 29, "invalid bid status")
+	ErrBidNotAllowed               = sdkerrors.Register(ModuleName, 30, "bids are not allowed on partially fillable orders")
+	ErrOrderBidAccepted            = sdkerrors.Register(ModuleName, 31, "a bid on the order has been accepted")
+	ErrInvalidPriceSchedule        = sdkerrors.Register(ModuleName, 32, "invalid price schedule")
+	ErrInvalidOrderKind            = sdkerrors.Register(ModuleName, 33, "operation not supported by the order kind")
+	ErrInvalidHashLock             = sdkerrors.Register(ModuleName, 34, "invalid hash lock")
+	ErrInvalidPreimage             = sdkerrors.Register(ModuleName, 35, "preimage does not match the hash lock")
+	ErrOrderNotExpired             = sdkerrors.Register(ModuleName, 36, "order has not expired yet")
+	ErrOrderAlreadyExists          = sdkerrors.Register(ModuleName, 37, "order already exists")
+	ErrChannelNotClosed            = sdkerrors.Register(ModuleName, 38, "channel is not closed")
+	ErrInvalidBasket               = sdkerrors.Register(ModuleName, 39, "invalid basket order")
+	ErrBasketNotSupported          = sdkerrors.Register(ModuleName, 40, "operation not supported by basket orders")
+	ErrInvalidSignature            = sdkerrors.Register(ModuleName, 41, "invalid order signature")
+	ErrNonceUsed                   = sdkerrors.Register(ModuleName, 42, "order nonce already used")
+	ErrInvalidListEntry            = sdkerrors.Register(ModuleName, 43, "invalid swap list entry")
+	ErrDenomNotAllowed             = sdkerrors.Register(ModuleName, 44, "denom is not allowed to be swapped")
+	ErrChannelNotAllowed           = sdkerrors.Register(ModuleName, 45, "channel is not allowed to carry swaps")
+	ErrChainNotAllowed             = sdkerrors.Register(ModuleName, 46, "counterparty chain is not allowed to swap")
+	ErrInvalidRateLimit            = sdkerrors.Register(ModuleName, 47, "invalid rate limit")
+	ErrRateLimitExceeded           = sdkerrors.Register(ModuleName, 48, "escrow outflow exceeds the rate limit")
+	ErrChannelPaused               = sdkerrors.Register(ModuleName, 49, "escrow outflows of the channel are paused")
 )
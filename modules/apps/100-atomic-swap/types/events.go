@@ -20,16 +20,49 @@ const (
 	AttributeOrderId       = "order_id"
 	AttributeAction        = "action"
 	AttributeName          = "name"
+	AttributeBidder        = "bidder"
+	AttributeMaker         = "maker"
+	AttributeNonce         = "nonce"
+	AttributeKeyPortId     = "port_id"
+	AttributeKeyChannelId  = "channel_id"
+	AttributeKeyDenom      = "denom"
 )
 
 const (
-	EventValueActionMakeOrder   = "make_order"
-	EventValueActionTakeOrder   = "take_order"
-	EventValueActionCancelOrder = "cancel_order"
-	EventOwner                  = "atomic_swap"
+	EventValueActionMakeOrder       = "make_order"
+	EventValueActionTakeOrder       = "take_order"
+	EventValueActionCancelOrder     = "cancel_order"
+	EventValueActionExpireOrder     = "expire_order"
+	EventValueActionCollectFee      = "collect_fee"
+	EventValueActionMakeBid         = "make_bid"
+	EventValueActionAcceptBid       = "accept_bid"
+	EventValueActionCancelBid       = "cancel_bid"
+	EventValueActionRefundBid       = "refund_bid"
+	EventValueActionLockHTLC        = "lock_htlc"
+	EventValueActionClaimHTLC       = "claim_htlc"
+	EventValueActionRefundHTLC      = "refund_htlc"
+	EventValueActionFillSigned      = "fill_signed_order"
+	EventValueActionUpdateSwapLists = "update_swap_lists"
+	EventValueActionSetRateLimits   = "set_rate_limits"
+	EventValueActionPauseChannel    = "pause_channel"
+	EventValueActionUnpauseChannel  = "unpause_channel"
+	EventOwner                      = "atomic_swap"
 )
 
 const (
-	EventValueSuffixReceived     = "received"
-	EventValueSuffixAcknowledged = "acknowledged"
+	EventValueSuffixReceived      = "received"
+	EventValueSuffixAcknowledged  = "acknowledged"
+	EventValueSuffixChannelClosed = "channel_closed"
+)
+
+// Reasons of the typed cancel and refund events
+const (
+	ReasonCancelled     = "cancelled"
+	ReasonExpired       = "expired"
+	ReasonChannelClosed = "channel_closed"
+	ReasonTimeout       = "timeout"
+	ReasonErrorAck      = "error_acknowledgement"
+	ReasonOverpayment   = "overpayment"
+	// the bids left when the order is completed by another taker or bid
+	ReasonOrderCompleted = "order_completed"
 )
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 )
 
@@ -26,5 +30,104 @@ func (gs GenesisState) Validate() error {
 	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
 		return err
 	}
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	orderIds := make(map[string]bool, len(gs.Orders))
+	positions := make(map[uint64]bool, len(gs.Orders))
+	for _, entry := range gs.Orders {
+		order := entry.Order
+		if strings.TrimSpace(order.Id) == "" {
+			return fmt.Errorf("order at position %d has an empty id", entry.Position)
+		}
+		if orderIds[order.Id] {
+			return fmt.Errorf("duplicate order id %s", order.Id)
+		}
+		orderIds[order.Id] = true
+		if positions[entry.Position] {
+			return fmt.Errorf("duplicate order position %d", entry.Position)
+		}
+		positions[entry.Position] = true
+		if entry.Position >= gs.OrderCount {
+			return fmt.Errorf("order %s position %d is not below the order count %d", order.Id, entry.Position, gs.OrderCount)
+		}
+		if _, ok := Status_name[int32(order.Status)]; !ok {
+			return fmt.Errorf("order %s has an invalid status %d", order.Id, order.Status)
+		}
+		if _, ok := Side_name[int32(order.Side)]; !ok {
+			return fmt.Errorf("order %s has an invalid side %d", order.Id, order.Side)
+		}
+		if !order.HasConsistentTimestamps() {
+			return fmt.Errorf("order %s in status %s has complete timestamp %d and cancel timestamp %d",
+				order.Id, order.Status, order.CompleteTimestamp, order.CancelTimestamp)
+		}
+	}
+
+	bids := make(map[string]bool, len(gs.Bids))
+	for _, bid := range gs.Bids {
+		if !orderIds[bid.OrderId] {
+			return fmt.Errorf("bid of %s on unknown order %s", bid.Bidder, bid.OrderId)
+		}
+		key := bid.OrderId + "/" + bid.Bidder
+		if bids[key] {
+			return fmt.Errorf("duplicate bid of %s on order %s", bid.Bidder, bid.OrderId)
+		}
+		bids[key] = true
+	}
+
+	entries := make(map[string]bool, len(gs.SwapLists))
+	for _, entry := range gs.SwapLists {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+		key := string(SwapListEntryKey(entry))
+		if entries[key] {
+			return fmt.Errorf("duplicate %s %s %s", entry.List, entry.Kind, entry.Value)
+		}
+		entries[key] = true
+	}
+
+	rateLimits := make(map[string]bool, len(gs.RateLimits))
+	for _, usage := range gs.RateLimits {
+		rateLimit := usage.RateLimit
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		if !rateLimit.MaxOutflow.IsPositive() {
+			return fmt.Errorf("rate limit of %s on %s/%s without max outflow", rateLimit.Denom, rateLimit.PortId, rateLimit.ChannelId)
+		}
+		key := string(RateLimitStoreKey(RateLimitKey, rateLimit.PortId, rateLimit.ChannelId, rateLimit.Denom))
+		if rateLimits[key] {
+			return fmt.Errorf("duplicate rate limit of %s on %s/%s", rateLimit.Denom, rateLimit.PortId, rateLimit.ChannelId)
+		}
+		rateLimits[key] = true
+		if usage.Flow.Outflow.IsNil() || usage.Flow.Outflow.IsNegative() || usage.Flow.Inflow.IsNil() || usage.Flow.Inflow.IsNegative() {
+			return fmt.Errorf("invalid flow of the rate limit of %s on %s/%s", rateLimit.Denom, rateLimit.PortId, rateLimit.ChannelId)
+		}
+	}
+
+	pausedChannels := make(map[string]bool, len(gs.PausedChannels))
+	for _, channel := range gs.PausedChannels {
+		if _, _, err := ParseChannelListValue(channel); err != nil {
+			return err
+		}
+		if pausedChannels[channel] {
+			return fmt.Errorf("duplicate paused channel %s", channel)
+		}
+		pausedChannels[channel] = true
+	}
+
+	nonces := make(map[string]bool, len(gs.SignedOrderNonces))
+	for _, nonce := range gs.SignedOrderNonces {
+		if _, err := sdk.AccAddressFromBech32(nonce.Maker); err != nil {
+			return fmt.Errorf("invalid maker %s of signed order nonce %d: %w", nonce.Maker, nonce.Nonce, err)
+		}
+		key := string(SignedOrderNonceStoreKey(nonce.Maker, nonce.Nonce))
+		if nonces[key] {
+			return fmt.Errorf("duplicate nonce %d of maker %s", nonce.Nonce, nonce.Maker)
+		}
+		nonces[key] = true
+	}
+	return nil
 }
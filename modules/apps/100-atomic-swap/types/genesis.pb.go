@@ -25,9 +25,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the ibc-transfer genesis state
 type GenesisState struct {
-	PortId string   `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
-	Params Params   `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
-	Orders []*Order `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// orders are the orders of the order book with their position
+	Orders []GenesisOrder `protobuf:"bytes,4,rep,name=orders,proto3" json:"orders"`
+	// order_count is the position of the next order appended to the order book
+	OrderCount uint64 `protobuf:"varint,5,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty" yaml:"order_count"`
+	Bids       []Bid  `protobuf:"bytes,6,rep,name=bids,proto3" json:"bids"`
+	// swap_lists are the entries of the swap allowlist and denylist
+	SwapLists []ListEntry `protobuf:"bytes,7,rep,name=swap_lists,json=swapLists,proto3" json:"swap_lists" yaml:"swap_lists"`
+	// rate_limits are the escrow rate limits with their flow in the current window
+	RateLimits []RateLimitUsage `protobuf:"bytes,8,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
+	// paused_channels are the channels paused by their rate limits, as port_id/channel_id
+	PausedChannels []string `protobuf:"bytes,9,rep,name=paused_channels,json=pausedChannels,proto3" json:"paused_channels,omitempty" yaml:"paused_channels"`
+	// signed_order_nonces are the nonces of the signed orders filled per maker
+	SignedOrderNonces []SignedOrderNonce `protobuf:"bytes,10,rep,name=signed_order_nonces,json=signedOrderNonces,proto3" json:"signed_order_nonces" yaml:"signed_order_nonces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,15 +89,165 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
-func (m *GenesisState) GetOrders() []*Order {
+func (m *GenesisState) GetOrders() []GenesisOrder {
 	if m != nil {
 		return m.Orders
 	}
 	return nil
 }
 
+func (m *GenesisState) GetOrderCount() uint64 {
+	if m != nil {
+		return m.OrderCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBids() []Bid {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSwapLists() []ListEntry {
+	if m != nil {
+		return m.SwapLists
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimits() []RateLimitUsage {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedChannels() []string {
+	if m != nil {
+		return m.PausedChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSignedOrderNonces() []SignedOrderNonce {
+	if m != nil {
+		return m.SignedOrderNonces
+	}
+	return nil
+}
+
+// GenesisOrder is an order stored at the given position of the order book.
+type GenesisOrder struct {
+	Position uint64 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Order    Order  `protobuf:"bytes,2,opt,name=order,proto3" json:"order"`
+}
+
+func (m *GenesisOrder) Reset()         { *m = GenesisOrder{} }
+func (m *GenesisOrder) String() string { return proto.CompactTextString(m) }
+func (*GenesisOrder) ProtoMessage()    {}
+func (*GenesisOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12220f7b5b69953c, []int{1}
+}
+func (m *GenesisOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisOrder.Merge(m, src)
+}
+func (m *GenesisOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisOrder proto.InternalMessageInfo
+
+func (m *GenesisOrder) GetPosition() uint64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *GenesisOrder) GetOrder() Order {
+	if m != nil {
+		return m.Order
+	}
+	return Order{}
+}
+
+// SignedOrderNonce is the nonce of a filled signed order of a maker, the order can not be filled again.
+type SignedOrderNonce struct {
+	Maker string `protobuf:"bytes,1,opt,name=maker,proto3" json:"maker,omitempty"`
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *SignedOrderNonce) Reset()         { *m = SignedOrderNonce{} }
+func (m *SignedOrderNonce) String() string { return proto.CompactTextString(m) }
+func (*SignedOrderNonce) ProtoMessage()    {}
+func (*SignedOrderNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12220f7b5b69953c, []int{2}
+}
+func (m *SignedOrderNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedOrderNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedOrderNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignedOrderNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedOrderNonce.Merge(m, src)
+}
+func (m *SignedOrderNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedOrderNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedOrderNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedOrderNonce proto.InternalMessageInfo
+
+func (m *SignedOrderNonce) GetMaker() string {
+	if m != nil {
+		return m.Maker
+	}
+	return ""
+}
+
+func (m *SignedOrderNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.atomic_swap.v1.GenesisState")
+	proto.RegisterType((*GenesisOrder)(nil), "ibc.applications.atomic_swap.v1.GenesisOrder")
+	proto.RegisterType((*SignedOrderNonce)(nil), "ibc.applications.atomic_swap.v1.SignedOrderNonce")
 }
 
 func init() {
@@ -93,27 +255,45 @@ func init() {
 }
 
 var fileDescriptor_12220f7b5b69953c = []byte{
-	// 307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcd, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0x4f, 0x2c, 0xc9,
-	0xcf, 0xcd, 0x4c, 0x8e, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xcf, 0x4c, 0x4a, 0xd6, 0x43,
-	0x56, 0xae, 0x87, 0xa4, 0x5c, 0xaf, 0xcc, 0x50, 0x4a, 0x8b, 0x90, 0x79, 0x60, 0x85, 0x60, 0xc3,
-	0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0x74, 0x88, 0x91,
-	0x8b, 0xc7, 0x1d, 0x62, 0x69, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x36, 0x17, 0x7b, 0x41, 0x7e,
-	0x51, 0x49, 0x7c, 0x66, 0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xd0, 0xa7, 0x7b, 0xf2,
-	0x7c, 0x95, 0x89, 0xb9, 0x39, 0x56, 0x4a, 0x50, 0x09, 0xa5, 0x20, 0x36, 0x10, 0xcb, 0x33, 0x45,
-	0xc8, 0x95, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x49, 0x81, 0x51, 0x83, 0xdb,
-	0x48, 0x5d, 0x8f, 0x80, 0x8b, 0xf5, 0x02, 0xc0, 0xca, 0x9d, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08,
-	0x82, 0x6a, 0x16, 0xb2, 0xe3, 0x62, 0xcb, 0x2f, 0x4a, 0x49, 0x2d, 0x2a, 0x96, 0x60, 0x56, 0x60,
-	0xd6, 0xe0, 0x36, 0x52, 0x23, 0x68, 0x8c, 0x3f, 0x48, 0x79, 0x10, 0x54, 0x97, 0x53, 0xfc, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xb9, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0x67, 0xa6, 0xa4, 0x82, 0x3d, 0x9d, 0x9c, 0x9f, 0xa3, 0x9f,
-	0x99, 0x94, 0x0c, 0x09, 0x23, 0x33, 0xfd, 0xdc, 0xfc, 0x94, 0xd2, 0x9c, 0xd4, 0x62, 0x50, 0x38,
-	0x16, 0xeb, 0x1b, 0x1a, 0x18, 0xe8, 0x42, 0xec, 0xd2, 0x05, 0xcb, 0x97, 0x54, 0x16, 0xa4, 0x16,
-	0x27, 0xb1, 0x81, 0xf5, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x2c, 0x65, 0xbe, 0xc0,
-	0x01, 0x00, 0x00,
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4f, 0x6b, 0xd4, 0x4e,
+	0x18, 0xde, 0xfc, 0x9a, 0xdd, 0x76, 0x67, 0x7f, 0xd4, 0x76, 0x2c, 0x25, 0xee, 0x21, 0x59, 0x82,
+	0x68, 0xa8, 0x6c, 0xd2, 0xad, 0xa0, 0xe0, 0xa1, 0x87, 0x94, 0x22, 0x6a, 0x51, 0x49, 0xf1, 0xe2,
+	0x25, 0x4c, 0x92, 0x21, 0x1d, 0x4c, 0x32, 0x21, 0xef, 0x6c, 0xb5, 0x1f, 0xc0, 0xbb, 0x1f, 0xab,
+	0xc7, 0x1e, 0x3d, 0x2d, 0xd2, 0x7e, 0x00, 0x61, 0x3f, 0x81, 0xcc, 0x4c, 0x5a, 0x97, 0x7a, 0xc8,
+	0xed, 0xfd, 0xf3, 0x3c, 0xcf, 0xfb, 0xcc, 0xe4, 0x9d, 0xa0, 0x29, 0x4b, 0xd2, 0x80, 0xd4, 0x75,
+	0xc1, 0x52, 0x22, 0x18, 0xaf, 0x20, 0x20, 0x82, 0x97, 0x2c, 0x8d, 0xe1, 0x2b, 0xa9, 0x83, 0xf3,
+	0x59, 0x90, 0xd3, 0x8a, 0x02, 0x03, 0xbf, 0x6e, 0xb8, 0xe0, 0xd8, 0x61, 0x49, 0xea, 0xaf, 0xc2,
+	0xfd, 0x15, 0xb8, 0x7f, 0x3e, 0x1b, 0xef, 0x75, 0xe9, 0x29, 0xa0, 0x12, 0x1b, 0x7b, 0x5d, 0x58,
+	0xf1, 0xad, 0x45, 0xee, 0xe4, 0x3c, 0xe7, 0x2a, 0x0c, 0x64, 0xa4, 0xab, 0xee, 0xef, 0x3e, 0xfa,
+	0xff, 0xb5, 0xb6, 0x77, 0x2a, 0x88, 0xa0, 0xf8, 0x19, 0x5a, 0xaf, 0x79, 0x23, 0x62, 0x96, 0x59,
+	0xc6, 0xc4, 0xf0, 0x86, 0x21, 0x5e, 0x2e, 0x9c, 0xcd, 0x0b, 0x52, 0x16, 0xaf, 0xdc, 0xb6, 0xe1,
+	0x46, 0x03, 0x19, 0xbd, 0xc9, 0xf0, 0x31, 0x1a, 0xd4, 0xa4, 0x21, 0x25, 0x58, 0xff, 0x4d, 0x0c,
+	0x6f, 0x74, 0xf0, 0xd4, 0xef, 0x38, 0x9b, 0xff, 0x51, 0xc1, 0x43, 0xf3, 0x72, 0xe1, 0xf4, 0xa2,
+	0x96, 0x8c, 0xdf, 0xa1, 0x01, 0x6f, 0x32, 0xda, 0x80, 0x65, 0x4e, 0xd6, 0xbc, 0xd1, 0xc1, 0xb4,
+	0x53, 0xa6, 0xb5, 0xfc, 0x41, 0xb2, 0x6e, 0xc5, 0xb4, 0x04, 0x7e, 0x89, 0x46, 0x2a, 0x8a, 0x53,
+	0x3e, 0xaf, 0x84, 0xd5, 0x9f, 0x18, 0x9e, 0x19, 0xee, 0x2e, 0x17, 0x0e, 0xd6, 0x87, 0x58, 0x69,
+	0xba, 0x11, 0x52, 0xd9, 0x91, 0x4c, 0xf0, 0x21, 0x32, 0x13, 0x96, 0x81, 0x35, 0x50, 0x1e, 0x1e,
+	0x77, 0x7a, 0x08, 0x59, 0xd6, 0x8e, 0x56, 0x3c, 0x9c, 0x21, 0x24, 0x5b, 0x71, 0xc1, 0x40, 0x80,
+	0xb5, 0xae, 0x54, 0xf6, 0x3a, 0x55, 0x4e, 0x18, 0x88, 0xe3, 0x4a, 0x34, 0x17, 0xe1, 0x23, 0xa9,
+	0xb5, 0x5c, 0x38, 0xdb, 0xda, 0xe7, 0x5f, 0x2d, 0x37, 0x1a, 0xca, 0x44, 0x22, 0x01, 0x17, 0x68,
+	0xd4, 0x10, 0x41, 0xe3, 0x82, 0x95, 0x4c, 0x80, 0xb5, 0xa1, 0xc6, 0x04, 0x9d, 0x63, 0x22, 0x22,
+	0xe8, 0x89, 0xa4, 0x7c, 0x02, 0x92, 0xd3, 0x70, 0xdc, 0xce, 0x6a, 0xef, 0x64, 0x45, 0xd1, 0x8d,
+	0x50, 0x73, 0x8b, 0x05, 0x7c, 0x84, 0x1e, 0xd4, 0x64, 0x0e, 0x34, 0x8b, 0xd3, 0x33, 0x52, 0x55,
+	0xb4, 0x00, 0x6b, 0x38, 0x59, 0xf3, 0x86, 0xe1, 0x78, 0xb9, 0x70, 0x76, 0x35, 0xf9, 0x1e, 0xc0,
+	0x8d, 0x36, 0x75, 0xe5, 0xa8, 0x2d, 0xe0, 0xef, 0x06, 0x7a, 0x08, 0x2c, 0xaf, 0x68, 0x16, 0xeb,
+	0xcb, 0xaf, 0x78, 0x95, 0x52, 0xb0, 0x90, 0xf2, 0x3e, 0xeb, 0xf4, 0x7e, 0xaa, 0xb8, 0xea, 0x5b,
+	0xbf, 0x97, 0xcc, 0xd0, 0x6d, 0xdd, 0x8f, 0xdb, 0x9b, 0xfa, 0x57, 0xdb, 0x8d, 0xb6, 0xe1, 0x1e,
+	0x0b, 0xde, 0x9a, 0x1b, 0x6b, 0x5b, 0xa6, 0x5b, 0xdd, 0x2d, 0xbc, 0xea, 0xe1, 0x31, 0xda, 0xa8,
+	0x39, 0x30, 0x39, 0x59, 0x6d, 0xbc, 0x19, 0xdd, 0xe5, 0x38, 0x44, 0x7d, 0xa5, 0xda, 0xae, 0xf7,
+	0x93, 0x4e, 0xab, 0xab, 0x0b, 0xa9, 0xa9, 0xee, 0x21, 0xda, 0xba, 0x7f, 0x00, 0xbc, 0x83, 0xfa,
+	0x25, 0xf9, 0x42, 0x1b, 0xfd, 0xc4, 0x22, 0x9d, 0xc8, 0xaa, 0x72, 0xaf, 0xa6, 0x99, 0x91, 0x4e,
+	0xc2, 0xf8, 0xf2, 0xda, 0x36, 0xae, 0xae, 0x6d, 0xe3, 0xd7, 0xb5, 0x6d, 0xfc, 0xb8, 0xb1, 0x7b,
+	0x57, 0x37, 0x76, 0xef, 0xe7, 0x8d, 0xdd, 0xfb, 0x7c, 0x9c, 0x33, 0x71, 0x36, 0x4f, 0xfc, 0x94,
+	0x97, 0x01, 0xb0, 0x8c, 0xaa, 0x17, 0x9d, 0xf2, 0x22, 0x60, 0x49, 0xaa, 0x9f, 0xff, 0x8b, 0xa0,
+	0xe4, 0xd9, 0xbc, 0xa0, 0x20, 0x7f, 0x11, 0x10, 0xcc, 0xf6, 0xf7, 0xa7, 0xda, 0xf0, 0x54, 0xf5,
+	0xc5, 0x45, 0x4d, 0x21, 0x19, 0x28, 0xde, 0xf3, 0x3f, 0x03, 0x00, 0x5c, 0xe6, 0x5c, 0x06, 0xc7,
+	0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +316,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SignedOrderNonces) > 0 {
+		for iNdEx := len(m.SignedOrderNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignedOrderNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PausedChannels) > 0 {
+		for iNdEx := len(m.PausedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedChannels[iNdEx])
+			copy(dAtA[i:], m.PausedChannels[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SwapLists) > 0 {
+		for iNdEx := len(m.SwapLists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapLists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.OrderCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OrderCount))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Orders) > 0 {
 		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -147,7 +397,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x22
 		}
 	}
 	{
@@ -170,6 +420,79 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Position != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedOrderNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedOrderNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedOrderNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Maker) > 0 {
+		i -= len(m.Maker)
+		copy(dAtA[i:], m.Maker)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Maker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -199,6 +522,69 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.OrderCount != 0 {
+		n += 1 + sovGenesis(uint64(m.OrderCount))
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SwapLists) > 0 {
+		for _, e := range m.SwapLists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedChannels) > 0 {
+		for _, s := range m.PausedChannels {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SignedOrderNonces) > 0 {
+		for _, e := range m.SignedOrderNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != 0 {
+		n += 1 + sovGenesis(uint64(m.Position))
+	}
+	l = m.Order.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *SignedOrderNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Maker)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
 	return n
 }
 
@@ -302,7 +688,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
@@ -331,11 +717,401 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &Order{})
+			m.Orders = append(m.Orders, GenesisOrder{})
 			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderCount", wireType)
+			}
+			m.OrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapLists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapLists = append(m.SwapLists, ListEntry{})
+			if err := m.SwapLists[len(m.SwapLists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimitUsage{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedChannels = append(m.PausedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedOrderNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedOrderNonces = append(m.SignedOrderNonces, SignedOrderNonce{})
+			if err := m.SignedOrderNonces[len(m.SignedOrderNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignedOrderNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedOrderNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedOrderNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
@@ -26,6 +27,141 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid orders",
+			&types.GenesisState{
+				PortId: types.PortID,
+				Orders: []types.GenesisOrder{
+					{Position: 0, Order: types.Order{Id: "order0", Status: types.Status_SYNC}},
+					{Position: 2, Order: types.Order{Id: "order1", Status: types.Status_COMPLETE, CompleteTimestamp: 1}},
+				},
+				OrderCount: 3,
+				Bids:       []types.Bid{{OrderId: "order0", Bidder: "bidder"}},
+			},
+			true,
+		},
+		{
+			"duplicate order id",
+			&types.GenesisState{
+				PortId: types.PortID,
+				Orders: []types.GenesisOrder{
+					{Position: 0, Order: types.Order{Id: "order0"}},
+					{Position: 1, Order: types.Order{Id: "order0"}},
+				},
+				OrderCount: 2,
+			},
+			false,
+		},
+		{
+			"duplicate order position",
+			&types.GenesisState{
+				PortId: types.PortID,
+				Orders: []types.GenesisOrder{
+					{Position: 0, Order: types.Order{Id: "order0"}},
+					{Position: 0, Order: types.Order{Id: "order1"}},
+				},
+				OrderCount: 2,
+			},
+			false,
+		},
+		{
+			"order position beyond the order count",
+			&types.GenesisState{
+				PortId:     types.PortID,
+				Orders:     []types.GenesisOrder{{Position: 1, Order: types.Order{Id: "order0"}}},
+				OrderCount: 1,
+			},
+			false,
+		},
+		{
+			"completed order without complete timestamp",
+			&types.GenesisState{
+				PortId:     types.PortID,
+				Orders:     []types.GenesisOrder{{Position: 0, Order: types.Order{Id: "order0", Status: types.Status_COMPLETE}}},
+				OrderCount: 1,
+			},
+			false,
+		},
+		{
+			"bid on unknown order",
+			&types.GenesisState{
+				PortId: types.PortID,
+				Bids:   []types.Bid{{OrderId: "order0", Bidder: "bidder"}},
+			},
+			false,
+		},
+		{
+			"valid swap lists",
+			&types.GenesisState{
+				PortId: types.PortID,
+				SwapLists: []types.ListEntry{
+					types.NewListEntry(types.ALLOWLIST, types.DenomEntry, "stake"),
+					types.NewListEntry(types.DENYLIST, types.ChannelEntry, types.ChannelListValue(types.PortID, "channel-1")),
+				},
+			},
+			true,
+		},
+		{
+			"duplicate swap list entry",
+			&types.GenesisState{
+				PortId: types.PortID,
+				SwapLists: []types.ListEntry{
+					types.NewListEntry(types.ALLOWLIST, types.DenomEntry, "stake"),
+					types.NewListEntry(types.ALLOWLIST, types.DenomEntry, "stake"),
+				},
+			},
+			false,
+		},
+		{
+			"invalid swap list entry",
+			&types.GenesisState{
+				PortId:    types.PortID,
+				SwapLists: []types.ListEntry{types.NewListEntry(types.DENYLIST, types.ChannelEntry, "channel-1")},
+			},
+			false,
+		},
+		{
+			"valid rate limits",
+			&types.GenesisState{
+				PortId: types.PortID,
+				RateLimits: []types.RateLimitUsage{{
+					RateLimit: types.NewRateLimit(types.PortID, "channel-0", "stake", sdk.NewInt(100), 60),
+					Flow:      types.NewRateLimitFlow(0),
+				}},
+				PausedChannels: []string{types.ChannelListValue(types.PortID, "channel-0")},
+			},
+			true,
+		},
+		{
+			"rate limit without max outflow",
+			&types.GenesisState{
+				PortId: types.PortID,
+				RateLimits: []types.RateLimitUsage{{
+					RateLimit: types.NewRateLimit(types.PortID, "channel-0", "stake", sdk.ZeroInt(), 60),
+					Flow:      types.NewRateLimitFlow(0),
+				}},
+			},
+			false,
+		},
+		{
+			"invalid paused channel",
+			&types.GenesisState{
+				PortId:         types.PortID,
+				PausedChannels: []string{"channel-0"},
+			},
+			false,
+		},
+		{
+			"duplicate signed order nonce",
+			&types.GenesisState{
+				PortId: types.PortID,
+				SignedOrderNonces: []types.SignedOrderNonce{
+					{Maker: sdk.AccAddress("maker").String(), Nonce: 1},
+					{Maker: sdk.AccAddress("maker").String(), Nonce: 1},
+				},
+			},
+			false,
+		},
 		{
 			"invalid client",
 			&types.GenesisState{
@@ -2,7 +2,9 @@ package types
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -15,9 +17,21 @@ const (
 	// module supports
 	Version = "ics100-1"
 
+	// VersionBasket defines the version of the IBC swap supporting basket orders,
+	// which trade several coins on each side of the order.
+	VersionBasket = "ics100-basket-1"
+
+	// Version2 defines the version of the IBC swap encoding the packet data, the messages it carries
+	// and the acknowledgements with deterministic protobuf instead of JSON. It supports basket orders.
+	Version2 = "ics100-2"
+
 	// PortID is the default port id that swap module binds to
 	PortID = ModuleName
 
+	// HTLCChannelID is the channel placeholder of HTLC orders, which are not settled over IBC.
+	// Their tokens are locked in the escrow account of this placeholder.
+	HTLCChannelID = "htlc"
+
 	// StoreKey is the store key string for IBC swap
 	StoreKey = ModuleName
 
@@ -27,7 +41,13 @@ const (
 	// QuerierRoute is the querier route for IBC swap
 	QuerierRoute = ModuleName
 
-	MaxOrderCount = 10000000000000
+	// MaxExpiredOrdersPerBlock bounds the orders expired by the EndBlocker in a block, the
+	// other expired orders are left in the queue for the next blocks
+	MaxExpiredOrdersPerBlock = 100
+
+	// ExpiredOrderRetryDelay is the delay in seconds after which the EndBlocker retries to expire
+	// an order whose expiration failed
+	ExpiredOrderRetryDelay = 600
 )
 
 var (
@@ -40,6 +60,43 @@ var (
 	OTCOrderBookKey         = []byte{0x04}
 	OTCOrderBookKeyCountKey = []byte{0x05}
 	OTCOrderBookKeyIndexKey = []byte{0x06}
+	// OTCOrderExpirationQueueKey defines the key prefix of the time-ordered order expiration queue
+	OTCOrderExpirationQueueKey = []byte{0x07}
+	// OTCOrderMakerIndexKey defines the key prefix of the orders indexed by maker address
+	OTCOrderMakerIndexKey = []byte{0x08}
+	// OTCOrderTakerIndexKey defines the key prefix of the orders indexed by taker address
+	OTCOrderTakerIndexKey = []byte{0x09}
+	// OTCOrderDesiredTakerIndexKey defines the key prefix of the orders indexed by desired taker address
+	OTCOrderDesiredTakerIndexKey = []byte{0x0a}
+	// OTCOrderStatusIndexKey defines the key prefix of the orders indexed by status
+	OTCOrderStatusIndexKey = []byte{0x0b}
+	// OTCOrderPairIndexKey defines the key prefix of the orders indexed by (sell denom, buy denom) pair
+	OTCOrderPairIndexKey = []byte{0x0c}
+	// OTCOrderSideIndexKey defines the key prefix of the orders indexed by side
+	OTCOrderSideIndexKey = []byte{0x0d}
+	// CollectedFeesKey defines the key prefix of the protocol fees collected per denom
+	CollectedFeesKey = []byte{0x0e}
+	// BidKey defines the key prefix of the bids, stored by order id and bidder
+	BidKey = []byte{0x0f}
+	// BidBidderIndexKey defines the key prefix of the bids indexed by bidder
+	BidBidderIndexKey = []byte{0x10}
+	// SignedOrderNonceKey defines the key prefix of the nonces of the signed orders filled per maker
+	SignedOrderNonceKey = []byte{0x11}
+	// OTCOrderRetentionQueueKey defines the key prefix of the completed and cancelled orders sorted by closing time
+	OTCOrderRetentionQueueKey = []byte{0x12}
+	// TerminalOrderCountKey defines the key of the number of completed and cancelled orders in the store
+	TerminalOrderCountKey = []byte{0x13}
+	// SwapListKey defines the key prefix of the entries of the swap allowlist and denylist
+	SwapListKey = []byte{0x14}
+
+	// RateLimitKey defines the key prefix of the escrow rate limits by channel and denom
+	RateLimitKey = []byte{0x15}
+
+	// RateLimitFlowKey defines the key prefix of the escrow flows of the rate limits in the current window
+	RateLimitFlowKey = []byte{0x16}
+
+	// PausedChannelKey defines the key prefix of the channels paused by their rate limits
+	PausedChannelKey = []byte{0x17}
 )
 
 // GetEscrowAddress returns the escrow address for the specified channel.
@@ -58,6 +115,113 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	return hash[:20]
 }
 
+// OrderExpirationQueueKey returns the key of an order in the expiration queue.
+// The key is sorted by the expiration timestamp, so expired orders can be found
+// by iterating the queue up to the current block time.
+func OrderExpirationQueueKey(expiration uint64, orderId string) []byte {
+	return append(OrderExpirationQueuePrefix(expiration), []byte(orderId)...)
+}
+
+// OrderExpirationQueuePrefix returns the key prefix of all orders expiring at the given timestamp.
+func OrderExpirationQueuePrefix(expiration uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, expiration)
+	return bz
+}
+
+// OrderRetentionQueuePrefix returns the key prefix of the orders closed at the given timestamp.
+// The full key appends the position of the order in the order book, so the queue iterates
+// the closed orders from the oldest to the newest.
+func OrderRetentionQueuePrefix(closeTimestamp uint64) []byte {
+	return append(append([]byte{}, OTCOrderRetentionQueueKey...), sdk.Uint64ToBigEndian(closeTimestamp)...)
+}
+
+// OrderIndexPrefix returns the key prefix of an order index for the given attribute values.
+// Every value is length-prefixed, so the prefix of one value never matches another one.
+// Values longer than 255 bytes are replaced by their hash.
+// The full index key appends the position of the order in the order book to this prefix.
+func OrderIndexPrefix(indexKey []byte, values ...string) []byte {
+	key := append([]byte{}, indexKey...)
+	for _, value := range values {
+		bz := []byte(value)
+		if len(bz) > math.MaxUint8 {
+			hash := sha256.Sum256(bz)
+			bz = hash[:]
+		}
+		key = append(key, byte(len(bz)))
+		key = append(key, bz...)
+	}
+	return key
+}
+
+// OrderStatusIndexPrefix returns the key prefix of the orders in the given status.
+func OrderStatusIndexPrefix(status Status) []byte {
+	return append(append([]byte{}, OTCOrderStatusIndexKey...), byte(status))
+}
+
+// OrderSideIndexPrefix returns the key prefix of the orders on the given side.
+func OrderSideIndexPrefix(side Side) []byte {
+	return append(append([]byte{}, OTCOrderSideIndexKey...), byte(side))
+}
+
 func KeyPrefix(key string) []byte {
 	return []byte(key)
 }
+
+// SupportedVersions are the channel versions supported by the atomic swap module.
+var SupportedVersions = []string{Version, VersionBasket, Version2}
+
+// IsSupportedVersion returns true if the channel version is supported by the atomic swap module.
+func IsSupportedVersion(version string) bool {
+	for _, supported := range SupportedVersions {
+		if version == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBasketVersion returns true if the basket orders can be settled on a channel with the given version.
+func IsBasketVersion(version string) bool {
+	return version == VersionBasket || version == Version2
+}
+
+// IsProtoVersion returns true if the packets of a channel with the given version are protobuf encoded.
+func IsProtoVersion(version string) bool {
+	return version == Version2
+}
+
+// SignedOrderNonceStoreKey returns the key marking the nonce of a maker's signed order as used.
+func SignedOrderNonceStoreKey(maker string, nonce uint64) []byte {
+	return append(OrderIndexPrefix(SignedOrderNonceKey, maker), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// SwapListPrefix returns the key prefix of the entries of the given swap list.
+func SwapListPrefix(list SwapList) []byte {
+	return append(append([]byte{}, SwapListKey...), byte(list))
+}
+
+// SwapListKindPrefix returns the key prefix of the entries of a kind in the given swap list.
+func SwapListKindPrefix(list SwapList, kind ListEntryKind) []byte {
+	return append(SwapListPrefix(list), byte(kind))
+}
+
+// SwapListEntryKey returns the store key of a swap list entry.
+func SwapListEntryKey(entry ListEntry) []byte {
+	return append(SwapListKindPrefix(entry.List, entry.Kind), []byte(entry.Value)...)
+}
+
+// RateLimitChannelPrefix returns the key prefix of the rate limits or flows of a channel.
+func RateLimitChannelPrefix(prefix []byte, portID, channelID string) []byte {
+	return append(append([]byte{}, prefix...), []byte(ChannelListValue(portID, channelID)+"/")...)
+}
+
+// RateLimitStoreKey returns the key of the rate limit or flow of a denom on a channel.
+func RateLimitStoreKey(prefix []byte, portID, channelID, denom string) []byte {
+	return append(RateLimitChannelPrefix(prefix, portID, channelID), []byte(denom)...)
+}
+
+// PausedChannelStoreKey returns the key marking a channel as paused.
+func PausedChannelStoreKey(portID, channelID string) []byte {
+	return append(append([]byte{}, PausedChannelKey...), []byte(ChannelListValue(portID, channelID))...)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,18 @@ const (
 	TypeMsgMakeSwap   = "make_swap"
 	TypeMsgTakeSwap   = "take_swap"
 	TypeMsgCancelSwap = "cancel_swap"
+	TypeMsgMakeBid    = "make_bid"
+	TypeMsgAcceptBid  = "accept_bid"
+	TypeMsgCancelBid  = "cancel_bid"
+	TypeMsgLockHTLC   = "lock_htlc"
+	TypeMsgClaimHTLC  = "claim_htlc"
+	TypeMsgRefundHTLC = "refund_htlc"
+
+	TypeMsgCancelChannelOrders = "cancel_channel_orders"
+	TypeMsgFillSignedOrder     = "fill_signed_order"
+	TypeMsgUpdateSwapLists     = "update_swap_lists"
+	TypeMsgSetRateLimits       = "set_rate_limits"
+	TypeMsgUnpauseChannel      = "unpause_channel"
 )
 
 // NewMsgMakeSwap creates a new MsgMakeSwapRequest instance
@@ -60,6 +73,17 @@ func (msg *MakeSwapMsg) ValidateBasic() error {
 	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
 		return sdkerrors.Wrap(err, "invalid source channel ID")
 	}
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.MakerAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if strings.TrimSpace(msg.MakerReceivingAddress) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	if msg.IsBasket() {
+		return msg.validateBasket()
+	}
 	if !msg.SellToken.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.SellToken.String())
 	}
@@ -72,18 +96,40 @@ func (msg *MakeSwapMsg) ValidateBasic() error {
 	if !msg.BuyToken.IsPositive() {
 		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, msg.BuyToken.String())
 	}
-	// NOTE: sender format must be validated as it is required by the GetSigners function.
-	_, err := sdk.AccAddressFromBech32(msg.MakerAddress)
-	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	if minFill := msg.GetMinFillAmount(); minFill.IsNegative() || minFill.GT(msg.BuyToken.Amount) {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid min fill amount %s", minFill)
 	}
-	if strings.TrimSpace(msg.MakerReceivingAddress) == "" {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	if msg.PriceSchedule != nil {
+		// an auction order is sold at once to the first taker paying the current price
+		if msg.AllowPartialFill {
+			return sdkerrors.Wrap(ErrInvalidPriceSchedule, "auction orders cannot be partially filled")
+		}
+		if err := msg.PriceSchedule.Validate(msg.BuyToken.Amount); err != nil {
+			return err
+		}
 	}
 	// return ValidateIBCDenom(msg.SendingToken.Denom)
 	return nil
 }
 
+// validateBasket checks the coins of a basket order. A basket order is filled at once,
+// it can neither be partially filled nor auctioned.
+func (msg *MakeSwapMsg) validateBasket() error {
+	if msg.SellToken.Denom != "" || msg.BuyToken.Denom != "" {
+		return sdkerrors.Wrap(ErrInvalidBasket, "sell token and buy token must be empty")
+	}
+	if msg.SellTokens.Empty() || !msg.SellTokens.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidBasket, msg.SellTokens.String())
+	}
+	if msg.BuyTokens.Empty() || !msg.BuyTokens.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidBasket, msg.BuyTokens.String())
+	}
+	if msg.AllowPartialFill || msg.PriceSchedule != nil || msg.GetMinFillAmount().IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidBasket, "basket orders cannot be partially filled or auctioned")
+	}
+	return nil
+}
+
 // GetSignBytes implements sdk.Msg.
 func (msg *MakeSwapMsg) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
@@ -142,11 +188,20 @@ func (msg *TakeSwapMsg) ValidateBasic() error {
 	if len(msg.OrderId) == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, msg.OrderId)
 	}
-	if !msg.SellToken.IsValid() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.SellToken.String())
-	}
-	if !msg.SellToken.IsPositive() {
-		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, msg.SellToken.String())
+	if len(msg.SellTokens) > 0 {
+		if msg.SellToken.Denom != "" {
+			return sdkerrors.Wrap(ErrInvalidBasket, "sell token must be empty")
+		}
+		if !msg.SellTokens.IsValid() {
+			return sdkerrors.Wrap(ErrInvalidBasket, msg.SellTokens.String())
+		}
+	} else {
+		if !msg.SellToken.IsValid() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.SellToken.String())
+		}
+		if !msg.SellToken.IsPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, msg.SellToken.String())
+		}
 	}
 	// NOTE: sender format must be validated as it is required by the GetSigners function.
 	_, err := sdk.AccAddressFromBech32(msg.TakerAddress)
@@ -221,3 +276,619 @@ func (msg *CancelSwapMsg) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// NewMsgMakeBid creates a new MakeBidMsg instance
+func NewMsgMakeBid(
+	orderId string,
+	amount sdk.Coin,
+	bidder, bidderReceivingAddress string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	createdTimestamp int64,
+) *MakeBidMsg {
+	return &MakeBidMsg{
+		OrderId:                orderId,
+		Amount:                 amount,
+		Bidder:                 bidder,
+		BidderReceivingAddress: bidderReceivingAddress,
+		TimeoutHeight:          timeoutHeight,
+		TimeoutTimestamp:       timeoutTimestamp,
+		CreateTimestamp:        createdTimestamp,
+	}
+}
+
+// Route implements sdk.Msg
+func (*MakeBidMsg) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (*MakeBidMsg) Type() string {
+	return TypeMsgMakeBid
+}
+
+// ValidateBasic performs a basic check of the MakeBidMsg fields.
+// NOTE: The receiving address format is not validated as the format defined by
+// the maker chain is not known to IBC.
+func (msg *MakeBidMsg) ValidateBasic() error {
+	if strings.TrimSpace(msg.OrderId) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "OrderId is required")
+	}
+	if !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, msg.Amount.String())
+	}
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if strings.TrimSpace(msg.BidderReceivingAddress) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg *MakeBidMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MakeBidMsg) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgAcceptBid creates a new AcceptBidMsg instance
+func NewMsgAcceptBid(
+	orderId, makerAddress, bidder string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	createdTimestamp int64,
+) *AcceptBidMsg {
+	return &AcceptBidMsg{
+		OrderId:          orderId,
+		MakerAddress:     makerAddress,
+		Bidder:           bidder,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		CreateTimestamp:  createdTimestamp,
+	}
+}
+
+// Route implements sdk.Msg
+func (*AcceptBidMsg) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (*AcceptBidMsg) Type() string {
+	return TypeMsgAcceptBid
+}
+
+// ValidateBasic performs a basic check of the AcceptBidMsg fields.
+// NOTE: The bidder address format is not validated as it is an address of the taker chain.
+func (msg *AcceptBidMsg) ValidateBasic() error {
+	if strings.TrimSpace(msg.OrderId) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "OrderId is required")
+	}
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.MakerAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if strings.TrimSpace(msg.Bidder) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing bidder address")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg *AcceptBidMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *AcceptBidMsg) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.MakerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgCancelBid creates a new CancelBidMsg instance
+func NewMsgCancelBid(
+	orderId, bidder string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	createdTimestamp int64,
+) *CancelBidMsg {
+	return &CancelBidMsg{
+		OrderId:          orderId,
+		Bidder:           bidder,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		CreateTimestamp:  createdTimestamp,
+	}
+}
+
+// Route implements sdk.Msg
+func (*CancelBidMsg) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (*CancelBidMsg) Type() string {
+	return TypeMsgCancelBid
+}
+
+// ValidateBasic performs a basic check of the CancelBidMsg fields.
+func (msg *CancelBidMsg) ValidateBasic() error {
+	if strings.TrimSpace(msg.OrderId) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "OrderId is required")
+	}
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg *CancelBidMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *CancelBidMsg) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgLockHTLC creates a new LockHTLCMsg instance
+func NewMsgLockHTLC(
+	sender, receiver string,
+	sellToken, buyToken sdk.Coin,
+	senderReceivingAddress string,
+	hashLock []byte,
+	timelock uint64,
+	createdTimestamp int64,
+) *LockHTLCMsg {
+	return &LockHTLCMsg{
+		Sender:                 sender,
+		Receiver:               receiver,
+		SellToken:              sellToken,
+		BuyToken:               buyToken,
+		SenderReceivingAddress: senderReceivingAddress,
+		HashLock:               hashLock,
+		Timelock:               timelock,
+		CreateTimestamp:        createdTimestamp,
+	}
+}
+
+// Route implements sdk.Msg
+func (*LockHTLCMsg) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (*LockHTLCMsg) Type() string {
+	return TypeMsgLockHTLC
+}
+
+// ValidateBasic performs a basic check of the LockHTLCMsg fields.
+// NOTE: The sender receiving address format is not validated as the counterparty chain is not known.
+func (msg *LockHTLCMsg) ValidateBasic() error {
+	if !msg.SellToken.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.SellToken.String())
+	}
+	if !msg.SellToken.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, msg.SellToken.String())
+	}
+	if !msg.BuyToken.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.BuyToken.String())
+	}
+	if !msg.BuyToken.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, msg.BuyToken.String())
+	}
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %v", err)
+	}
+	if len(msg.HashLock) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidHashLock, "hash lock must be a %d bytes SHA-256 hash", sha256.Size)
+	}
+	if msg.Timelock == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "timelock is required")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg *LockHTLCMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *LockHTLCMsg) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgClaimHTLC creates a new ClaimHTLCMsg instance
+func NewMsgClaimHTLC(orderId, sender string, preimage []byte) *ClaimHTLCMsg {
+	return &ClaimHTLCMsg{
+		OrderId:  orderId,
+		Sender:   sender,
+		Preimage: preimage,
+	}
+}
+
+// Route implements sdk.Msg
+func (*ClaimHTLCMsg) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (*ClaimHTLCMsg) Type() string {
+	return TypeMsgClaimHTLC
+}
+
+// ValidateBasic performs a basic check of the ClaimHTLCMsg fields.
+func (msg *ClaimHTLCMsg) ValidateBasic() error {
+	if strings.TrimSpace(msg.OrderId) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "OrderId is required")
+	}
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if len(msg.Preimage) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPreimage, "preimage is required")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg *ClaimHTLCMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *ClaimHTLCMsg) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgRefundHTLC creates a new RefundHTLCMsg instance
+func NewMsgRefundHTLC(orderId, sender string) *RefundHTLCMsg {
+	return &RefundHTLCMsg{
+		OrderId: orderId,
+		Sender:  sender,
+	}
+}
+
+// Route implements sdk.Msg
+func (*RefundHTLCMsg) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (*RefundHTLCMsg) Type() string {
+	return TypeMsgRefundHTLC
+}
+
+// ValidateBasic performs a basic check of the RefundHTLCMsg fields.
+func (msg *RefundHTLCMsg) ValidateBasic() error {
+	if strings.TrimSpace(msg.OrderId) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "OrderId is required")
+	}
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg *RefundHTLCMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *RefundHTLCMsg) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgCancelChannelOrders creates a new CancelChannelOrdersMsg instance
+func NewMsgCancelChannelOrders(sender, portID, channelID string) *CancelChannelOrdersMsg {
+	return &CancelChannelOrdersMsg{
+		Sender:    sender,
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Route implements sdk.Msg
+func (*CancelChannelOrdersMsg) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (*CancelChannelOrdersMsg) Type() string {
+	return TypeMsgCancelChannelOrders
+}
+
+// ValidateBasic performs a basic check of the CancelChannelOrdersMsg fields.
+func (msg *CancelChannelOrdersMsg) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg *CancelChannelOrdersMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *CancelChannelOrdersMsg) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgFillSignedOrder creates a new FillSignedOrderMsg instance
+func NewMsgFillSignedOrder(
+	sourcePort, sourceChannel string,
+	sender, takerReceivingAddress string, order SignedOrder, signature []byte,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) *FillSignedOrderMsg {
+	return &FillSignedOrderMsg{
+		SourcePort:            sourcePort,
+		SourceChannel:         sourceChannel,
+		Sender:                sender,
+		TakerReceivingAddress: takerReceivingAddress,
+		Order:                 order,
+		Signature:             signature,
+		TimeoutHeight:         timeoutHeight,
+		TimeoutTimestamp:      timeoutTimestamp,
+	}
+}
+
+// Route implements sdk.Msg
+func (*FillSignedOrderMsg) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (*FillSignedOrderMsg) Type() string {
+	return TypeMsgFillSignedOrder
+}
+
+// ValidateBasic performs a basic check of the FillSignedOrderMsg fields.
+func (msg *FillSignedOrderMsg) ValidateBasic() error {
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if strings.TrimSpace(msg.TakerReceivingAddress) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing taker receiving address")
+	}
+	if msg.Order.DesiredTaker != "" && msg.Order.DesiredTaker != msg.Sender {
+		return sdkerrors.Wrapf(ErrInvalidTakerAddress, "the order is reserved for %s", msg.Order.DesiredTaker)
+	}
+	if len(msg.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignature, "missing signature")
+	}
+	return msg.Order.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg *FillSignedOrderMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *FillSignedOrderMsg) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgUpdateSwapLists creates a new UpdateSwapListsMsg instance
+func NewMsgUpdateSwapLists(authority string, add, remove []ListEntry) *UpdateSwapListsMsg {
+	return &UpdateSwapListsMsg{
+		Authority: authority,
+		Add:       add,
+		Remove:    remove,
+	}
+}
+
+// Route implements sdk.Msg
+func (*UpdateSwapListsMsg) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (*UpdateSwapListsMsg) Type() string {
+	return TypeMsgUpdateSwapLists
+}
+
+// ValidateBasic performs a basic check of the UpdateSwapListsMsg fields.
+func (msg *UpdateSwapListsMsg) ValidateBasic() error {
+	// NOTE: authority format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if len(msg.Add) == 0 && len(msg.Remove) == 0 {
+		return sdkerrors.Wrap(ErrInvalidListEntry, "no entry to add or remove")
+	}
+	for _, entry := range append(append([]ListEntry{}, msg.Add...), msg.Remove...) {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg *UpdateSwapListsMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *UpdateSwapListsMsg) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgSetRateLimits creates a new SetRateLimitsMsg instance
+func NewMsgSetRateLimits(authority string, rateLimits []RateLimit) *SetRateLimitsMsg {
+	return &SetRateLimitsMsg{
+		Authority:  authority,
+		RateLimits: rateLimits,
+	}
+}
+
+// Route implements sdk.Msg
+func (*SetRateLimitsMsg) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (*SetRateLimitsMsg) Type() string {
+	return TypeMsgSetRateLimits
+}
+
+// ValidateBasic performs a basic check of the SetRateLimitsMsg fields.
+func (msg *SetRateLimitsMsg) ValidateBasic() error {
+	// NOTE: authority format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if len(msg.RateLimits) == 0 {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "no rate limit to set")
+	}
+	for _, rateLimit := range msg.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg *SetRateLimitsMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *SetRateLimitsMsg) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgUnpauseChannel creates a new UnpauseChannelMsg instance
+func NewMsgUnpauseChannel(authority, portID, channelID string) *UnpauseChannelMsg {
+	return &UnpauseChannelMsg{
+		Authority: authority,
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Route implements sdk.Msg
+func (*UnpauseChannelMsg) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (*UnpauseChannelMsg) Type() string {
+	return TypeMsgUnpauseChannel
+}
+
+// ValidateBasic performs a basic check of the UnpauseChannelMsg fields.
+func (msg *UnpauseChannelMsg) ValidateBasic() error {
+	// NOTE: authority format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg *UnpauseChannelMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg *UnpauseChannelMsg) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
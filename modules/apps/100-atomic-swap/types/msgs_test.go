@@ -144,3 +144,63 @@ func TestMsgSwapGetSigners(t *testing.T) {
 		require.Equal(t, tc.expSigner, tc.signer, fmt.Sprintf("Test passed to %s", tc.name))
 	}
 }
+
+// TestMsgUpdateSwapListsValidateBasic tests ValidateBasic for UpdateSwapListsMsg
+func TestMsgUpdateSwapListsValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *UpdateSwapListsMsg
+		expPass bool
+	}{
+		{"valid denom entry", NewMsgUpdateSwapLists(addr1, []ListEntry{NewListEntry(DENYLIST, DenomEntry, coin.Denom)}, nil), true},
+		{"valid channel entry", NewMsgUpdateSwapLists(addr1, nil, []ListEntry{NewListEntry(ALLOWLIST, ChannelEntry, ChannelListValue(validPort, validChannel))}), true},
+		{"valid chain id entry", NewMsgUpdateSwapLists(addr1, []ListEntry{NewListEntry(ALLOWLIST, ChainIdEntry, "side-1")}, nil), true},
+		{"invalid authority", NewMsgUpdateSwapLists(emptyAddr, []ListEntry{NewListEntry(DENYLIST, DenomEntry, coin.Denom)}, nil), false},
+		{"no entries", NewMsgUpdateSwapLists(addr1, nil, nil), false},
+		{"invalid denom", NewMsgUpdateSwapLists(addr1, []ListEntry{NewListEntry(DENYLIST, DenomEntry, invalidDenomCoin.Denom)}, nil), false},
+		{"channel without port", NewMsgUpdateSwapLists(addr1, []ListEntry{NewListEntry(DENYLIST, ChannelEntry, validChannel)}, nil), false},
+		{"invalid channel", NewMsgUpdateSwapLists(addr1, []ListEntry{NewListEntry(DENYLIST, ChannelEntry, ChannelListValue(validPort, invalidChannel))}, nil), false},
+		{"invalid chain id", NewMsgUpdateSwapLists(addr1, []ListEntry{NewListEntry(DENYLIST, ChainIdEntry, "side 1")}, nil), false},
+		{"unknown list", NewMsgUpdateSwapLists(addr1, []ListEntry{NewListEntry(SwapList(2), DenomEntry, coin.Denom)}, nil), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+// TestMsgSetRateLimitsValidateBasic tests ValidateBasic for SetRateLimitsMsg
+func TestMsgSetRateLimitsValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *SetRateLimitsMsg
+		expPass bool
+	}{
+		{"valid rate limit", NewMsgSetRateLimits(addr1, []RateLimit{NewRateLimit(validPort, validChannel, coin.Denom, sdk.NewInt(100), 60)}), true},
+		{"removed rate limit", NewMsgSetRateLimits(addr1, []RateLimit{NewRateLimit(validPort, validChannel, coin.Denom, sdk.ZeroInt(), 0)}), true},
+		{"invalid authority", NewMsgSetRateLimits(emptyAddr, []RateLimit{NewRateLimit(validPort, validChannel, coin.Denom, sdk.NewInt(100), 60)}), false},
+		{"no rate limit", NewMsgSetRateLimits(addr1, nil), false},
+		{"invalid channel", NewMsgSetRateLimits(addr1, []RateLimit{NewRateLimit(validPort, invalidChannel, coin.Denom, sdk.NewInt(100), 60)}), false},
+		{"invalid denom", NewMsgSetRateLimits(addr1, []RateLimit{NewRateLimit(validPort, validChannel, invalidDenomCoin.Denom, sdk.NewInt(100), 60)}), false},
+		{"negative max outflow", NewMsgSetRateLimits(addr1, []RateLimit{NewRateLimit(validPort, validChannel, coin.Denom, sdk.NewInt(-1), 60)}), false},
+		{"no window", NewMsgSetRateLimits(addr1, []RateLimit{NewRateLimit(validPort, validChannel, coin.Denom, sdk.NewInt(100), 0)}), false},
+		{"nil max outflow", &SetRateLimitsMsg{Authority: addr1, RateLimits: []RateLimit{{PortId: validPort, ChannelId: validChannel, Denom: coin.Denom}}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+
+	require.NoError(t, NewMsgUnpauseChannel(addr1, validPort, validChannel).ValidateBasic())
+	require.Error(t, NewMsgUnpauseChannel(addr1, validPort, invalidChannel).ValidateBasic())
+}
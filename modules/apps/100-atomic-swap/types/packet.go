@@ -1,8 +1,14 @@
 package types
 
 import (
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"errors"
 	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
 var (
@@ -43,3 +49,122 @@ func (pd AtomicSwapPacketData) ValidateBasic() error {
 func (pd AtomicSwapPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&pd))
 }
+
+// PacketCodec encodes the packet data of a channel, the messages it carries and the acknowledgements
+// with the encoding of the negotiated channel version: JSON on the v1 channels and deterministic
+// protobuf on the v2 channels.
+type PacketCodec struct {
+	proto bool
+}
+
+// NewPacketCodec returns the packet codec of a channel with the given version.
+func NewPacketCodec(version string) PacketCodec {
+	return PacketCodec{proto: IsProtoVersion(version)}
+}
+
+// MarshalMsg encodes a message carried by the packet data or the result of an acknowledgement.
+func (c PacketCodec) MarshalMsg(msg codec.ProtoMarshaler) ([]byte, error) {
+	if c.proto {
+		return ModuleCdc.Marshal(msg)
+	}
+	return ModuleCdc.MarshalJSON(msg)
+}
+
+// UnmarshalMsg decodes a message carried by the packet data or the result of an acknowledgement.
+func (c PacketCodec) UnmarshalMsg(bz []byte, msg codec.ProtoMarshaler) error {
+	if c.proto {
+		return ModuleCdc.Unmarshal(bz, msg)
+	}
+	return ModuleCdc.UnmarshalJSON(bz, msg)
+}
+
+// MarshalPacketData encodes the packet data sent on the channel.
+func (c PacketCodec) MarshalPacketData(pd AtomicSwapPacketData) []byte {
+	if c.proto {
+		return ModuleCdc.MustMarshal(&pd)
+	}
+	return pd.GetBytes()
+}
+
+// UnmarshalPacketData decodes the packet data received on the channel.
+func (c PacketCodec) UnmarshalPacketData(bz []byte) (AtomicSwapPacketData, error) {
+	var pd AtomicSwapPacketData
+	if err := c.UnmarshalMsg(bz, &pd); err != nil {
+		return AtomicSwapPacketData{}, err
+	}
+	return pd, nil
+}
+
+// NewAcknowledgement returns the acknowledgement written for a packet received on the channel.
+func (c PacketCodec) NewAcknowledgement(ack channeltypes.Acknowledgement) ibcexported.Acknowledgement {
+	if c.proto {
+		return protoAcknowledgement{ack: ack}
+	}
+	return ack
+}
+
+// UnmarshalAcknowledgement decodes the acknowledgement of a packet sent on the channel.
+func (c PacketCodec) UnmarshalAcknowledgement(bz []byte) (channeltypes.Acknowledgement, error) {
+	var ack channeltypes.Acknowledgement
+	if err := c.UnmarshalMsg(bz, &ack); err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+	// an empty message is a valid protobuf encoding
+	if c.proto && ack.Response == nil {
+		return channeltypes.Acknowledgement{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "acknowledgement response cannot be empty")
+	}
+	return ack, nil
+}
+
+// protoAcknowledgement is an acknowledgement written with the protobuf encoding.
+type protoAcknowledgement struct {
+	ack channeltypes.Acknowledgement
+}
+
+var _ ibcexported.Acknowledgement = protoAcknowledgement{}
+
+// Success implements the Acknowledgement interface.
+func (a protoAcknowledgement) Success() bool {
+	return a.ack.Success()
+}
+
+// Acknowledgement implements the Acknowledgement interface.
+func (a protoAcknowledgement) Acknowledgement() []byte {
+	return ModuleCdc.MustMarshal(&a.ack)
+}
+
+// internalErrorReason is the reason of the errors without a registered ABCI code, their message is not
+// deterministic.
+const internalErrorReason = "internal error"
+
+// NewErrorAcknowledgement returns the error acknowledgement of a swap packet rejected by the taker or
+// maker chain. The codespace, code and message of the registered error of err are encoded as JSON, the
+// wrapping context is dropped so that every validator writes the same acknowledgement.
+func NewErrorAcknowledgement(err error) channeltypes.Acknowledgement {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+	reason := internalErrorReason
+	var registered *sdkerrors.Error
+	if errors.As(err, &registered) {
+		reason = registered.Error()
+	}
+	payload := ErrorAcknowledgement{Codespace: codespace, Code: code, Reason: reason}
+	return channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{
+			Error: string(sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&payload))),
+		},
+	}
+}
+
+// ParseErrorAcknowledgement decodes the error of an acknowledgement, it is recorded on the refunded order.
+// A counterparty running a version without the payload only returns a reason.
+func ParseErrorAcknowledgement(ack channeltypes.Acknowledgement) (*ErrorAcknowledgement, bool) {
+	resp, ok := ack.Response.(*channeltypes.Acknowledgement_Error)
+	if !ok {
+		return nil, false
+	}
+	var payload ErrorAcknowledgement
+	if err := ModuleCdc.UnmarshalJSON([]byte(resp.Error), &payload); err != nil || payload.Code == 0 {
+		return &ErrorAcknowledgement{Reason: resp.Error}, true
+	}
+	return &payload, true
+}
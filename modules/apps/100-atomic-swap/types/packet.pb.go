@@ -29,10 +29,14 @@ type SwapMessageType int32
 
 const (
 	// Default zero value enumeration
-	UNSPECIFIED SwapMessageType = 0
-	MAKE_SWAP   SwapMessageType = 1
-	TAKE_SWAP   SwapMessageType = 2
-	CANCEL_SWAP SwapMessageType = 3
+	UNSPECIFIED       SwapMessageType = 0
+	MAKE_SWAP         SwapMessageType = 1
+	TAKE_SWAP         SwapMessageType = 2
+	CANCEL_SWAP       SwapMessageType = 3
+	MAKE_BID          SwapMessageType = 4
+	ACCEPT_BID        SwapMessageType = 5
+	CANCEL_BID        SwapMessageType = 6
+	FILL_SIGNED_ORDER SwapMessageType = 7
 )
 
 var SwapMessageType_name = map[int32]string{
@@ -40,13 +44,21 @@ var SwapMessageType_name = map[int32]string{
 	1: "TYPE_MSG_MAKE_SWAP",
 	2: "TYPE_MSG_TAKE_SWAP",
 	3: "TYPE_MSG_CANCEL_SWAP",
+	4: "TYPE_MSG_MAKE_BID",
+	5: "TYPE_MSG_ACCEPT_BID",
+	6: "TYPE_MSG_CANCEL_BID",
+	7: "TYPE_MSG_FILL_SIGNED_ORDER",
 }
 
 var SwapMessageType_value = map[string]int32{
-	"TYPE_UNSPECIFIED":     0,
-	"TYPE_MSG_MAKE_SWAP":   1,
-	"TYPE_MSG_TAKE_SWAP":   2,
-	"TYPE_MSG_CANCEL_SWAP": 3,
+	"TYPE_UNSPECIFIED":           0,
+	"TYPE_MSG_MAKE_SWAP":         1,
+	"TYPE_MSG_TAKE_SWAP":         2,
+	"TYPE_MSG_CANCEL_SWAP":       3,
+	"TYPE_MSG_MAKE_BID":          4,
+	"TYPE_MSG_ACCEPT_BID":        5,
+	"TYPE_MSG_CANCEL_BID":        6,
+	"TYPE_MSG_FILL_SIGNED_ORDER": 7,
 }
 
 func (x SwapMessageType) String() string {
@@ -134,9 +146,73 @@ func (m *AtomicSwapPacketData) GetMemo() string {
 	return ""
 }
 
+// ErrorAcknowledgement is the payload of the error acknowledgement written when a swap packet
+// fails on the receiving chain. The reason is the description of the registered error, it does
+// not depend on the error context so the acknowledgement is deterministic.
+type ErrorAcknowledgement struct {
+	Codespace string `protobuf:"bytes,1,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code      uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ErrorAcknowledgement) Reset()         { *m = ErrorAcknowledgement{} }
+func (m *ErrorAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*ErrorAcknowledgement) ProtoMessage()    {}
+func (*ErrorAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e225b3c72fc646b1, []int{1}
+}
+func (m *ErrorAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ErrorAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorAcknowledgement.Merge(m, src)
+}
+func (m *ErrorAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorAcknowledgement proto.InternalMessageInfo
+
+func (m *ErrorAcknowledgement) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *ErrorAcknowledgement) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ErrorAcknowledgement) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("ibc.applications.atomic_swap.v1.SwapMessageType", SwapMessageType_name, SwapMessageType_value)
 	proto.RegisterType((*AtomicSwapPacketData)(nil), "ibc.applications.atomic_swap.v1.AtomicSwapPacketData")
+	proto.RegisterType((*ErrorAcknowledgement)(nil), "ibc.applications.atomic_swap.v1.ErrorAcknowledgement")
 }
 
 func init() {
@@ -144,33 +220,42 @@ func init() {
 }
 
 var fileDescriptor_e225b3c72fc646b1 = []byte{
-	// 414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x6e, 0xd3, 0x30,
-	0x00, 0xc6, 0xe3, 0xad, 0x80, 0x66, 0xfe, 0xac, 0xb2, 0x7a, 0x08, 0x39, 0x84, 0x08, 0x69, 0x52,
-	0x41, 0x2c, 0x6e, 0x41, 0xe2, 0x1e, 0xda, 0x80, 0x2a, 0xe8, 0x14, 0x35, 0x45, 0x08, 0x2e, 0x91,
-	0xe3, 0x98, 0xcc, 0x22, 0x99, 0xad, 0xda, 0xdd, 0xd4, 0x37, 0x40, 0x3b, 0xf1, 0x02, 0x3b, 0xf1,
-	0x06, 0x5c, 0x78, 0x05, 0x8e, 0x3b, 0x72, 0x44, 0xed, 0x8b, 0xa0, 0xd8, 0x22, 0x0a, 0x5c, 0xb8,
-	0x7d, 0xf9, 0xe5, 0xf7, 0xe5, 0x93, 0x62, 0xc3, 0x27, 0x3c, 0xa7, 0x98, 0x48, 0x59, 0x71, 0x4a,
-	0x34, 0x17, 0x67, 0x0a, 0x13, 0x2d, 0x6a, 0x4e, 0x33, 0x75, 0x41, 0x24, 0x3e, 0x1f, 0x63, 0x49,
-	0xe8, 0x27, 0xa6, 0x43, 0xb9, 0x12, 0x5a, 0xa0, 0x07, 0x3c, 0xa7, 0x61, 0xd7, 0x0e, 0x3b, 0x76,
-	0x78, 0x3e, 0xf6, 0xee, 0x97, 0x42, 0x94, 0x15, 0xc3, 0x46, 0xcf, 0xd7, 0x1f, 0x31, 0x39, 0xdb,
-	0xd8, 0xae, 0x37, 0x28, 0x45, 0x29, 0x4c, 0xc4, 0x4d, 0xb2, 0xf4, 0xe1, 0x37, 0x00, 0x07, 0x91,
-	0xf9, 0x46, 0x7a, 0x41, 0x64, 0x62, 0xc6, 0xa6, 0x44, 0x13, 0x34, 0x85, 0x3d, 0xbd, 0x91, 0xcc,
-	0x05, 0x01, 0x18, 0xde, 0x7b, 0x3a, 0x0a, 0xff, 0xb3, 0x1c, 0x36, 0xf5, 0x39, 0x53, 0x8a, 0x94,
-	0x6c, 0xb9, 0x91, 0x6c, 0x61, 0xda, 0x08, 0xc1, 0x5e, 0x41, 0x34, 0x71, 0xf7, 0x02, 0x30, 0xbc,
-	0xb3, 0x30, 0x19, 0xb9, 0xf0, 0x96, 0x58, 0x15, 0x6c, 0x35, 0x2b, 0xdc, 0xfd, 0x00, 0x0c, 0x0f,
-	0x16, 0x7f, 0x1e, 0x1b, 0x5b, 0x12, 0x7d, 0xea, 0xf6, 0x0c, 0x36, 0xb9, 0x61, 0x35, 0xab, 0x85,
-	0x7b, 0xc3, 0xb2, 0x26, 0x3f, 0xfe, 0x0e, 0xe0, 0xe1, 0x3f, 0x7b, 0xe8, 0x08, 0xf6, 0x97, 0xef,
-	0x93, 0x38, 0x7b, 0x7b, 0x92, 0x26, 0xf1, 0x64, 0xf6, 0x72, 0x16, 0x4f, 0xfb, 0x8e, 0x77, 0x78,
-	0x79, 0x15, 0xdc, 0xee, 0x20, 0x74, 0x04, 0x91, 0xd1, 0xe6, 0xe9, 0xab, 0x6c, 0x1e, 0xbd, 0x8e,
-	0xb3, 0xf4, 0x5d, 0x94, 0xf4, 0x81, 0x77, 0xf7, 0xf2, 0x2a, 0x38, 0x68, 0xc1, 0x5f, 0xda, 0xb2,
-	0xd5, 0xf6, 0xac, 0xd6, 0x02, 0xf4, 0x08, 0x0e, 0x5a, 0x6d, 0x12, 0x9d, 0x4c, 0xe2, 0x37, 0x56,
-	0xdc, 0xb7, 0xc3, 0x1d, 0xe4, 0xf5, 0x3e, 0x7f, 0xf5, 0x9d, 0x17, 0xd9, 0x8f, 0xad, 0x0f, 0xae,
-	0xb7, 0x3e, 0xf8, 0xb5, 0xf5, 0xc1, 0x97, 0x9d, 0xef, 0x5c, 0xef, 0x7c, 0xe7, 0xe7, 0xce, 0x77,
-	0x3e, 0xc4, 0x25, 0xd7, 0xa7, 0xeb, 0x3c, 0xa4, 0xa2, 0xc6, 0x8a, 0x17, 0xcc, 0x1c, 0x0f, 0x15,
-	0x15, 0xe6, 0x39, 0xb5, 0x77, 0xe1, 0x39, 0xae, 0x45, 0xb1, 0xae, 0x98, 0x6a, 0xee, 0x8b, 0xc2,
-	0xe3, 0xd1, 0xe8, 0xd8, 0x9e, 0xc1, 0xb1, 0x79, 0xdf, 0xfc, 0x6f, 0x95, 0xdf, 0x34, 0xbd, 0x67,
-	0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xde, 0x94, 0x23, 0x42, 0x58, 0x02, 0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcf, 0x6e, 0xd3, 0x4a,
+	0x14, 0x87, 0xe3, 0x36, 0x4d, 0x6f, 0xe6, 0xf6, 0x4f, 0x3a, 0x04, 0x64, 0x2c, 0x64, 0xac, 0xa2,
+	0x8a, 0x80, 0xa8, 0x27, 0x01, 0xc1, 0xde, 0x8d, 0xdd, 0xca, 0x22, 0x09, 0x91, 0x13, 0x84, 0x60,
+	0x63, 0xc6, 0xe3, 0xc1, 0xb5, 0x1a, 0x67, 0x2c, 0xdb, 0x69, 0x94, 0x37, 0x40, 0x59, 0xf1, 0x02,
+	0x59, 0xf1, 0x06, 0x3c, 0x05, 0xcb, 0x2e, 0x59, 0xa2, 0x44, 0xbc, 0x07, 0xf2, 0x38, 0x75, 0x12,
+	0x58, 0xb0, 0xfb, 0x9d, 0xcf, 0xdf, 0x99, 0x33, 0x96, 0xce, 0x80, 0x67, 0xbe, 0x43, 0x10, 0x0e,
+	0xc3, 0x81, 0x4f, 0x70, 0xe2, 0xb3, 0x61, 0x8c, 0x70, 0xc2, 0x02, 0x9f, 0xd8, 0xf1, 0x18, 0x87,
+	0xe8, 0xba, 0x81, 0x42, 0x4c, 0xae, 0x68, 0xa2, 0x86, 0x11, 0x4b, 0x18, 0x7c, 0xe8, 0x3b, 0x44,
+	0x5d, 0xb7, 0xd5, 0x35, 0x5b, 0xbd, 0x6e, 0x48, 0xf7, 0x3d, 0xc6, 0xbc, 0x01, 0x45, 0x5c, 0x77,
+	0x46, 0x9f, 0x10, 0x1e, 0x4e, 0xb2, 0x5e, 0xa9, 0xea, 0x31, 0x8f, 0xf1, 0x88, 0xd2, 0x94, 0xd1,
+	0xe3, 0x6f, 0x02, 0xa8, 0x6a, 0xfc, 0x8c, 0xde, 0x18, 0x87, 0x5d, 0x3e, 0x4c, 0xc7, 0x09, 0x86,
+	0x3a, 0x28, 0x26, 0x93, 0x90, 0x8a, 0x82, 0x22, 0xd4, 0x0e, 0x9e, 0xd7, 0xd5, 0x7f, 0x4c, 0x56,
+	0xd3, 0xf6, 0x36, 0x8d, 0x63, 0xec, 0xd1, 0xfe, 0x24, 0xa4, 0x16, 0xef, 0x86, 0x10, 0x14, 0x5d,
+	0x9c, 0x60, 0x71, 0x4b, 0x11, 0x6a, 0x7b, 0x16, 0xcf, 0x50, 0x04, 0xbb, 0x2c, 0x72, 0x69, 0x64,
+	0xba, 0xe2, 0xb6, 0x22, 0xd4, 0xca, 0xd6, 0x6d, 0x99, 0xda, 0x21, 0x4e, 0x2e, 0xc5, 0x22, 0xc7,
+	0x3c, 0xa7, 0x2c, 0xa0, 0x01, 0x13, 0x77, 0x32, 0x96, 0xe6, 0xe3, 0x8f, 0xa0, 0x6a, 0x44, 0x11,
+	0x8b, 0x34, 0x72, 0x35, 0x64, 0xe3, 0x01, 0x75, 0x3d, 0x1a, 0xd0, 0x61, 0x02, 0x1f, 0x80, 0x32,
+	0x61, 0x2e, 0x8d, 0x43, 0x4c, 0xb2, 0x8b, 0x97, 0xad, 0x15, 0x48, 0x4f, 0x4a, 0x0b, 0x7e, 0x97,
+	0x7d, 0x8b, 0x67, 0x78, 0x0f, 0x94, 0x22, 0x8a, 0x63, 0x36, 0x5c, 0x5e, 0x65, 0x59, 0x3d, 0xfd,
+	0xb5, 0x05, 0x0e, 0xff, 0xf8, 0x23, 0x78, 0x02, 0x2a, 0xfd, 0xf7, 0x5d, 0xc3, 0x7e, 0xdb, 0xe9,
+	0x75, 0x8d, 0xa6, 0x79, 0x6e, 0x1a, 0x7a, 0xa5, 0x20, 0x1d, 0x4e, 0x67, 0xca, 0xff, 0x6b, 0x08,
+	0x9e, 0x00, 0xc8, 0xb5, 0x76, 0xef, 0xc2, 0x6e, 0x6b, 0xaf, 0x0d, 0xbb, 0xf7, 0x4e, 0xeb, 0x56,
+	0x04, 0x69, 0x7f, 0x3a, 0x53, 0xca, 0x39, 0xd8, 0xd0, 0xfa, 0xb9, 0xb6, 0x95, 0x69, 0x39, 0x80,
+	0x4f, 0x40, 0x35, 0xd7, 0x9a, 0x5a, 0xa7, 0x69, 0xb4, 0x32, 0x71, 0x3b, 0x1b, 0xbc, 0x86, 0xe0,
+	0x23, 0x70, 0xb4, 0x39, 0xf8, 0xcc, 0xd4, 0x2b, 0x45, 0x69, 0x6f, 0x3a, 0x53, 0xfe, 0xbb, 0xad,
+	0xe1, 0x63, 0x70, 0x27, 0x97, 0xb4, 0x66, 0xd3, 0xe8, 0xf6, 0xb9, 0xb6, 0x23, 0x1d, 0x4c, 0x67,
+	0x0a, 0x58, 0x91, 0x0d, 0x71, 0x39, 0x25, 0x15, 0x4b, 0x99, 0xb8, 0x22, 0xf0, 0x25, 0x90, 0x72,
+	0xf1, 0xdc, 0x6c, 0xb5, 0xec, 0x9e, 0x79, 0xd1, 0x31, 0x74, 0xfb, 0x8d, 0xa5, 0x1b, 0x56, 0x65,
+	0x57, 0xba, 0x3b, 0x9d, 0x29, 0x47, 0x7f, 0x7d, 0x90, 0x8a, 0x9f, 0xbf, 0xca, 0x85, 0x33, 0xfb,
+	0xfb, 0x5c, 0x16, 0x6e, 0xe6, 0xb2, 0xf0, 0x73, 0x2e, 0x0b, 0x5f, 0x16, 0x72, 0xe1, 0x66, 0x21,
+	0x17, 0x7e, 0x2c, 0xe4, 0xc2, 0x07, 0xc3, 0xf3, 0x93, 0xcb, 0x91, 0xa3, 0x12, 0x16, 0xa0, 0xd8,
+	0x77, 0x29, 0x5f, 0x57, 0xc2, 0x06, 0xc8, 0x77, 0x48, 0xf6, 0x36, 0x5e, 0xa1, 0x80, 0xb9, 0xa3,
+	0x01, 0x8d, 0xd3, 0xf7, 0x13, 0xa3, 0x46, 0xbd, 0x7e, 0x9a, 0xed, 0xe4, 0x29, 0xff, 0x9e, 0xee,
+	0x5f, 0xec, 0x94, 0x78, 0xdf, 0x8b, 0xdf, 0x03, 0x00, 0x51, 0x14, 0xb9, 0xd3, 0x68, 0x03, 0x00,
+	0x00,
 }
 
 func (m *AtomicSwapPacketData) Marshal() (dAtA []byte, err error) {
@@ -229,6 +314,48 @@ func (m *AtomicSwapPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ErrorAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Code != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -268,6 +395,26 @@ func (m *AtomicSwapPacketData) Size() (n int) {
 	return n
 }
 
+func (m *ErrorAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovPacket(uint64(m.Code))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
 func sovPacket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -473,6 +620,139 @@ func (m *AtomicSwapPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ErrorAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPacket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"errors"
 	"testing"
 
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,3 +46,37 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestErrorAcknowledgement(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ack      channeltypes.Acknowledgement
+		expected *ErrorAcknowledgement
+	}{
+		{
+			"registered error, the wrapping context is dropped",
+			NewErrorAcknowledgement(sdkerrors.Wrapf(ErrOrderDoesNotExists, "order %s", "id")),
+			&ErrorAcknowledgement{Codespace: ModuleName, Code: 12, Reason: "Make Order does not exist"},
+		},
+		{
+			"error without ABCI code",
+			NewErrorAcknowledgement(errors.New("non deterministic")),
+			&ErrorAcknowledgement{Codespace: sdkerrors.UndefinedCodespace, Code: 1, Reason: "internal error"},
+		},
+		{
+			"error acknowledgement of a counterparty without the payload",
+			channeltypes.NewErrorAcknowledgement(ErrOrderDoesNotExists),
+			&ErrorAcknowledgement{Reason: "ABCI code: 12: error handling packet: see events for details"},
+		},
+	}
+
+	for _, tc := range testCases {
+		ackError, ok := ParseErrorAcknowledgement(tc.ack)
+		require.True(t, ok, tc.name)
+		require.Equal(t, tc.expected, ackError, tc.name)
+		require.NoError(t, tc.ack.ValidateBasic(), tc.name)
+	}
+
+	_, ok := ParseErrorAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{1}))
+	require.False(t, ok)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -10,11 +11,29 @@ const (
 	DefaultSwapEnabled = true
 	// DefaultMaxFeeRate is 0.0010
 	DefaultMaxFeeRate = 10
+	// DefaultFeeRate is 0, no fee is charged by default
+	DefaultFeeRate = 0
+	// FeeRateDenominator is the denominator of the fee rates, they are base points
+	FeeRateDenominator = 10000
+	// DefaultOrderRetentionPeriod keeps completed and cancelled orders for 30 days
+	DefaultOrderRetentionPeriod = 30 * 24 * 60 * 60
+	// DefaultMaxTerminalOrders is the default max number of completed and cancelled orders kept in the store
+	DefaultMaxTerminalOrders = 100000
+	// DefaultPruneBatchSize is the default max number of orders pruned per block
+	DefaultPruneBatchSize = 100
+	// MaxPruneBatchSize bounds the work of the EndBlocker pruning the orders
+	MaxPruneBatchSize = 10000
 )
 
 var (
 	KeySwapEnabled    = []byte("SwapEnabled")
 	KeySwapMaxFeeRate = []byte("MaxFeeRate")
+	KeySwapFeeRate    = []byte("FeeRate")
+	KeyFeeCollector   = []byte("FeeCollector")
+
+	KeyOrderRetentionPeriod = []byte("OrderRetentionPeriod")
+	KeyMaxTerminalOrders    = []byte("MaxTerminalOrders")
+	KeyPruneBatchSize       = []byte("PruneBatchSize")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -23,16 +42,27 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the ibc transfer module
-func NewParams(enable bool, feeRate uint32) Params {
+func NewParams(
+	enable bool, maxFeeRate, feeRate uint32, feeCollector string,
+	orderRetentionPeriod, maxTerminalOrders uint64, pruneBatchSize uint32,
+) Params {
 	return Params{
-		SwapEnabled: enable,
-		MaxFeeRate:  feeRate,
+		SwapEnabled:          enable,
+		MaxFeeRate:           maxFeeRate,
+		FeeRate:              feeRate,
+		FeeCollector:         feeCollector,
+		OrderRetentionPeriod: orderRetentionPeriod,
+		MaxTerminalOrders:    maxTerminalOrders,
+		PruneBatchSize:       pruneBatchSize,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the ibc-transfer module
 func DefaultParams() Params {
-	return NewParams(DefaultSwapEnabled, DefaultMaxFeeRate)
+	return NewParams(
+		DefaultSwapEnabled, DefaultMaxFeeRate, DefaultFeeRate, "",
+		DefaultOrderRetentionPeriod, DefaultMaxTerminalOrders, DefaultPruneBatchSize,
+	)
 }
 
 // Validate all ibc-swap module parameters
@@ -41,6 +71,24 @@ func (p Params) Validate() error {
 	if err := validateMaxFeeRate(p.MaxFeeRate); err != nil {
 		return err
 	}
+	if err := validateFeeRate(p.FeeRate); err != nil {
+		return err
+	}
+	if p.FeeRate > p.MaxFeeRate {
+		return fmt.Errorf("fee rate %d exceeds max fee rate %d", p.FeeRate, p.MaxFeeRate)
+	}
+	if err := validateFeeCollector(p.FeeCollector); err != nil {
+		return err
+	}
+	if err := validateUint64(p.OrderRetentionPeriod); err != nil {
+		return err
+	}
+	if err := validateUint64(p.MaxTerminalOrders); err != nil {
+		return err
+	}
+	if err := validatePruneBatchSize(p.PruneBatchSize); err != nil {
+		return err
+	}
 	return validateEnabled(p.SwapEnabled)
 }
 
@@ -49,6 +97,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySwapEnabled, p.SwapEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeySwapMaxFeeRate, p.MaxFeeRate, validateMaxFeeRate),
+		paramtypes.NewParamSetPair(KeySwapFeeRate, p.FeeRate, validateFeeRate),
+		paramtypes.NewParamSetPair(KeyFeeCollector, p.FeeCollector, validateFeeCollector),
+		paramtypes.NewParamSetPair(KeyOrderRetentionPeriod, p.OrderRetentionPeriod, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxTerminalOrders, p.MaxTerminalOrders, validateUint64),
+		paramtypes.NewParamSetPair(KeyPruneBatchSize, p.PruneBatchSize, validatePruneBatchSize),
 	}
 }
 
@@ -61,9 +114,55 @@ func validateEnabled(i interface{}) error {
 }
 
 func validateMaxFeeRate(i interface{}) error {
-	_, ok := i.(uint32)
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > FeeRateDenominator {
+		return fmt.Errorf("max fee rate must not exceed %d: %d", FeeRateDenominator, v)
+	}
+	return nil
+}
+
+func validateFeeRate(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > FeeRateDenominator {
+		return fmt.Errorf("fee rate must not exceed %d: %d", FeeRateDenominator, v)
+	}
+	return nil
+}
+
+func validateFeeCollector(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid fee collector address: %w", err)
+	}
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePruneBatchSize(i interface{}) error {
+	v, ok := i.(uint32)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if v > MaxPruneBatchSize {
+		return fmt.Errorf("prune batch size must not exceed %d: %d", MaxPruneBatchSize, v)
+	}
 	return nil
 }
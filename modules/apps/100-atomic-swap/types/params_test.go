@@ -8,5 +8,10 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, DefaultParams().Validate())
-	require.NoError(t, NewParams(true, 100).Validate())
+	require.NoError(t, NewParams(true, 100, 30, "", 0, 0, 0).Validate())
+	require.Error(t, NewParams(true, 100, 101, "", 0, 0, 0).Validate())
+	require.Error(t, NewParams(true, 20000, 0, "", 0, 0, 0).Validate())
+	require.Error(t, NewParams(true, 100, 30, "invalid", 0, 0, 0).Validate())
+	require.NoError(t, NewParams(true, 100, 30, "", 3600, 1000, MaxPruneBatchSize).Validate())
+	require.Error(t, NewParams(true, 100, 30, "", 3600, 1000, MaxPruneBatchSize+1).Validate())
 }
@@ -22,11 +22,32 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EscrowAddress returns the escrow address for a particular port and channel id.
 	EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error)
+	// CollectedFees returns the protocol fees collected by the module, per denom.
+	CollectedFees(ctx context.Context, in *QueryCollectedFeesRequest, opts ...grpc.CallOption) (*QueryCollectedFeesResponse, error)
 	GetAllOrders(ctx context.Context, in *QueryOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error)
 	GetAllOrdersByType(ctx context.Context, in *QueryOrdersByRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error)
 	GetSubmittedOrders(ctx context.Context, in *QuerySubmittedOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error)
 	GetTookOrders(ctx context.Context, in *QueryTookOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error)
 	GetPrivateOrders(ctx context.Context, in *QueryPrivateOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error)
+	// GetOrdersByStatus returns the orders in the given status.
+	GetOrdersByStatus(ctx context.Context, in *QueryOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error)
+	// GetOrdersByPair returns the orders selling sell_denom for buy_denom.
+	GetOrdersByPair(ctx context.Context, in *QueryOrdersByPairRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error)
+	// GetBidsByOrder returns the bids made on an order.
+	GetBidsByOrder(ctx context.Context, in *QueryBidsByOrderRequest, opts ...grpc.CallOption) (*QueryBidsResponse, error)
+	// GetBidsByBidder returns the bids made by a bidder.
+	GetBidsByBidder(ctx context.Context, in *QueryBidsByBidderRequest, opts ...grpc.CallOption) (*QueryBidsResponse, error)
+	// GetOrderPrice returns the buy token currently asked by an order.
+	GetOrderPrice(ctx context.Context, in *QueryOrderPriceRequest, opts ...grpc.CallOption) (*QueryOrderPriceResponse, error)
+	// SwapLists returns the entries of the swap allowlist or denylist.
+	SwapLists(ctx context.Context, in *QuerySwapListsRequest, opts ...grpc.CallOption) (*QuerySwapListsResponse, error)
+	// RateLimits returns the escrow rate limits with their usage in the current window.
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	// ChannelRateLimits returns the escrow rate limits of a channel with their usage and whether the channel is paused.
+	ChannelRateLimits(ctx context.Context, in *QueryChannelRateLimitsRequest, opts ...grpc.CallOption) (*QueryChannelRateLimitsResponse, error)
+	// GetOrder returns an order by its id. It has to be declared after the other
+	// order routes, so their static paths are matched before the order id.
+	GetOrder(ctx context.Context, in *QueryOrderRequest, opts ...grpc.CallOption) (*QueryOrderResponse, error)
 }
 
 type queryClient struct {
@@ -55,6 +76,15 @@ func (c *queryClient) EscrowAddress(ctx context.Context, in *QueryEscrowAddressR
 	return out, nil
 }
 
+func (c *queryClient) CollectedFees(ctx context.Context, in *QueryCollectedFeesRequest, opts ...grpc.CallOption) (*QueryCollectedFeesResponse, error) {
+	out := new(QueryCollectedFeesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Query/CollectedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetAllOrders(ctx context.Context, in *QueryOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error) {
 	out := new(QueryOrdersResponse)
 	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Query/GetAllOrders", in, out, opts...)
@@ -100,6 +130,87 @@ func (c *queryClient) GetPrivateOrders(ctx context.Context, in *QueryPrivateOrde
 	return out, nil
 }
 
+func (c *queryClient) GetOrdersByStatus(ctx context.Context, in *QueryOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error) {
+	out := new(QueryOrdersResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Query/GetOrdersByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetOrdersByPair(ctx context.Context, in *QueryOrdersByPairRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error) {
+	out := new(QueryOrdersResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Query/GetOrdersByPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetBidsByOrder(ctx context.Context, in *QueryBidsByOrderRequest, opts ...grpc.CallOption) (*QueryBidsResponse, error) {
+	out := new(QueryBidsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Query/GetBidsByOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetBidsByBidder(ctx context.Context, in *QueryBidsByBidderRequest, opts ...grpc.CallOption) (*QueryBidsResponse, error) {
+	out := new(QueryBidsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Query/GetBidsByBidder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetOrderPrice(ctx context.Context, in *QueryOrderPriceRequest, opts ...grpc.CallOption) (*QueryOrderPriceResponse, error) {
+	out := new(QueryOrderPriceResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Query/GetOrderPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SwapLists(ctx context.Context, in *QuerySwapListsRequest, opts ...grpc.CallOption) (*QuerySwapListsResponse, error) {
+	out := new(QuerySwapListsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Query/SwapLists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelRateLimits(ctx context.Context, in *QueryChannelRateLimitsRequest, opts ...grpc.CallOption) (*QueryChannelRateLimitsResponse, error) {
+	out := new(QueryChannelRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Query/ChannelRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetOrder(ctx context.Context, in *QueryOrderRequest, opts ...grpc.CallOption) (*QueryOrderResponse, error) {
+	out := new(QueryOrderResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Query/GetOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations should embed UnimplementedQueryServer
 // for forward compatibility
@@ -108,11 +219,32 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EscrowAddress returns the escrow address for a particular port and channel id.
 	EscrowAddress(context.Context, *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error)
+	// CollectedFees returns the protocol fees collected by the module, per denom.
+	CollectedFees(context.Context, *QueryCollectedFeesRequest) (*QueryCollectedFeesResponse, error)
 	GetAllOrders(context.Context, *QueryOrdersRequest) (*QueryOrdersResponse, error)
 	GetAllOrdersByType(context.Context, *QueryOrdersByRequest) (*QueryOrdersResponse, error)
 	GetSubmittedOrders(context.Context, *QuerySubmittedOrdersRequest) (*QueryOrdersResponse, error)
 	GetTookOrders(context.Context, *QueryTookOrdersRequest) (*QueryOrdersResponse, error)
 	GetPrivateOrders(context.Context, *QueryPrivateOrdersRequest) (*QueryOrdersResponse, error)
+	// GetOrdersByStatus returns the orders in the given status.
+	GetOrdersByStatus(context.Context, *QueryOrdersByStatusRequest) (*QueryOrdersResponse, error)
+	// GetOrdersByPair returns the orders selling sell_denom for buy_denom.
+	GetOrdersByPair(context.Context, *QueryOrdersByPairRequest) (*QueryOrdersResponse, error)
+	// GetBidsByOrder returns the bids made on an order.
+	GetBidsByOrder(context.Context, *QueryBidsByOrderRequest) (*QueryBidsResponse, error)
+	// GetBidsByBidder returns the bids made by a bidder.
+	GetBidsByBidder(context.Context, *QueryBidsByBidderRequest) (*QueryBidsResponse, error)
+	// GetOrderPrice returns the buy token currently asked by an order.
+	GetOrderPrice(context.Context, *QueryOrderPriceRequest) (*QueryOrderPriceResponse, error)
+	// SwapLists returns the entries of the swap allowlist or denylist.
+	SwapLists(context.Context, *QuerySwapListsRequest) (*QuerySwapListsResponse, error)
+	// RateLimits returns the escrow rate limits with their usage in the current window.
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// ChannelRateLimits returns the escrow rate limits of a channel with their usage and whether the channel is paused.
+	ChannelRateLimits(context.Context, *QueryChannelRateLimitsRequest) (*QueryChannelRateLimitsResponse, error)
+	// GetOrder returns an order by its id. It has to be declared after the other
+	// order routes, so their static paths are matched before the order id.
+	GetOrder(context.Context, *QueryOrderRequest) (*QueryOrderResponse, error)
 }
 
 // UnimplementedQueryServer should be embedded to have forward compatible implementations.
@@ -125,6 +257,9 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) EscrowAddress(context.Context, *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EscrowAddress not implemented")
 }
+func (UnimplementedQueryServer) CollectedFees(context.Context, *QueryCollectedFeesRequest) (*QueryCollectedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectedFees not implemented")
+}
 func (UnimplementedQueryServer) GetAllOrders(context.Context, *QueryOrdersRequest) (*QueryOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrders not implemented")
 }
@@ -140,6 +275,33 @@ func (UnimplementedQueryServer) GetTookOrders(context.Context, *QueryTookOrdersR
 func (UnimplementedQueryServer) GetPrivateOrders(context.Context, *QueryPrivateOrdersRequest) (*QueryOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPrivateOrders not implemented")
 }
+func (UnimplementedQueryServer) GetOrdersByStatus(context.Context, *QueryOrdersByStatusRequest) (*QueryOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrdersByStatus not implemented")
+}
+func (UnimplementedQueryServer) GetOrdersByPair(context.Context, *QueryOrdersByPairRequest) (*QueryOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrdersByPair not implemented")
+}
+func (UnimplementedQueryServer) GetBidsByOrder(context.Context, *QueryBidsByOrderRequest) (*QueryBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBidsByOrder not implemented")
+}
+func (UnimplementedQueryServer) GetBidsByBidder(context.Context, *QueryBidsByBidderRequest) (*QueryBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBidsByBidder not implemented")
+}
+func (UnimplementedQueryServer) GetOrderPrice(context.Context, *QueryOrderPriceRequest) (*QueryOrderPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderPrice not implemented")
+}
+func (UnimplementedQueryServer) SwapLists(context.Context, *QuerySwapListsRequest) (*QuerySwapListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapLists not implemented")
+}
+func (UnimplementedQueryServer) RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (UnimplementedQueryServer) ChannelRateLimits(context.Context, *QueryChannelRateLimitsRequest) (*QueryChannelRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelRateLimits not implemented")
+}
+func (UnimplementedQueryServer) GetOrder(context.Context, *QueryOrderRequest) (*QueryOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to QueryServer will
@@ -188,6 +350,24 @@ func _Query_EscrowAddress_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CollectedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollectedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollectedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Query/CollectedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollectedFees(ctx, req.(*QueryCollectedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryOrdersRequest)
 	if err := dec(in); err != nil {
@@ -278,6 +458,168 @@ func _Query_GetPrivateOrders_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetOrdersByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrdersByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOrdersByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Query/GetOrdersByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOrdersByStatus(ctx, req.(*QueryOrdersByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetOrdersByPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrdersByPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOrdersByPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Query/GetOrdersByPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOrdersByPair(ctx, req.(*QueryOrdersByPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetBidsByOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidsByOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBidsByOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Query/GetBidsByOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBidsByOrder(ctx, req.(*QueryBidsByOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetBidsByBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidsByBidderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBidsByBidder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Query/GetBidsByBidder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBidsByBidder(ctx, req.(*QueryBidsByBidderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetOrderPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOrderPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Query/GetOrderPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOrderPrice(ctx, req.(*QueryOrderPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Query/SwapLists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapLists(ctx, req.(*QuerySwapListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Query/ChannelRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelRateLimits(ctx, req.(*QueryChannelRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Query/GetOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOrder(ctx, req.(*QueryOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -293,6 +635,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EscrowAddress",
 			Handler:    _Query_EscrowAddress_Handler,
 		},
+		{
+			MethodName: "CollectedFees",
+			Handler:    _Query_CollectedFees_Handler,
+		},
 		{
 			MethodName: "GetAllOrders",
 			Handler:    _Query_GetAllOrders_Handler,
@@ -313,6 +659,42 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPrivateOrders",
 			Handler:    _Query_GetPrivateOrders_Handler,
 		},
+		{
+			MethodName: "GetOrdersByStatus",
+			Handler:    _Query_GetOrdersByStatus_Handler,
+		},
+		{
+			MethodName: "GetOrdersByPair",
+			Handler:    _Query_GetOrdersByPair_Handler,
+		},
+		{
+			MethodName: "GetBidsByOrder",
+			Handler:    _Query_GetBidsByOrder_Handler,
+		},
+		{
+			MethodName: "GetBidsByBidder",
+			Handler:    _Query_GetBidsByBidder_Handler,
+		},
+		{
+			MethodName: "GetOrderPrice",
+			Handler:    _Query_GetOrderPrice_Handler,
+		},
+		{
+			MethodName: "SwapLists",
+			Handler:    _Query_SwapLists_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "ChannelRateLimits",
+			Handler:    _Query_ChannelRateLimits_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _Query_GetOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/atomic_swap/v1/query.proto",
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// NewRateLimit creates a new RateLimit instance
+func NewRateLimit(portID, channelID, denom string, maxOutflow sdk.Int, window uint64) RateLimit {
+	return RateLimit{
+		PortId:     portID,
+		ChannelId:  channelID,
+		Denom:      denom,
+		MaxOutflow: maxOutflow,
+		Window:     window,
+	}
+}
+
+// Validate performs a basic check of the rate limit fields. A zero max outflow is valid, it removes the rate limit.
+func (r RateLimit) Validate() error {
+	if err := host.PortIdentifierValidator(r.PortId); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+	if r.MaxOutflow.IsNil() || r.MaxOutflow.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidRateLimit, "invalid max outflow %s", r.MaxOutflow)
+	}
+	if r.Window == 0 && r.MaxOutflow.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "window must be positive")
+	}
+	return nil
+}
+
+// NewRateLimitFlow creates an empty flow for a window starting at the given time.
+func NewRateLimitFlow(windowStart uint64) RateLimitFlow {
+	return RateLimitFlow{
+		Outflow:     sdk.ZeroInt(),
+		Inflow:      sdk.ZeroInt(),
+		WindowStart: windowStart,
+	}
+}
+
+// NetOutflow returns the outflow of the window minus its inflow.
+func (f RateLimitFlow) NetOutflow() sdk.Int {
+	return f.Outflow.Sub(f.Inflow)
+}
@@ -1,7 +1,13 @@
 package types
 
 import (
+	"bytes"
 	"crypto/sha256"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
@@ -32,3 +38,317 @@ func Hash(content []byte) tmbytes.HexBytes {
 	hash := sha256.Sum256(content)
 	return hash[:]
 }
+
+// IsExpired returns true if the order has an expiration timestamp and the given
+// block time (in unix seconds) has reached it.
+func (msg *MakeSwapMsg) IsExpired(blockTime int64) bool {
+	return msg.ExpirationTimestamp != 0 && blockTime >= 0 && uint64(blockTime) >= msg.ExpirationTimestamp
+}
+
+// IsBasket returns true if the order trades a basket of coins on each side.
+func (msg *MakeSwapMsg) IsBasket() bool {
+	return len(msg.SellTokens) > 0 || len(msg.BuyTokens) > 0
+}
+
+// SellCoins returns the coins sold by the order.
+func (msg *MakeSwapMsg) SellCoins() sdk.Coins {
+	if msg.IsBasket() {
+		return msg.SellTokens
+	}
+	return sdk.NewCoins(msg.SellToken)
+}
+
+// BuyCoins returns the coins asked by the order.
+func (msg *MakeSwapMsg) BuyCoins() sdk.Coins {
+	if msg.IsBasket() {
+		return msg.BuyTokens
+	}
+	return sdk.NewCoins(msg.BuyToken)
+}
+
+// Denoms returns the denoms of the sell and buy tokens of the order.
+func (msg *MakeSwapMsg) Denoms() []string {
+	if !msg.IsBasket() {
+		return []string{msg.SellToken.Denom, msg.BuyToken.Denom}
+	}
+	var denoms []string
+	for _, coin := range append(append(sdk.Coins{}, msg.SellTokens...), msg.BuyTokens...) {
+		denoms = append(denoms, coin.Denom)
+	}
+	return denoms
+}
+
+// SellCoins returns the coins paid by the take.
+func (msg *TakeSwapMsg) SellCoins() sdk.Coins {
+	if len(msg.SellTokens) > 0 {
+		return msg.SellTokens
+	}
+	return sdk.NewCoins(msg.SellToken)
+}
+
+// GetMinFillAmount returns the minimum amount of buy token a single take has to pay.
+func (msg *MakeSwapMsg) GetMinFillAmount() sdk.Int {
+	if msg.MinFillAmount.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return msg.MinFillAmount
+}
+
+// CurrentBuyToken returns the buy token asked by the order at the given block time (in unix seconds).
+// Without a price schedule it is the buy token of the order.
+func (msg *MakeSwapMsg) CurrentBuyToken(blockTime int64) sdk.Coin {
+	if msg.PriceSchedule == nil {
+		return msg.BuyToken
+	}
+	return sdk.NewCoin(msg.BuyToken.Denom, msg.PriceSchedule.BuyAmountAt(msg.CreateTimestamp, blockTime))
+}
+
+// Validate checks the price schedule of an order asking the given buy amount.
+func (s *PriceSchedule) Validate(buyAmount sdk.Int) error {
+	if s.StartBuyAmount.IsNil() || !s.StartBuyAmount.Equal(buyAmount) {
+		return sdkerrors.Wrapf(ErrInvalidPriceSchedule, "start buy amount must equal the buy token amount %s", buyAmount)
+	}
+	if s.FloorBuyAmount.IsNil() || !s.FloorBuyAmount.IsPositive() || s.FloorBuyAmount.GT(s.StartBuyAmount) {
+		return sdkerrors.Wrapf(ErrInvalidPriceSchedule, "floor buy amount must be positive and not exceed the start buy amount")
+	}
+	if s.DecayWindow == 0 {
+		return sdkerrors.Wrap(ErrInvalidPriceSchedule, "decay window must be positive")
+	}
+	return nil
+}
+
+// BuyAmountAt returns the buy amount asked at the given block time by an order created at the start time.
+// The amount decays linearly and is rounded up in favour of the maker.
+func (s *PriceSchedule) BuyAmountAt(startTime, blockTime int64) sdk.Int {
+	if blockTime <= startTime {
+		return s.StartBuyAmount
+	}
+	elapsed := uint64(blockTime - startTime)
+	if elapsed >= s.DecayWindow {
+		return s.FloorBuyAmount
+	}
+	decay := s.StartBuyAmount.Sub(s.FloorBuyAmount).Mul(sdk.NewIntFromUint64(elapsed)).Quo(sdk.NewIntFromUint64(s.DecayWindow))
+	return s.StartBuyAmount.Sub(decay)
+}
+
+// FilledBuyAmount returns the amount of buy token paid by all fills of the order.
+func (o *Order) FilledBuyAmount() sdk.Int {
+	filled := sdk.ZeroInt()
+	for _, fill := range o.Fills {
+		filled = filled.Add(fill.SellToken.Amount)
+	}
+	return filled
+}
+
+// RemainingBuyAmount returns the amount of buy token the order still accepts.
+func (o *Order) RemainingBuyAmount() sdk.Int {
+	return o.Maker.BuyToken.Amount.Sub(o.FilledBuyAmount())
+}
+
+// releasedSellAmount returns the amount of sell token released to takers once
+// the given amount of buy token has been filled.
+func (o *Order) releasedSellAmount(filled sdk.Int) sdk.Int {
+	// an order without partial fills releases the whole sell token to its taker,
+	// whatever the price paid under its price schedule
+	if !o.Maker.AllowPartialFill && filled.IsPositive() {
+		return o.Maker.SellToken.Amount
+	}
+	return o.Maker.SellToken.Amount.Mul(filled).Quo(o.Maker.BuyToken.Amount)
+}
+
+// FillSellToken returns the sell token the maker releases for a take paying the given
+// amount of buy token. The released amount is computed on the accumulated fills, so the
+// rounding never leaves dust in the escrow once the order is fully filled.
+func (o *Order) FillSellToken(amount sdk.Int) sdk.Coin {
+	filled := o.FilledBuyAmount()
+	released := o.releasedSellAmount(filled.Add(amount)).Sub(o.releasedSellAmount(filled))
+	return sdk.NewCoin(o.Maker.SellToken.Denom, released)
+}
+
+// ReleasedSellTokens returns the sell tokens released to a take paying the given amount.
+func (o *Order) ReleasedSellTokens(paid sdk.Coin) sdk.Coins {
+	if o.Maker.IsBasket() {
+		return o.Maker.SellTokens
+	}
+	return sdk.NewCoins(o.FillSellToken(paid.Amount))
+}
+
+// RemainingSellTokens returns the sell tokens of the order that have not been released to takers yet.
+func (o *Order) RemainingSellTokens() sdk.Coins {
+	if !o.Maker.IsBasket() {
+		return sdk.NewCoins(o.RemainingSellToken())
+	}
+	if o.IsFilled() {
+		return sdk.NewCoins()
+	}
+	return o.Maker.SellTokens
+}
+
+// RemainingSellToken returns the sell token that has not been released to takers yet.
+func (o *Order) RemainingSellToken() sdk.Coin {
+	released := o.releasedSellAmount(o.FilledBuyAmount())
+	return sdk.NewCoin(o.Maker.SellToken.Denom, o.Maker.SellToken.Amount.Sub(released))
+}
+
+// IsFilled returns true if the whole buy token of the order has been paid. An order
+// without partial fills is filled by its first take.
+func (o *Order) IsFilled() bool {
+	if o.Maker.IsBasket() {
+		return len(o.Fills) > 0
+	}
+	if !o.Maker.AllowPartialFill {
+		return o.FilledBuyAmount().IsPositive()
+	}
+	return !o.RemainingBuyAmount().IsPositive()
+}
+
+// IsClosed returns true if the order is completed, cancelled or expired.
+func (o *Order) IsClosed() bool {
+	return o.Status == Status_COMPLETE || o.Status == Status_CANCEL || o.Status == Status_EXPIRED
+}
+
+// CloseTimestamp returns the block time at which a closed order was closed, it returns
+// false for the open orders. The complete and cancel timestamps are given by the requests,
+// so they are not used to retain the orders.
+func (o *Order) CloseTimestamp() (uint64, bool) {
+	if !o.IsClosed() {
+		return 0, false
+	}
+	if o.ClosedAt < 0 {
+		return 0, true
+	}
+	return uint64(o.ClosedAt), true
+}
+
+// HasConsistentTimestamps returns true if the complete and cancel timestamps of the order match its status:
+// only completed orders have a complete timestamp and only cancelled orders have a cancel timestamp.
+func (o *Order) HasConsistentTimestamps() bool {
+	switch o.Status {
+	case Status_COMPLETE:
+		return o.CompleteTimestamp > 0 && o.CancelTimestamp == 0
+	case Status_CANCEL:
+		return o.CancelTimestamp > 0 && o.CompleteTimestamp == 0
+	default:
+		return o.CompleteTimestamp == 0 && o.CancelTimestamp == 0
+	}
+}
+
+// ValidateBasketFill checks that a take on a basket order pays all the buy tokens of the order.
+func (o *Order) ValidateBasketFill(sellTokens sdk.Coins) error {
+	if !o.Maker.IsBasket() {
+		return ErrInvalidBasket
+	}
+	if !sellTokens.IsEqual(o.Maker.BuyTokens) {
+		return sdkerrors.Wrapf(ErrInvalidSellToken, "expected %s, got %s", o.Maker.BuyTokens, sellTokens)
+	}
+	return nil
+}
+
+// ValidateFill checks the amount of a take against the fill rules of the order at the
+// given block time (in unix seconds). A take on an auction order may pay more than the
+// current price, the maker chain only charges the current price.
+func (o *Order) ValidateFill(sellToken sdk.Coin, blockTime int64) error {
+	if sellToken.Denom != o.Maker.BuyToken.Denom {
+		return ErrOrderDenominationMismatched
+	}
+	if o.Maker.PriceSchedule != nil {
+		if sellToken.Amount.LT(o.Maker.CurrentBuyToken(blockTime).Amount) {
+			return ErrOrderInsufficientAmount
+		}
+		return nil
+	}
+	if !o.Maker.AllowPartialFill {
+		if !sellToken.Amount.Equal(o.Maker.BuyToken.Amount) {
+			return ErrInvalidSellToken
+		}
+		return nil
+	}
+
+	remaining := o.RemainingBuyAmount()
+	if sellToken.Amount.GT(remaining) {
+		return ErrInvalidSellToken
+	}
+	// only the last fill is allowed to be smaller than the minimum fill amount
+	if sellToken.Amount.LT(o.Maker.GetMinFillAmount()) && !sellToken.Amount.Equal(remaining) {
+		return ErrOrderInsufficientAmount
+	}
+	if !o.FillSellToken(sellToken.Amount).IsPositive() {
+		return ErrOrderInsufficientAmount
+	}
+	return nil
+}
+
+// IsOpen returns true if the bid can still be accepted, its tokens are locked on the taker chain.
+func (b Bid) IsOpen() bool {
+	return b.Status == BID_INITIAL || b.Status == BID_PLACED
+}
+
+// VerifyPreimage returns true if the SHA-256 hash of the preimage matches the hash lock.
+func (h *HashTimeLock) VerifyPreimage(preimage []byte) bool {
+	hash := sha256.Sum256(preimage)
+	return bytes.Equal(hash[:], h.HashLock)
+}
+
+// ValidateBasic checks the fields of a signed order, the order has to be a valid make swap order.
+func (o *SignedOrder) ValidateBasic() error {
+	if strings.TrimSpace(o.ChainId) == "" {
+		return sdkerrors.Wrap(ErrInvalidSignature, "missing chain id")
+	}
+	if o.ExpirationTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignature, "signed orders must expire")
+	}
+	return o.MakeSwapMsg(clienttypes.ZeroHeight(), 0, 0).ValidateBasic()
+}
+
+// GetSignBytes returns the bytes signed by the maker of the order.
+func (o *SignedOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(o))
+}
+
+// MakeSwapMsg returns the make swap order opened by the signed order. An order with several
+// tokens on a side is opened as a basket order.
+func (o *SignedOrder) MakeSwapMsg(timeoutHeight clienttypes.Height, timeoutTimestamp uint64, createTimestamp int64) *MakeSwapMsg {
+	msg := &MakeSwapMsg{
+		SourcePort:            o.SourcePort,
+		SourceChannel:         o.SourceChannel,
+		MakerAddress:          o.MakerAddress,
+		MakerReceivingAddress: o.MakerReceivingAddress,
+		DesiredTaker:          o.DesiredTaker,
+		CreateTimestamp:       createTimestamp,
+		TimeoutHeight:         timeoutHeight,
+		TimeoutTimestamp:      timeoutTimestamp,
+		ExpirationTimestamp:   o.ExpirationTimestamp,
+	}
+	if len(o.SellTokens) == 1 && len(o.BuyTokens) == 1 {
+		msg.SellToken, msg.BuyToken = o.SellTokens[0], o.BuyTokens[0]
+	} else {
+		msg.SellTokens, msg.BuyTokens = o.SellTokens, o.BuyTokens
+	}
+	return msg
+}
+
+// MakeSwapMsg returns the make swap order filled by the message, the sender is bound as the taker of the order.
+// The maker and taker chains derive the same order from the message.
+func (msg *FillSignedOrderMsg) MakeSwapMsg() *MakeSwapMsg {
+	makeMsg := msg.Order.MakeSwapMsg(msg.TimeoutHeight, msg.TimeoutTimestamp, 0)
+	makeMsg.DesiredTaker = msg.Sender
+	return makeMsg
+}
+
+// TakeSwapMsg returns the take of the order with the given id by the sender, it pays the whole buy tokens of the
+// order.
+func (msg *FillSignedOrderMsg) TakeSwapMsg(orderId string) *TakeSwapMsg {
+	take := &TakeSwapMsg{
+		OrderId:               orderId,
+		TakerAddress:          msg.Sender,
+		TakerReceivingAddress: msg.TakerReceivingAddress,
+		TimeoutHeight:         msg.TimeoutHeight,
+		TimeoutTimestamp:      msg.TimeoutTimestamp,
+	}
+	if makeMsg := msg.MakeSwapMsg(); makeMsg.IsBasket() {
+		take.SellTokens = makeMsg.BuyTokens
+	} else {
+		take.SellToken = makeMsg.BuyToken
+	}
+	return take
+}
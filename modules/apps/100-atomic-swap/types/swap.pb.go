@@ -32,6 +32,7 @@ const (
 	Status_SYNC     Status = 1
 	Status_CANCEL   Status = 2
 	Status_COMPLETE Status = 3
+	Status_EXPIRED  Status = 4
 )
 
 var Status_name = map[int32]string{
@@ -39,6 +40,7 @@ var Status_name = map[int32]string{
 	1: "SYNC",
 	2: "CANCEL",
 	3: "COMPLETE",
+	4: "EXPIRED",
 }
 
 var Status_value = map[string]int32{
@@ -46,6 +48,7 @@ var Status_value = map[string]int32{
 	"SYNC":     1,
 	"CANCEL":   2,
 	"COMPLETE": 3,
+	"EXPIRED":  4,
 }
 
 func (x Status) String() string {
@@ -83,12 +86,96 @@ func (Side) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7ab3ff4471e3e52b, []int{1}
 }
 
+// OrderKind defines how an order is settled
+type OrderKind int32
+
+const (
+	// the order is settled over IBC with the counterparty chain
+	IBCOrder OrderKind = 0
+	// the order is a hash time locked contract, settled with the preimage of its hash lock
+	HTLCOrder OrderKind = 1
+)
+
+var OrderKind_name = map[int32]string{
+	0: "KIND_IBC",
+	1: "KIND_HTLC",
+}
+
+var OrderKind_value = map[string]int32{
+	"KIND_IBC":  0,
+	"KIND_HTLC": 1,
+}
+
+func (x OrderKind) String() string {
+	return proto.EnumName(OrderKind_name, int32(x))
+}
+
+func (OrderKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7ab3ff4471e3e52b, []int{2}
+}
+
+type BidStatus int32
+
+const (
+	// the bid is locked on the taker chain and not yet recorded by the maker chain
+	BID_INITIAL BidStatus = 0
+	// the bid is recorded on both chains
+	BID_PLACED BidStatus = 1
+	// the maker accepted the bid, the settlement is in flight
+	BID_ACCEPTED BidStatus = 2
+	// the bid has been settled with the maker
+	BID_COMPLETE BidStatus = 3
+	// the bidder withdrew the bid
+	BID_CANCELLED BidStatus = 4
+	// the bid has been refunded because the order was closed otherwise or the bid failed
+	BID_REFUNDED BidStatus = 5
+)
+
+var BidStatus_name = map[int32]string{
+	0: "BID_INITIAL",
+	1: "BID_PLACED",
+	2: "BID_ACCEPTED",
+	3: "BID_COMPLETE",
+	4: "BID_CANCELLED",
+	5: "BID_REFUNDED",
+}
+
+var BidStatus_value = map[string]int32{
+	"BID_INITIAL":   0,
+	"BID_PLACED":    1,
+	"BID_ACCEPTED":  2,
+	"BID_COMPLETE":  3,
+	"BID_CANCELLED": 4,
+	"BID_REFUNDED":  5,
+}
+
+func (x BidStatus) String() string {
+	return proto.EnumName(BidStatus_name, int32(x))
+}
+
+func (BidStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7ab3ff4471e3e52b, []int{3}
+}
+
 // Params defines the set of IBC swap parameters.
 type Params struct {
 	// swap_enabled enables or disables all cross-chain token transfers from this chain.
 	SwapEnabled bool `protobuf:"varint,1,opt,name=swap_enabled,json=swapEnabled,proto3" json:"swap_enabled,omitempty" yaml:"swap_enabled"`
 	// max_fee_rate set a max value of fee, it's base point, 1/10000
 	MaxFeeRate uint32 `protobuf:"varint,2,opt,name=max_fee_rate,json=maxFeeRate,proto3" json:"max_fee_rate,omitempty" yaml:"max_fee_rate"`
+	// fee_rate is the fee charged on the proceeds of each side of a swap, it's base point, 1/10000.
+	// It can not exceed max_fee_rate.
+	FeeRate uint32 `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty" yaml:"fee_rate"`
+	// fee_collector is the address receiving the fees, they are sent to the community pool if it is empty.
+	FeeCollector string `protobuf:"bytes,4,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty" yaml:"fee_collector"`
+	// order_retention_period is the number of seconds completed and cancelled orders are kept
+	// in the store after they were closed, they are kept forever if it is 0.
+	OrderRetentionPeriod uint64 `protobuf:"varint,5,opt,name=order_retention_period,json=orderRetentionPeriod,proto3" json:"order_retention_period,omitempty" yaml:"order_retention_period"`
+	// max_terminal_orders is the max number of completed and cancelled orders kept in the store,
+	// the oldest ones are pruned first. There is no limit if it is 0.
+	MaxTerminalOrders uint64 `protobuf:"varint,6,opt,name=max_terminal_orders,json=maxTerminalOrders,proto3" json:"max_terminal_orders,omitempty" yaml:"max_terminal_orders"`
+	// prune_batch_size is the max number of orders pruned per block, pruning is disabled if it is 0.
+	PruneBatchSize uint32 `protobuf:"varint,7,opt,name=prune_batch_size,json=pruneBatchSize,proto3" json:"prune_batch_size,omitempty" yaml:"prune_batch_size"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -138,6 +225,41 @@ func (m *Params) GetMaxFeeRate() uint32 {
 	return 0
 }
 
+func (m *Params) GetFeeRate() uint32 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *Params) GetFeeCollector() string {
+	if m != nil {
+		return m.FeeCollector
+	}
+	return ""
+}
+
+func (m *Params) GetOrderRetentionPeriod() uint64 {
+	if m != nil {
+		return m.OrderRetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTerminalOrders() uint64 {
+	if m != nil {
+		return m.MaxTerminalOrders
+	}
+	return 0
+}
+
+func (m *Params) GetPruneBatchSize() uint32 {
+	if m != nil {
+		return m.PruneBatchSize
+	}
+	return 0
+}
+
 type SwapMaker struct {
 	// the port on which the packet will be sent
 	SourcePort string `protobuf:"bytes,1,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty" yaml:"source_port"`
@@ -325,6 +447,61 @@ func (m *SwapTaker) GetCreateTimestamp() int64 {
 	return 0
 }
 
+// HashTimeLock holds the hash lock of an HTLC order. The timelock is the expiration timestamp of the order.
+type HashTimeLock struct {
+	// the SHA-256 hash of the secret preimage
+	HashLock []byte `protobuf:"bytes,1,opt,name=hash_lock,json=hashLock,proto3" json:"hash_lock,omitempty" yaml:"hash_lock"`
+	// the preimage revealed by the claim of the order
+	Preimage []byte `protobuf:"bytes,2,opt,name=preimage,proto3" json:"preimage,omitempty"`
+}
+
+func (m *HashTimeLock) Reset()         { *m = HashTimeLock{} }
+func (m *HashTimeLock) String() string { return proto.CompactTextString(m) }
+func (*HashTimeLock) ProtoMessage()    {}
+func (*HashTimeLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ab3ff4471e3e52b, []int{3}
+}
+func (m *HashTimeLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HashTimeLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HashTimeLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HashTimeLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HashTimeLock.Merge(m, src)
+}
+func (m *HashTimeLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *HashTimeLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_HashTimeLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HashTimeLock proto.InternalMessageInfo
+
+func (m *HashTimeLock) GetHashLock() []byte {
+	if m != nil {
+		return m.HashLock
+	}
+	return nil
+}
+
+func (m *HashTimeLock) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
 type Order struct {
 	Id                string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Side              Side         `protobuf:"varint,2,opt,name=side,proto3,enum=ibc.applications.atomic_swap.v1.Side" json:"side,omitempty"`
@@ -334,13 +511,22 @@ type Order struct {
 	Takers            *TakeSwapMsg `protobuf:"bytes,6,opt,name=takers,proto3" json:"takers,omitempty"`
 	CancelTimestamp   int64        `protobuf:"varint,7,opt,name=cancel_timestamp,json=cancelTimestamp,proto3" json:"cancel_timestamp,omitempty"`
 	CompleteTimestamp int64        `protobuf:"varint,8,opt,name=complete_timestamp,json=completeTimestamp,proto3" json:"complete_timestamp,omitempty"`
+	// fills records every take of the order, partially filled orders can have several.
+	Fills []*TakeSwapMsg `protobuf:"bytes,9,rep,name=fills,proto3" json:"fills,omitempty"`
+	Kind  OrderKind      `protobuf:"varint,10,opt,name=kind,proto3,enum=ibc.applications.atomic_swap.v1.OrderKind" json:"kind,omitempty"`
+	// the hash lock of HTLC orders
+	Htlc *HashTimeLock `protobuf:"bytes,11,opt,name=htlc,proto3" json:"htlc,omitempty"`
+	// the error acknowledgement of the last failed packet of the order
+	AckError *ErrorAcknowledgement `protobuf:"bytes,12,opt,name=ack_error,json=ackError,proto3" json:"ack_error,omitempty" yaml:"ack_error"`
+	// the block time at which the order was completed, cancelled or expired, the retention period starts from it
+	ClosedAt int64 `protobuf:"varint,13,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty" yaml:"closed_at"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
 func (m *Order) String() string { return proto.CompactTextString(m) }
 func (*Order) ProtoMessage()    {}
 func (*Order) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ab3ff4471e3e52b, []int{3}
+	return fileDescriptor_7ab3ff4471e3e52b, []int{4}
 }
 func (m *Order) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,13 +611,140 @@ func (m *Order) GetCompleteTimestamp() int64 {
 	return 0
 }
 
+func (m *Order) GetFills() []*TakeSwapMsg {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
+func (m *Order) GetKind() OrderKind {
+	if m != nil {
+		return m.Kind
+	}
+	return IBCOrder
+}
+
+func (m *Order) GetHtlc() *HashTimeLock {
+	if m != nil {
+		return m.Htlc
+	}
+	return nil
+}
+
+func (m *Order) GetAckError() *ErrorAcknowledgement {
+	if m != nil {
+		return m.AckError
+	}
+	return nil
+}
+
+func (m *Order) GetClosedAt() int64 {
+	if m != nil {
+		return m.ClosedAt
+	}
+	return 0
+}
+
+// Bid is a counter-offer of a taker for an order.
+type Bid struct {
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// the bidder address on the taker chain
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// the bidder's address on the maker chain
+	BidderReceivingAddress string `protobuf:"bytes,3,opt,name=bidder_receiving_address,json=bidderReceivingAddress,proto3" json:"bidder_receiving_address,omitempty"`
+	// the tokens offered for the sell token of the order
+	Amount          types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	Status          BidStatus  `protobuf:"varint,5,opt,name=status,proto3,enum=ibc.applications.atomic_swap.v1.BidStatus" json:"status,omitempty"`
+	CreateTimestamp int64      `protobuf:"varint,6,opt,name=create_timestamp,json=createTimestamp,proto3" json:"create_timestamp,omitempty"`
+}
+
+func (m *Bid) Reset()         { *m = Bid{} }
+func (m *Bid) String() string { return proto.CompactTextString(m) }
+func (*Bid) ProtoMessage()    {}
+func (*Bid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ab3ff4471e3e52b, []int{5}
+}
+func (m *Bid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bid.Merge(m, src)
+}
+func (m *Bid) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bid) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bid proto.InternalMessageInfo
+
+func (m *Bid) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *Bid) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *Bid) GetBidderReceivingAddress() string {
+	if m != nil {
+		return m.BidderReceivingAddress
+	}
+	return ""
+}
+
+func (m *Bid) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *Bid) GetStatus() BidStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BID_INITIAL
+}
+
+func (m *Bid) GetCreateTimestamp() int64 {
+	if m != nil {
+		return m.CreateTimestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.applications.atomic_swap.v1.Status", Status_name, Status_value)
 	proto.RegisterEnum("ibc.applications.atomic_swap.v1.Side", Side_name, Side_value)
+	proto.RegisterEnum("ibc.applications.atomic_swap.v1.OrderKind", OrderKind_name, OrderKind_value)
+	proto.RegisterEnum("ibc.applications.atomic_swap.v1.BidStatus", BidStatus_name, BidStatus_value)
 	proto.RegisterType((*Params)(nil), "ibc.applications.atomic_swap.v1.Params")
 	proto.RegisterType((*SwapMaker)(nil), "ibc.applications.atomic_swap.v1.SwapMaker")
 	proto.RegisterType((*SwapTaker)(nil), "ibc.applications.atomic_swap.v1.SwapTaker")
+	proto.RegisterType((*HashTimeLock)(nil), "ibc.applications.atomic_swap.v1.HashTimeLock")
 	proto.RegisterType((*Order)(nil), "ibc.applications.atomic_swap.v1.Order")
+	proto.RegisterType((*Bid)(nil), "ibc.applications.atomic_swap.v1.Bid")
 }
 
 func init() {
@@ -439,61 +752,93 @@ func init() {
 }
 
 var fileDescriptor_7ab3ff4471e3e52b = []byte{
-	// 850 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4f, 0x6f, 0xe3, 0xc4,
-	0x1b, 0xc7, 0xe3, 0x24, 0x4d, 0x93, 0x27, 0x49, 0x7f, 0xf9, 0x0d, 0xbb, 0x6c, 0x5a, 0x24, 0x27,
-	0x32, 0x42, 0x84, 0x8a, 0xda, 0x9b, 0x22, 0x81, 0x76, 0xc5, 0x02, 0x4d, 0xf0, 0x4a, 0x91, 0xfa,
-	0x4f, 0xae, 0x85, 0xb4, 0x7b, 0xb1, 0xc6, 0xf6, 0x90, 0x5a, 0x6b, 0x7b, 0x2c, 0xcf, 0xa4, 0xdb,
-	0x9e, 0xb8, 0x22, 0xb8, 0xf0, 0x06, 0x38, 0x71, 0xe5, 0x85, 0xec, 0x71, 0x8f, 0x9c, 0x22, 0xd4,
-	0xbe, 0x00, 0xa4, 0xbc, 0x02, 0xe4, 0x19, 0x27, 0x78, 0x43, 0x57, 0x5d, 0x4e, 0xf5, 0x77, 0xbe,
-	0xcf, 0x77, 0xf4, 0xf4, 0xf3, 0xcc, 0x4c, 0x60, 0x37, 0x70, 0x3d, 0x03, 0x27, 0x49, 0x18, 0x78,
-	0x98, 0x07, 0x34, 0x66, 0x06, 0xe6, 0x34, 0x0a, 0x3c, 0x87, 0xbd, 0xc4, 0x89, 0x71, 0x31, 0x34,
-	0xb2, 0xbf, 0x7a, 0x92, 0x52, 0x4e, 0x51, 0x2f, 0x70, 0x3d, 0xbd, 0x58, 0xab, 0x17, 0x6a, 0xf5,
-	0x8b, 0xe1, 0xce, 0xbd, 0x29, 0x9d, 0x52, 0x51, 0x6b, 0x64, 0x5f, 0x32, 0xb6, 0xa3, 0x7a, 0x94,
-	0x45, 0x94, 0x19, 0x2e, 0x66, 0xc4, 0xb8, 0x18, 0xba, 0x84, 0xe3, 0xa1, 0xe1, 0xd1, 0x20, 0xce,
-	0xfd, 0xc1, 0x5d, 0x2d, 0xf0, 0x4b, 0x59, 0xa9, 0xfd, 0x00, 0xb5, 0x53, 0x9c, 0xe2, 0x88, 0xa1,
-	0xc7, 0xd0, 0xca, 0x5c, 0x87, 0xc4, 0xd8, 0x0d, 0x89, 0xdf, 0x55, 0xfa, 0xca, 0xa0, 0x3e, 0x7a,
-	0xb0, 0x98, 0xf7, 0xde, 0xbb, 0xc2, 0x51, 0xf8, 0x58, 0x2b, 0xba, 0x9a, 0xd5, 0xcc, 0xa4, 0x29,
-	0x15, 0x7a, 0x04, 0xad, 0x08, 0x5f, 0x3a, 0xdf, 0x13, 0xe2, 0xa4, 0x98, 0x93, 0x6e, 0xb9, 0xaf,
-	0x0c, 0xda, 0xc5, 0x6c, 0xd1, 0xd5, 0x2c, 0x88, 0xf0, 0xe5, 0x53, 0x42, 0xac, 0x4c, 0xfc, 0x55,
-	0x81, 0xc6, 0xd9, 0x4b, 0x9c, 0x1c, 0xe1, 0x17, 0x24, 0x45, 0x5f, 0x40, 0x93, 0xd1, 0x59, 0xea,
-	0x11, 0x27, 0xa1, 0x29, 0x17, 0x3d, 0x34, 0x46, 0xef, 0x2f, 0xe6, 0x3d, 0x94, 0xf7, 0xf0, 0x8f,
-	0xa9, 0x59, 0x20, 0xd5, 0x29, 0x4d, 0x39, 0xfa, 0x06, 0xb6, 0x72, 0xcf, 0x3b, 0xc7, 0x71, 0x4c,
-	0x42, 0xd1, 0x43, 0x63, 0xb4, 0xbd, 0x98, 0xf7, 0xee, 0xbf, 0x91, 0xcd, 0x7d, 0xcd, 0x6a, 0xcb,
-	0x85, 0xb1, 0xd4, 0xe8, 0x2b, 0x00, 0x46, 0xc2, 0xd0, 0xe1, 0xf4, 0x05, 0x89, 0xbb, 0x95, 0xbe,
-	0x32, 0x68, 0xee, 0x6f, 0xeb, 0x12, 0xb4, 0x9e, 0x81, 0xd6, 0x73, 0xd0, 0xfa, 0x98, 0x06, 0xf1,
-	0xa8, 0xfa, 0x6a, 0xde, 0x2b, 0x59, 0x8d, 0x2c, 0x62, 0x67, 0x09, 0xf4, 0x25, 0x34, 0xdc, 0xd9,
-	0x55, 0x1e, 0xaf, 0xbe, 0x5b, 0xbc, 0xee, 0xce, 0xae, 0x64, 0xfa, 0x09, 0xb4, 0xa3, 0x8c, 0x80,
-	0x83, 0x7d, 0x3f, 0x25, 0x8c, 0x75, 0x37, 0x44, 0xfb, 0xdd, 0xc5, 0xbc, 0x77, 0x6f, 0x89, 0xb0,
-	0x60, 0x6b, 0x56, 0x4b, 0xe8, 0x03, 0x29, 0xd1, 0x73, 0x78, 0x20, 0xfd, 0x94, 0x78, 0x24, 0xb8,
-	0x08, 0xe2, 0xe9, 0x6a, 0xa3, 0x9a, 0xd8, 0x48, 0x5b, 0xcc, 0x7b, 0x6a, 0x71, 0xa3, 0x7f, 0x15,
-	0x6a, 0xd6, 0x7d, 0xe1, 0x58, 0x4b, 0x63, 0xb9, 0xf7, 0x87, 0xd0, 0xf6, 0x09, 0x0b, 0x52, 0xe2,
-	0x3b, 0x3c, 0x2b, 0xe8, 0x6e, 0x66, 0x3b, 0x5a, 0xad, 0x7c, 0xd1, 0x16, 0x83, 0xfb, 0x04, 0x3a,
-	0x5e, 0x4a, 0x30, 0x27, 0x0e, 0x0f, 0x22, 0xc2, 0x38, 0x8e, 0x92, 0x6e, 0xbd, 0xaf, 0x0c, 0x2a,
-	0xd6, 0xff, 0xe4, 0xba, 0xbd, 0x5c, 0xd6, 0x7e, 0x2f, 0xcb, 0x89, 0xcb, 0xe0, 0x36, 0xd4, 0x69,
-	0xea, 0x93, 0xd4, 0x09, 0xe4, 0x91, 0x6b, 0x58, 0x9b, 0x42, 0x4f, 0xfc, 0xb5, 0x89, 0x94, 0xff,
-	0xf3, 0x44, 0x9e, 0x40, 0x9b, 0xbf, 0xc1, 0xb4, 0xb2, 0xce, 0x94, 0xaf, 0x31, 0xe5, 0x6b, 0x4c,
-	0xf9, 0x5b, 0x98, 0x56, 0xd7, 0x99, 0xf2, 0xb7, 0x32, 0xe5, 0xb7, 0x32, 0xbd, 0x0d, 0xd7, 0xc6,
-	0xed, 0xb8, 0x7e, 0xae, 0xc0, 0xc6, 0x49, 0x46, 0x04, 0x6d, 0x41, 0x79, 0x05, 0xa9, 0x1c, 0x64,
-	0xb7, 0xae, 0xca, 0x02, 0x5f, 0xde, 0xb6, 0xad, 0xfd, 0x8f, 0xf4, 0x3b, 0xde, 0x12, 0xfd, 0x2c,
-	0xf0, 0x89, 0x25, 0x22, 0x68, 0x04, 0x1b, 0x62, 0xd8, 0xf9, 0x39, 0xff, 0xf4, 0xce, 0x6c, 0x76,
-	0x3d, 0xc5, 0x35, 0x65, 0x53, 0x4b, 0x46, 0xd1, 0xd7, 0x50, 0x63, 0x1c, 0xf3, 0x99, 0xc4, 0xb1,
-	0xb5, 0xff, 0xf1, 0xdd, 0x0d, 0x88, 0x72, 0x2b, 0x8f, 0x21, 0x04, 0xd5, 0x04, 0xf3, 0x73, 0x79,
-	0xd4, 0x2d, 0xf1, 0x8d, 0xbe, 0x85, 0x9a, 0x20, 0x26, 0xcf, 0xed, 0xbb, 0x74, 0x66, 0x17, 0x3a,
-	0xcb, 0xb3, 0x02, 0x2f, 0x8e, 0x3d, 0x12, 0x16, 0xf0, 0x6e, 0xe6, 0x78, 0xc5, 0xfa, 0x0a, 0x2f,
-	0xda, 0x03, 0xe4, 0xd1, 0x28, 0x09, 0xc9, 0x2d, 0x47, 0xf7, 0xff, 0x4b, 0x67, 0x55, 0xbe, 0xfb,
-	0x08, 0x6a, 0xf2, 0xbf, 0x40, 0x4d, 0xd8, 0x9c, 0x1c, 0x4f, 0xec, 0xc9, 0xc1, 0x61, 0xa7, 0x84,
-	0xea, 0x50, 0x3d, 0x7b, 0x76, 0x3c, 0xee, 0x28, 0x08, 0xa0, 0x36, 0x3e, 0x38, 0x1e, 0x9b, 0x87,
-	0x9d, 0x32, 0x6a, 0x41, 0x7d, 0x7c, 0x72, 0x74, 0x7a, 0x68, 0xda, 0x66, 0xa7, 0xb2, 0xfb, 0x14,
-	0xaa, 0xd9, 0x04, 0xd0, 0x07, 0xd0, 0xb4, 0x9f, 0x9d, 0x9a, 0xce, 0xf1, 0x81, 0x3d, 0xf9, 0xce,
-	0xec, 0x94, 0x76, 0xe0, 0xa7, 0x5f, 0xfb, 0x35, 0xa9, 0x56, 0xa6, 0x65, 0x1e, 0x9d, 0xd8, 0x66,
-	0x47, 0x91, 0xa6, 0x54, 0x3b, 0xd5, 0x1f, 0x7f, 0x53, 0x4b, 0x23, 0xe7, 0xd5, 0xb5, 0xaa, 0xbc,
-	0xbe, 0x56, 0x95, 0x3f, 0xaf, 0x55, 0xe5, 0x97, 0x1b, 0xb5, 0xf4, 0xfa, 0x46, 0x2d, 0xfd, 0x71,
-	0xa3, 0x96, 0x9e, 0x9b, 0xd3, 0x80, 0x9f, 0xcf, 0x5c, 0xdd, 0xa3, 0x91, 0x91, 0x8d, 0x59, 0x3c,
-	0xf1, 0x1e, 0x0d, 0x8d, 0xc0, 0xf5, 0xe4, 0xcb, 0xff, 0xb9, 0x11, 0x51, 0x7f, 0x16, 0x12, 0x96,
-	0xfd, 0x3a, 0x30, 0x63, 0xf8, 0xf0, 0xe1, 0x9e, 0xc4, 0xb9, 0x27, 0x7c, 0x7e, 0x95, 0x10, 0xe6,
-	0xd6, 0x44, 0xee, 0xb3, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x23, 0x1c, 0xbd, 0xc5, 0xc9, 0x06,
-	0x00, 0x00,
+	// 1375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x6e, 0x13, 0x49,
+	0x16, 0xf6, 0x7f, 0xec, 0x13, 0x3b, 0x34, 0x45, 0x08, 0xc6, 0xec, 0xda, 0xde, 0x5e, 0xad, 0x36,
+	0x1b, 0x11, 0x9b, 0xb0, 0xda, 0x65, 0x17, 0x2d, 0xec, 0xb8, 0xed, 0x8e, 0xb0, 0x08, 0xc1, 0xaa,
+	0x78, 0x7e, 0x40, 0x1a, 0xb5, 0xca, 0xdd, 0x95, 0xb8, 0xe5, 0xfe, 0xb1, 0xba, 0xcb, 0x21, 0x99,
+	0x79, 0x81, 0x11, 0x57, 0x73, 0x3d, 0x12, 0x57, 0x73, 0x3b, 0x0f, 0xc2, 0x25, 0x97, 0x73, 0x65,
+	0x8d, 0xe0, 0x01, 0x90, 0xfc, 0x04, 0xa3, 0xaa, 0x6a, 0x3b, 0xc6, 0x98, 0x71, 0xb8, 0x4a, 0x9f,
+	0xf3, 0x7d, 0xdf, 0x49, 0xf5, 0x77, 0xea, 0x1c, 0x37, 0xec, 0xd8, 0x3d, 0xb3, 0x4e, 0x86, 0x43,
+	0xc7, 0x36, 0x09, 0xb3, 0x7d, 0x2f, 0xac, 0x13, 0xe6, 0xbb, 0xb6, 0x69, 0x84, 0x2f, 0xc8, 0xb0,
+	0x7e, 0xba, 0x57, 0xe7, 0x7f, 0x6b, 0xc3, 0xc0, 0x67, 0x3e, 0xaa, 0xd8, 0x3d, 0xb3, 0x36, 0xcf,
+	0xad, 0xcd, 0x71, 0x6b, 0xa7, 0x7b, 0xa5, 0xcd, 0x13, 0xff, 0xc4, 0x17, 0xdc, 0x3a, 0x7f, 0x92,
+	0xb2, 0x52, 0xd9, 0xf4, 0x43, 0xd7, 0x0f, 0xeb, 0x3d, 0x12, 0xd2, 0xfa, 0xe9, 0x5e, 0x8f, 0x32,
+	0xb2, 0x57, 0x37, 0x7d, 0xdb, 0x8b, 0xf0, 0xed, 0x55, 0x47, 0x60, 0x67, 0x11, 0xf3, 0xf6, 0x2a,
+	0xe6, 0x90, 0x98, 0x03, 0xca, 0x24, 0x5b, 0x1d, 0x27, 0x21, 0xd3, 0x21, 0x01, 0x71, 0x43, 0x74,
+	0x1f, 0xf2, 0x9c, 0x62, 0x50, 0x8f, 0xf4, 0x1c, 0x6a, 0x15, 0xe3, 0xd5, 0xf8, 0x76, 0x56, 0xbb,
+	0x31, 0x19, 0x57, 0xae, 0x9d, 0x13, 0xd7, 0xb9, 0xaf, 0xce, 0xa3, 0x2a, 0x5e, 0xe7, 0xa1, 0x2e,
+	0x23, 0xf4, 0x5f, 0xc8, 0xbb, 0xe4, 0xcc, 0x38, 0xa6, 0xd4, 0x08, 0x08, 0xa3, 0xc5, 0x44, 0x35,
+	0xbe, 0x5d, 0x98, 0xd7, 0xce, 0xa3, 0x2a, 0x06, 0x97, 0x9c, 0xed, 0x53, 0x8a, 0x09, 0xa3, 0xa8,
+	0x06, 0xd9, 0x99, 0x2c, 0x29, 0x64, 0xd7, 0x26, 0xe3, 0xca, 0x15, 0x29, 0xbb, 0x90, 0xac, 0x1d,
+	0x47, 0xfc, 0x07, 0x50, 0xe0, 0x59, 0xd3, 0x77, 0x1c, 0x6a, 0x32, 0x3f, 0x28, 0xa6, 0xaa, 0xf1,
+	0xed, 0x9c, 0x56, 0x9c, 0x8c, 0x2b, 0x9b, 0x17, 0xa2, 0x19, 0xac, 0xe2, 0xfc, 0x31, 0xa5, 0xcd,
+	0x69, 0x88, 0xbe, 0x86, 0x2d, 0x3f, 0xb0, 0x68, 0x60, 0x04, 0x94, 0x51, 0x8f, 0x1b, 0x64, 0x0c,
+	0x69, 0x60, 0xfb, 0x56, 0x31, 0x5d, 0x8d, 0x6f, 0xa7, 0xb4, 0xbf, 0x4c, 0xc6, 0x95, 0x3f, 0xcb,
+	0x3a, 0xcb, 0x79, 0x2a, 0xde, 0x14, 0x00, 0x9e, 0xe6, 0x3b, 0x22, 0x8d, 0x0e, 0xe1, 0x1a, 0x7f,
+	0x49, 0x46, 0x03, 0xd7, 0xf6, 0x88, 0x63, 0x08, 0x52, 0x58, 0xcc, 0x88, 0xaa, 0xe5, 0xc9, 0xb8,
+	0x52, 0xba, 0x70, 0x62, 0x81, 0xa4, 0xe2, 0xab, 0x2e, 0x39, 0xeb, 0x46, 0xc9, 0xa7, 0x22, 0x87,
+	0x74, 0x50, 0x86, 0xc1, 0xc8, 0xa3, 0x46, 0x8f, 0x30, 0xb3, 0x6f, 0x84, 0xf6, 0x77, 0xb4, 0xb8,
+	0x26, 0xfc, 0xb9, 0x35, 0x19, 0x57, 0x6e, 0xc8, 0x62, 0x8b, 0x0c, 0x15, 0x6f, 0x88, 0x94, 0xc6,
+	0x33, 0x47, 0x3c, 0xf1, 0x3e, 0x09, 0xb9, 0xa3, 0x17, 0x64, 0xf8, 0x84, 0x0c, 0x68, 0x80, 0xee,
+	0xc1, 0x7a, 0xe8, 0x8f, 0x02, 0x93, 0x1a, 0x43, 0x3f, 0x60, 0xa2, 0xc5, 0x39, 0x6d, 0x6b, 0x32,
+	0xae, 0xa0, 0xa8, 0xc5, 0x17, 0xa0, 0x8a, 0x41, 0x46, 0x1d, 0x3f, 0x60, 0xe8, 0x0b, 0xd8, 0x88,
+	0x30, 0xb3, 0x4f, 0x3c, 0x8f, 0x3a, 0xa2, 0xc5, 0x39, 0xed, 0xe6, 0x64, 0x5c, 0xb9, 0xfe, 0x81,
+	0x36, 0xc2, 0x55, 0x5c, 0x90, 0x89, 0xa6, 0x8c, 0xd1, 0x43, 0x80, 0x90, 0x3a, 0x8e, 0xc1, 0xfc,
+	0x01, 0xf5, 0x44, 0xa7, 0xd7, 0xef, 0xde, 0xac, 0xc9, 0x6b, 0x5f, 0xe3, 0xd7, 0xbe, 0x16, 0x5d,
+	0xfb, 0x5a, 0xd3, 0xb7, 0x3d, 0x2d, 0xf5, 0x7a, 0x5c, 0x89, 0xe1, 0x1c, 0x97, 0x74, 0xb9, 0x02,
+	0xfd, 0x0f, 0x72, 0xbd, 0xd1, 0x79, 0x24, 0x4f, 0x5d, 0x4e, 0x9e, 0xed, 0x8d, 0xce, 0xa5, 0xfa,
+	0x01, 0x14, 0x5c, 0xee, 0x80, 0x41, 0x2c, 0x2b, 0xa0, 0x61, 0x58, 0x4c, 0x2f, 0xde, 0x9a, 0x0f,
+	0x60, 0x15, 0xe7, 0x45, 0xdc, 0x90, 0x21, 0x7a, 0x0e, 0x37, 0x24, 0x1e, 0x50, 0x93, 0xda, 0xa7,
+	0xb6, 0x77, 0x32, 0x2b, 0x94, 0x11, 0x85, 0xd4, 0xc9, 0xb8, 0x52, 0x9e, 0x2f, 0xf4, 0x11, 0x51,
+	0xc5, 0xd7, 0x05, 0x82, 0xa7, 0xc0, 0xb4, 0xf6, 0x5f, 0xa1, 0x60, 0xd1, 0xd0, 0x0e, 0xa8, 0x65,
+	0x30, 0x4e, 0x10, 0x5d, 0xce, 0xe1, 0x7c, 0x94, 0xec, 0x8a, 0xc6, 0xfd, 0x03, 0x14, 0x33, 0xa0,
+	0x84, 0x51, 0x83, 0xd9, 0x2e, 0x0d, 0x19, 0x71, 0x87, 0xc5, 0x6c, 0x35, 0xbe, 0x9d, 0xc4, 0x57,
+	0x64, 0xbe, 0x3b, 0x4d, 0xab, 0xbf, 0x24, 0x64, 0xc7, 0xa5, 0xf0, 0x26, 0x64, 0xe5, 0x3d, 0xb6,
+	0xe5, 0x44, 0xe7, 0xf0, 0x9a, 0x88, 0xdb, 0xd6, 0x42, 0x47, 0x12, 0x9f, 0xdd, 0x91, 0x07, 0x50,
+	0x60, 0x1f, 0x78, 0x9a, 0x5c, 0xf4, 0x94, 0x2d, 0x78, 0xca, 0x16, 0x3c, 0x65, 0x9f, 0xf0, 0x34,
+	0xb5, 0xe8, 0x29, 0xfb, 0xa4, 0xa7, 0x6c, 0xa9, 0xa7, 0xcb, 0xec, 0x4a, 0x2f, 0xb7, 0xeb, 0x5b,
+	0xc8, 0x3f, 0x22, 0x61, 0x9f, 0x27, 0x0e, 0x7c, 0x73, 0x80, 0xf6, 0x20, 0xd7, 0x27, 0x61, 0xdf,
+	0x70, 0x7c, 0x73, 0x20, 0x1c, 0xcb, 0x6b, 0x9b, 0x93, 0x71, 0x45, 0x91, 0x07, 0x99, 0x41, 0x2a,
+	0xce, 0xf2, 0x67, 0x21, 0x29, 0x41, 0x76, 0x18, 0x50, 0xdb, 0x25, 0x27, 0x72, 0xf3, 0xe5, 0xf1,
+	0x2c, 0x56, 0xdf, 0xa7, 0x21, 0x2d, 0x26, 0x1a, 0x6d, 0x40, 0x62, 0xd6, 0x83, 0x84, 0xcd, 0x77,
+	0x66, 0x2a, 0xb4, 0x2d, 0xa9, 0xd8, 0xb8, 0xfb, 0xb7, 0xda, 0x8a, 0x1f, 0x8e, 0xda, 0x91, 0x6d,
+	0x51, 0x2c, 0x24, 0x48, 0x83, 0xb4, 0xb8, 0x4b, 0xd1, 0x18, 0xdd, 0x5e, 0xa9, 0xe5, 0xd3, 0x2f,
+	0xb6, 0x40, 0x78, 0x82, 0xa5, 0x14, 0xfd, 0x1f, 0x32, 0x21, 0x23, 0x6c, 0x24, 0xdd, 0xde, 0xb8,
+	0xfb, 0xf7, 0xd5, 0x07, 0x10, 0x74, 0x1c, 0xc9, 0x10, 0x82, 0xd4, 0x90, 0xb0, 0xbe, 0x9c, 0x24,
+	0x2c, 0x9e, 0x51, 0x0b, 0x32, 0xa2, 0x21, 0x72, 0x2c, 0x2e, 0x73, 0xb2, 0xee, 0xdc, 0xc9, 0x22,
+	0xad, 0xe8, 0x1e, 0xf1, 0x4c, 0xea, 0xcc, 0x75, 0x6f, 0x2d, 0xea, 0x9e, 0xc8, 0xcf, 0xba, 0x87,
+	0x76, 0x01, 0x99, 0xbe, 0x3b, 0x74, 0xe8, 0x92, 0xc9, 0xb8, 0x3a, 0x45, 0x2e, 0xe8, 0x1a, 0xa4,
+	0x8f, 0x6d, 0xc7, 0x09, 0x8b, 0xb9, 0x6a, 0xf2, 0xb3, 0x8f, 0x27, 0xa5, 0xe8, 0x21, 0xa4, 0x06,
+	0xb6, 0x67, 0x15, 0x41, 0xd8, 0xb6, 0xb3, 0xb2, 0x84, 0xe8, 0xfe, 0x63, 0xdb, 0xb3, 0xb0, 0xd0,
+	0xa1, 0x06, 0xa4, 0xfa, 0xcc, 0x31, 0x8b, 0xeb, 0xc2, 0xa1, 0xdd, 0x95, 0xfa, 0xf9, 0xdb, 0x89,
+	0x85, 0x14, 0xf5, 0x21, 0x47, 0xcc, 0x81, 0x41, 0x83, 0xc0, 0x0f, 0x8a, 0x79, 0x51, 0xe7, 0x5f,
+	0x2b, 0xeb, 0xe8, 0x9c, 0xdd, 0x30, 0x07, 0x9e, 0xff, 0xc2, 0xa1, 0xd6, 0x09, 0x75, 0xa9, 0xc7,
+	0xe6, 0xaf, 0xf6, 0xac, 0xa2, 0x8a, 0xb3, 0xc4, 0x1c, 0x08, 0x3a, 0x9f, 0x06, 0xd3, 0xf1, 0x43,
+	0x6a, 0x19, 0x84, 0x15, 0x0b, 0xdc, 0xd6, 0x79, 0xc9, 0x0c, 0x52, 0x71, 0x56, 0x3e, 0x37, 0x98,
+	0xfa, 0x53, 0x02, 0x92, 0x9a, 0x6d, 0xfd, 0xd1, 0xe6, 0xd9, 0x82, 0x4c, 0xcf, 0xb6, 0x2c, 0x1a,
+	0xc8, 0x5f, 0x11, 0x1c, 0x45, 0xe8, 0x3f, 0x50, 0x94, 0x4f, 0x4b, 0x76, 0x82, 0x58, 0x2e, 0x78,
+	0x4b, 0xe2, 0x1f, 0x0d, 0xfc, 0x3d, 0xc8, 0x10, 0xd7, 0x1f, 0x79, 0xec, 0xb2, 0x3f, 0x0d, 0x11,
+	0x1d, 0x69, 0xb3, 0x31, 0x48, 0x5f, 0xb2, 0x9f, 0x9a, 0x6d, 0x2d, 0x4c, 0xc2, 0xb2, 0x6d, 0x93,
+	0x59, 0xba, 0x6d, 0x76, 0xf6, 0x21, 0x23, 0xc5, 0x68, 0x1d, 0xd6, 0xda, 0x87, 0xed, 0x6e, 0xbb,
+	0x71, 0xa0, 0xc4, 0x50, 0x16, 0x52, 0x47, 0xcf, 0x0e, 0x9b, 0x4a, 0x1c, 0x01, 0x64, 0x9a, 0x8d,
+	0xc3, 0xa6, 0x7e, 0xa0, 0x24, 0x50, 0x1e, 0xb2, 0xcd, 0xa7, 0x4f, 0x3a, 0x07, 0x7a, 0x57, 0x57,
+	0x92, 0x5c, 0xa0, 0x7f, 0xd3, 0x69, 0x63, 0xbd, 0xa5, 0xa4, 0x76, 0xf6, 0x21, 0xc5, 0xf7, 0x01,
+	0xba, 0x05, 0xeb, 0xdd, 0x67, 0x1d, 0xdd, 0x38, 0x6c, 0x74, 0xdb, 0x5f, 0xe9, 0x4a, 0xac, 0x04,
+	0x2f, 0x5f, 0x55, 0x33, 0x32, 0x9a, 0x81, 0x58, 0x7f, 0xf2, 0xb4, 0xab, 0x2b, 0x71, 0x09, 0xca,
+	0xa8, 0x94, 0xfa, 0xe1, 0xe7, 0x72, 0x6c, 0xe7, 0x31, 0xe4, 0x66, 0xf7, 0x93, 0xef, 0xb1, 0xc7,
+	0xed, 0xc3, 0x96, 0xd1, 0xd6, 0x9a, 0x4a, 0xac, 0x94, 0x7f, 0xf9, 0xaa, 0x9a, 0x6d, 0x6b, 0x4d,
+	0xb9, 0xbd, 0xfe, 0x04, 0x39, 0x81, 0x3d, 0xea, 0x1e, 0x34, 0x95, 0x78, 0xa9, 0xf0, 0xf2, 0x55,
+	0x35, 0xc7, 0x9f, 0x05, 0x1a, 0x15, 0xfb, 0x1e, 0x72, 0x33, 0x73, 0xd0, 0x15, 0x58, 0xd7, 0xda,
+	0x2d, 0xe3, 0xe2, 0x1d, 0x37, 0x00, 0x78, 0xa2, 0x73, 0xd0, 0x68, 0xea, 0x2d, 0x25, 0x8e, 0x14,
+	0xc8, 0xf3, 0xb8, 0xd1, 0x6c, 0xea, 0x9d, 0xae, 0xde, 0x52, 0x12, 0xd3, 0xcc, 0xdc, 0x3b, 0x5f,
+	0x85, 0x82, 0xc8, 0x08, 0x47, 0x0e, 0xf8, 0x9b, 0x4f, 0x49, 0x58, 0xdf, 0xff, 0xf2, 0xb0, 0xa5,
+	0xb7, 0x94, 0xb4, 0xfc, 0xe7, 0x9a, 0xf1, 0xfa, 0x6d, 0x39, 0xfe, 0xe6, 0x6d, 0x39, 0xfe, 0xdb,
+	0xdb, 0x72, 0xfc, 0xc7, 0x77, 0xe5, 0xd8, 0x9b, 0x77, 0xe5, 0xd8, 0xaf, 0xef, 0xca, 0xb1, 0xe7,
+	0xfa, 0x89, 0xcd, 0xfa, 0xa3, 0x5e, 0xcd, 0xf4, 0xdd, 0x3a, 0x5f, 0x9f, 0xe2, 0xcb, 0xd7, 0xf4,
+	0x9d, 0xba, 0xdd, 0x33, 0xe5, 0x47, 0xf1, 0xbf, 0xeb, 0xae, 0x6f, 0x8d, 0x1c, 0x1a, 0xf2, 0x0f,
+	0xe7, 0xb0, 0xbe, 0x77, 0xe7, 0xce, 0xae, 0x6c, 0xfa, 0xae, 0xc0, 0xd9, 0xf9, 0x90, 0x86, 0xbd,
+	0x8c, 0xd0, 0xfd, 0xf3, 0xf7, 0x01, 0x00, 0x48, 0x4c, 0x53, 0x92, 0x0e, 0x0c, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -516,6 +861,33 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PruneBatchSize != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.PruneBatchSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxTerminalOrders != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.MaxTerminalOrders))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OrderRetentionPeriod != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.OrderRetentionPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FeeCollector) > 0 {
+		i -= len(m.FeeCollector)
+		copy(dAtA[i:], m.FeeCollector)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.FeeCollector)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FeeRate != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.MaxFeeRate != 0 {
 		i = encodeVarintSwap(dAtA, i, uint64(m.MaxFeeRate))
 		i--
@@ -676,6 +1048,43 @@ func (m *SwapTaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HashTimeLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HashTimeLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HashTimeLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Preimage) > 0 {
+		i -= len(m.Preimage)
+		copy(dAtA[i:], m.Preimage)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Preimage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HashLock) > 0 {
+		i -= len(m.HashLock)
+		copy(dAtA[i:], m.HashLock)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.HashLock)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Order) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -696,6 +1105,54 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClosedAt != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.ClosedAt))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.AckError != nil {
+		{
+			size, err := m.AckError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSwap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Htlc != nil {
+		{
+			size, err := m.Htlc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSwap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Kind != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.CompleteTimestamp != 0 {
 		i = encodeVarintSwap(dAtA, i, uint64(m.CompleteTimestamp))
 		i--
@@ -757,29 +1214,109 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSwap(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Bid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Bid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SwapEnabled {
-		n += 2
+	if m.CreateTimestamp != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.CreateTimestamp))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.MaxFeeRate != 0 {
+	if m.Status != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.BidderReceivingAddress) > 0 {
+		i -= len(m.BidderReceivingAddress)
+		copy(dAtA[i:], m.BidderReceivingAddress)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.BidderReceivingAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SwapEnabled {
+		n += 2
+	}
+	if m.MaxFeeRate != 0 {
 		n += 1 + sovSwap(uint64(m.MaxFeeRate))
 	}
+	if m.FeeRate != 0 {
+		n += 1 + sovSwap(uint64(m.FeeRate))
+	}
+	l = len(m.FeeCollector)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.OrderRetentionPeriod != 0 {
+		n += 1 + sovSwap(uint64(m.OrderRetentionPeriod))
+	}
+	if m.MaxTerminalOrders != 0 {
+		n += 1 + sovSwap(uint64(m.MaxTerminalOrders))
+	}
+	if m.PruneBatchSize != 0 {
+		n += 1 + sovSwap(uint64(m.PruneBatchSize))
+	}
 	return n
 }
 
@@ -845,6 +1382,23 @@ func (m *SwapTaker) Size() (n int) {
 	return n
 }
 
+func (m *HashTimeLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HashLock)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.Preimage)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	return n
+}
+
 func (m *Order) Size() (n int) {
 	if m == nil {
 		return 0
@@ -879,6 +1433,55 @@ func (m *Order) Size() (n int) {
 	if m.CompleteTimestamp != 0 {
 		n += 1 + sovSwap(uint64(m.CompleteTimestamp))
 	}
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if m.Kind != 0 {
+		n += 1 + sovSwap(uint64(m.Kind))
+	}
+	if m.Htlc != nil {
+		l = m.Htlc.Size()
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.AckError != nil {
+		l = m.AckError.Size()
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if m.ClosedAt != 0 {
+		n += 1 + sovSwap(uint64(m.ClosedAt))
+	}
+	return n
+}
+
+func (m *Bid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.BidderReceivingAddress)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovSwap(uint64(m.Status))
+	}
+	if m.CreateTimestamp != 0 {
+		n += 1 + sovSwap(uint64(m.CreateTimestamp))
+	}
 	return n
 }
 
@@ -956,6 +1559,114 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			m.FeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRate |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderRetentionPeriod", wireType)
+			}
+			m.OrderRetentionPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderRetentionPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTerminalOrders", wireType)
+			}
+			m.MaxTerminalOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTerminalOrders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneBatchSize", wireType)
+			}
+			m.PruneBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruneBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwap(dAtA[iNdEx:])
@@ -1470,7 +2181,7 @@ func (m *SwapTaker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Order) Unmarshal(dAtA []byte) error {
+func (m *HashTimeLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1493,17 +2204,17 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: HashTimeLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HashTimeLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HashLock", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1513,48 +2224,31 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.HashLock = append(m.HashLock[:0], dAtA[iNdEx:postIndex]...)
+			if m.HashLock == nil {
+				m.HashLock = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
-			}
-			m.Side = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSwap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Side |= Side(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preimage", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSwap
@@ -1564,40 +2258,175 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSwap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSwap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Maker == nil {
-				m.Maker = &MakeSwapMsg{}
+			m.Preimage = append(m.Preimage[:0], dAtA[iNdEx:postIndex]...)
+			if m.Preimage == nil {
+				m.Preimage = []byte{}
 			}
-			if err := m.Maker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSwap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Maker == nil {
+				m.Maker = &MakeSwapMsg{}
+			}
+			if err := m.Maker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
 				b := dAtA[iNdEx]
 				iNdEx++
 				m.Status |= Status(b&0x7F) << shift
@@ -1711,6 +2540,367 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fills = append(m.Fills, &TakeSwapMsg{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= OrderKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Htlc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Htlc == nil {
+				m.Htlc = &HashTimeLock{}
+			}
+			if err := m.Htlc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AckError == nil {
+				m.AckError = &ErrorAcknowledgement{}
+			}
+			if err := m.AckError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+			}
+			m.ClosedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidderReceivingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidderReceivingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BidStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTimestamp", wireType)
+			}
+			m.CreateTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwap(dAtA[iNdEx:])
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// ChannelListValue returns the value of the swap list entries matching a channel.
+func ChannelListValue(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", portID, channelID)
+}
+
+// ParseChannelListValue returns the port and channel identifiers of a port_id/channel_id value.
+func ParseChannelListValue(value string) (string, string, error) {
+	parts := strings.Split(value, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("channel %s is not formatted as port_id/channel_id", value)
+	}
+	if err := host.PortIdentifierValidator(parts[0]); err != nil {
+		return "", "", err
+	}
+	if err := host.ChannelIdentifierValidator(parts[1]); err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
+
+// NewListEntry creates a new ListEntry instance
+func NewListEntry(list SwapList, kind ListEntryKind, value string) ListEntry {
+	return ListEntry{
+		List:  list,
+		Kind:  kind,
+		Value: value,
+	}
+}
+
+// Validate performs a basic check of the list entry fields.
+func (e ListEntry) Validate() error {
+	if _, ok := SwapList_name[int32(e.List)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidListEntry, "unknown list %d", e.List)
+	}
+	switch e.Kind {
+	case DenomEntry:
+		if err := sdk.ValidateDenom(e.Value); err != nil {
+			return sdkerrors.Wrap(ErrInvalidListEntry, err.Error())
+		}
+	case ChannelEntry:
+		if _, _, err := ParseChannelListValue(e.Value); err != nil {
+			return sdkerrors.Wrap(ErrInvalidListEntry, err.Error())
+		}
+	case ChainIdEntry:
+		if strings.TrimSpace(e.Value) == "" || strings.ContainsAny(e.Value, " \t\n") {
+			return sdkerrors.Wrapf(ErrInvalidListEntry, "invalid chain id %q", e.Value)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidListEntry, "unknown entry kind %d", e.Kind)
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
+)
+
+func TestOrderPartialFill(t *testing.T) {
+	order := types.Order{
+		Maker: &types.MakeSwapMsg{
+			SellToken:        sdk.NewCoin("atom", sdk.NewInt(100)),
+			BuyToken:         sdk.NewCoin("osmo", sdk.NewInt(300)),
+			AllowPartialFill: true,
+			MinFillAmount:    sdk.NewInt(50),
+		},
+	}
+
+	// below the minimum fill amount
+	require.ErrorIs(t, order.ValidateFill(sdk.NewCoin("osmo", sdk.NewInt(49)), 0), types.ErrOrderInsufficientAmount)
+	// wrong denom
+	require.ErrorIs(t, order.ValidateFill(sdk.NewCoin("atom", sdk.NewInt(100)), 0), types.ErrOrderDenominationMismatched)
+	// above the remaining amount
+	require.ErrorIs(t, order.ValidateFill(sdk.NewCoin("osmo", sdk.NewInt(301)), 0), types.ErrInvalidSellToken)
+
+	fills := []int64{100, 100, 50, 50}
+	expReleased := []int64{33, 33, 17, 17}
+	for i, amount := range fills {
+		take := sdk.NewCoin("osmo", sdk.NewInt(amount))
+		require.NoError(t, order.ValidateFill(take, 0))
+		require.Equal(t, sdk.NewCoin("atom", sdk.NewInt(expReleased[i])), order.FillSellToken(take.Amount))
+		order.Fills = append(order.Fills, &types.TakeSwapMsg{SellToken: take})
+	}
+
+	// the rounding does not leave any dust once the order is filled
+	require.True(t, order.IsFilled())
+	require.True(t, order.RemainingSellToken().IsZero())
+}
+
+func TestOrderRemainingSellToken(t *testing.T) {
+	order := types.Order{
+		Maker: &types.MakeSwapMsg{
+			SellToken:        sdk.NewCoin("atom", sdk.NewInt(1000)),
+			BuyToken:         sdk.NewCoin("osmo", sdk.NewInt(10)),
+			AllowPartialFill: true,
+		},
+	}
+	require.Equal(t, order.Maker.SellToken, order.RemainingSellToken())
+
+	order.Fills = append(order.Fills, &types.TakeSwapMsg{SellToken: sdk.NewCoin("osmo", sdk.NewInt(4))})
+	require.False(t, order.IsFilled())
+	require.Equal(t, sdk.NewInt(6), order.RemainingBuyAmount())
+	require.Equal(t, sdk.NewCoin("atom", sdk.NewInt(600)), order.RemainingSellToken())
+
+	// exact takes are still required when partial fills are disabled
+	order.Maker.AllowPartialFill = false
+	require.ErrorIs(t, order.ValidateFill(sdk.NewCoin("osmo", sdk.NewInt(4)), 0), types.ErrInvalidSellToken)
+}
+
+func TestOrderPriceSchedule(t *testing.T) {
+	schedule := &types.PriceSchedule{
+		StartBuyAmount: sdk.NewInt(1000),
+		FloorBuyAmount: sdk.NewInt(400),
+		DecayWindow:    100,
+	}
+	require.NoError(t, schedule.Validate(sdk.NewInt(1000)))
+	require.ErrorIs(t, schedule.Validate(sdk.NewInt(999)), types.ErrInvalidPriceSchedule)
+
+	order := types.Order{
+		Maker: &types.MakeSwapMsg{
+			SellToken:       sdk.NewCoin("atom", sdk.NewInt(100)),
+			BuyToken:        sdk.NewCoin("osmo", sdk.NewInt(1000)),
+			CreateTimestamp: 1000,
+			PriceSchedule:   schedule,
+		},
+	}
+
+	// the price decays linearly from the start to the floor buy amount, rounded up
+	require.Equal(t, sdk.NewCoin("osmo", sdk.NewInt(1000)), order.Maker.CurrentBuyToken(900))
+	require.Equal(t, sdk.NewCoin("osmo", sdk.NewInt(700)), order.Maker.CurrentBuyToken(1050))
+	require.Equal(t, sdk.NewCoin("osmo", sdk.NewInt(994)), order.Maker.CurrentBuyToken(1001))
+	require.Equal(t, sdk.NewCoin("osmo", sdk.NewInt(400)), order.Maker.CurrentBuyToken(1200))
+
+	// a take pays at least the current price
+	require.ErrorIs(t, order.ValidateFill(sdk.NewCoin("osmo", sdk.NewInt(699)), 1050), types.ErrOrderInsufficientAmount)
+	require.NoError(t, order.ValidateFill(sdk.NewCoin("osmo", sdk.NewInt(800)), 1050))
+
+	// the whole sell token is released to the taker whatever the price paid
+	require.Equal(t, order.Maker.SellToken, order.FillSellToken(sdk.NewInt(700)))
+	order.Fills = append(order.Fills, &types.TakeSwapMsg{SellToken: sdk.NewCoin("osmo", sdk.NewInt(700))})
+	require.True(t, order.IsFilled())
+	require.True(t, order.RemainingSellToken().IsZero())
+}
+
+func TestBasketOrder(t *testing.T) {
+	maker := sdk.AccAddress("maker").String()
+	sellTokens := sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100)), sdk.NewCoin("stake", sdk.NewInt(50)))
+	buyTokens := sdk.NewCoins(sdk.NewCoin("osmo", sdk.NewInt(300)), sdk.NewCoin("usdc", sdk.NewInt(20)))
+	msg := &types.MakeSwapMsg{
+		SourcePort:            "swap",
+		SourceChannel:         "channel-0",
+		SellTokens:            sellTokens,
+		BuyTokens:             buyTokens,
+		MakerAddress:          maker,
+		MakerReceivingAddress: maker,
+	}
+	require.NoError(t, msg.ValidateBasic())
+	require.True(t, msg.IsBasket())
+	require.Equal(t, sellTokens, msg.SellCoins())
+	require.Equal(t, buyTokens, msg.BuyCoins())
+
+	// basket orders are filled at once
+	msg.AllowPartialFill = true
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidBasket)
+	msg.AllowPartialFill = false
+	msg.PriceSchedule = &types.PriceSchedule{StartBuyAmount: sdk.NewInt(300), FloorBuyAmount: sdk.NewInt(100), DecayWindow: 10}
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidBasket)
+	msg.PriceSchedule = nil
+	// the single tokens are left empty
+	msg.SellToken = sdk.NewCoin("atom", sdk.NewInt(1))
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidBasket)
+	msg.SellToken = sdk.Coin{}
+	msg.BuyTokens = nil
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidBasket)
+	msg.BuyTokens = buyTokens
+
+	order := types.Order{Maker: msg}
+	require.Equal(t, sellTokens, order.RemainingSellTokens())
+	require.ErrorIs(t, order.ValidateBasketFill(sdk.NewCoins(buyTokens[0])), types.ErrInvalidSellToken)
+	require.ErrorIs(t, order.ValidateBasketFill(buyTokens.Add(sdk.NewCoin("osmo", sdk.NewInt(1)))), types.ErrInvalidSellToken)
+	require.NoError(t, order.ValidateBasketFill(buyTokens))
+
+	order.Fills = append(order.Fills, &types.TakeSwapMsg{SellTokens: buyTokens})
+	require.True(t, order.IsFilled())
+	require.True(t, order.RemainingSellTokens().IsZero())
+}
+
+func TestSignedOrder(t *testing.T) {
+	maker := sdk.AccAddress("maker").String()
+	order := types.SignedOrder{
+		ChainId:               "chain",
+		SourcePort:            "swap",
+		SourceChannel:         "channel-0",
+		MakerAddress:          maker,
+		MakerReceivingAddress: maker,
+		SellTokens:            sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100))),
+		BuyTokens:             sdk.NewCoins(sdk.NewCoin("osmo", sdk.NewInt(300))),
+		Nonce:                 1,
+		ExpirationTimestamp:   1000,
+	}
+	require.NoError(t, order.ValidateBasic())
+
+	msg := order.MakeSwapMsg(clienttypes.ZeroHeight(), 0, 10)
+	require.False(t, msg.IsBasket())
+	require.Equal(t, order.SellTokens[0], msg.SellToken)
+	require.Equal(t, order.BuyTokens[0], msg.BuyToken)
+	require.Equal(t, order.ExpirationTimestamp, msg.ExpirationTimestamp)
+
+	// several tokens on a side open a basket order
+	order.BuyTokens = order.BuyTokens.Add(sdk.NewCoin("usdc", sdk.NewInt(20)))
+	require.True(t, order.MakeSwapMsg(clienttypes.ZeroHeight(), 0, 10).IsBasket())
+
+	// the sign bytes change with the nonce
+	signBytes := order.GetSignBytes()
+	order.Nonce = 2
+	require.NotEqual(t, signBytes, order.GetSignBytes())
+
+	order.ExpirationTimestamp = 0
+	require.ErrorIs(t, order.ValidateBasic(), types.ErrInvalidSignature)
+}
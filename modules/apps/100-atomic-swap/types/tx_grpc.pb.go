@@ -21,6 +21,20 @@ type MsgClient interface {
 	MakeSwap(ctx context.Context, in *MakeSwapMsg, opts ...grpc.CallOption) (*MsgMakeSwapResponse, error)
 	TakeSwap(ctx context.Context, in *TakeSwapMsg, opts ...grpc.CallOption) (*MsgTakeSwapResponse, error)
 	CancelSwap(ctx context.Context, in *CancelSwapMsg, opts ...grpc.CallOption) (*MsgCancelSwapResponse, error)
+	MakeBid(ctx context.Context, in *MakeBidMsg, opts ...grpc.CallOption) (*MsgMakeBidResponse, error)
+	AcceptBid(ctx context.Context, in *AcceptBidMsg, opts ...grpc.CallOption) (*MsgAcceptBidResponse, error)
+	CancelBid(ctx context.Context, in *CancelBidMsg, opts ...grpc.CallOption) (*MsgCancelBidResponse, error)
+	LockHTLC(ctx context.Context, in *LockHTLCMsg, opts ...grpc.CallOption) (*MsgLockHTLCResponse, error)
+	ClaimHTLC(ctx context.Context, in *ClaimHTLCMsg, opts ...grpc.CallOption) (*MsgClaimHTLCResponse, error)
+	RefundHTLC(ctx context.Context, in *RefundHTLCMsg, opts ...grpc.CallOption) (*MsgRefundHTLCResponse, error)
+	CancelChannelOrders(ctx context.Context, in *CancelChannelOrdersMsg, opts ...grpc.CallOption) (*MsgCancelChannelOrdersResponse, error)
+	FillSignedOrder(ctx context.Context, in *FillSignedOrderMsg, opts ...grpc.CallOption) (*MsgFillSignedOrderResponse, error)
+	// UpdateSwapLists adds and removes entries of the swap allowlist and denylist, it is executed by governance.
+	UpdateSwapLists(ctx context.Context, in *UpdateSwapListsMsg, opts ...grpc.CallOption) (*MsgUpdateSwapListsResponse, error)
+	// SetRateLimits sets the quotas of the escrow outflows, it is executed by governance.
+	SetRateLimits(ctx context.Context, in *SetRateLimitsMsg, opts ...grpc.CallOption) (*MsgSetRateLimitsResponse, error)
+	// UnpauseChannel resumes the escrow outflows of a channel paused by its rate limits, it is executed by governance.
+	UnpauseChannel(ctx context.Context, in *UnpauseChannelMsg, opts ...grpc.CallOption) (*MsgUnpauseChannelResponse, error)
 }
 
 type msgClient struct {
@@ -58,6 +72,105 @@ func (c *msgClient) CancelSwap(ctx context.Context, in *CancelSwapMsg, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) MakeBid(ctx context.Context, in *MakeBidMsg, opts ...grpc.CallOption) (*MsgMakeBidResponse, error) {
+	out := new(MsgMakeBidResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Msg/MakeBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptBid(ctx context.Context, in *AcceptBidMsg, opts ...grpc.CallOption) (*MsgAcceptBidResponse, error) {
+	out := new(MsgAcceptBidResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Msg/AcceptBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelBid(ctx context.Context, in *CancelBidMsg, opts ...grpc.CallOption) (*MsgCancelBidResponse, error) {
+	out := new(MsgCancelBidResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Msg/CancelBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LockHTLC(ctx context.Context, in *LockHTLCMsg, opts ...grpc.CallOption) (*MsgLockHTLCResponse, error) {
+	out := new(MsgLockHTLCResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Msg/LockHTLC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimHTLC(ctx context.Context, in *ClaimHTLCMsg, opts ...grpc.CallOption) (*MsgClaimHTLCResponse, error) {
+	out := new(MsgClaimHTLCResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Msg/ClaimHTLC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundHTLC(ctx context.Context, in *RefundHTLCMsg, opts ...grpc.CallOption) (*MsgRefundHTLCResponse, error) {
+	out := new(MsgRefundHTLCResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Msg/RefundHTLC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelChannelOrders(ctx context.Context, in *CancelChannelOrdersMsg, opts ...grpc.CallOption) (*MsgCancelChannelOrdersResponse, error) {
+	out := new(MsgCancelChannelOrdersResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Msg/CancelChannelOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FillSignedOrder(ctx context.Context, in *FillSignedOrderMsg, opts ...grpc.CallOption) (*MsgFillSignedOrderResponse, error) {
+	out := new(MsgFillSignedOrderResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Msg/FillSignedOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSwapLists(ctx context.Context, in *UpdateSwapListsMsg, opts ...grpc.CallOption) (*MsgUpdateSwapListsResponse, error) {
+	out := new(MsgUpdateSwapListsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Msg/UpdateSwapLists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRateLimits(ctx context.Context, in *SetRateLimitsMsg, opts ...grpc.CallOption) (*MsgSetRateLimitsResponse, error) {
+	out := new(MsgSetRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Msg/SetRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseChannel(ctx context.Context, in *UnpauseChannelMsg, opts ...grpc.CallOption) (*MsgUnpauseChannelResponse, error) {
+	out := new(MsgUnpauseChannelResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.atomic_swap.v1.Msg/UnpauseChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations should embed UnimplementedMsgServer
 // for forward compatibility
@@ -65,6 +178,20 @@ type MsgServer interface {
 	MakeSwap(context.Context, *MakeSwapMsg) (*MsgMakeSwapResponse, error)
 	TakeSwap(context.Context, *TakeSwapMsg) (*MsgTakeSwapResponse, error)
 	CancelSwap(context.Context, *CancelSwapMsg) (*MsgCancelSwapResponse, error)
+	MakeBid(context.Context, *MakeBidMsg) (*MsgMakeBidResponse, error)
+	AcceptBid(context.Context, *AcceptBidMsg) (*MsgAcceptBidResponse, error)
+	CancelBid(context.Context, *CancelBidMsg) (*MsgCancelBidResponse, error)
+	LockHTLC(context.Context, *LockHTLCMsg) (*MsgLockHTLCResponse, error)
+	ClaimHTLC(context.Context, *ClaimHTLCMsg) (*MsgClaimHTLCResponse, error)
+	RefundHTLC(context.Context, *RefundHTLCMsg) (*MsgRefundHTLCResponse, error)
+	CancelChannelOrders(context.Context, *CancelChannelOrdersMsg) (*MsgCancelChannelOrdersResponse, error)
+	FillSignedOrder(context.Context, *FillSignedOrderMsg) (*MsgFillSignedOrderResponse, error)
+	// UpdateSwapLists adds and removes entries of the swap allowlist and denylist, it is executed by governance.
+	UpdateSwapLists(context.Context, *UpdateSwapListsMsg) (*MsgUpdateSwapListsResponse, error)
+	// SetRateLimits sets the quotas of the escrow outflows, it is executed by governance.
+	SetRateLimits(context.Context, *SetRateLimitsMsg) (*MsgSetRateLimitsResponse, error)
+	// UnpauseChannel resumes the escrow outflows of a channel paused by its rate limits, it is executed by governance.
+	UnpauseChannel(context.Context, *UnpauseChannelMsg) (*MsgUnpauseChannelResponse, error)
 }
 
 // UnimplementedMsgServer should be embedded to have forward compatible implementations.
@@ -80,6 +207,39 @@ func (UnimplementedMsgServer) TakeSwap(context.Context, *TakeSwapMsg) (*MsgTakeS
 func (UnimplementedMsgServer) CancelSwap(context.Context, *CancelSwapMsg) (*MsgCancelSwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSwap not implemented")
 }
+func (UnimplementedMsgServer) MakeBid(context.Context, *MakeBidMsg) (*MsgMakeBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeBid not implemented")
+}
+func (UnimplementedMsgServer) AcceptBid(context.Context, *AcceptBidMsg) (*MsgAcceptBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptBid not implemented")
+}
+func (UnimplementedMsgServer) CancelBid(context.Context, *CancelBidMsg) (*MsgCancelBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBid not implemented")
+}
+func (UnimplementedMsgServer) LockHTLC(context.Context, *LockHTLCMsg) (*MsgLockHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockHTLC not implemented")
+}
+func (UnimplementedMsgServer) ClaimHTLC(context.Context, *ClaimHTLCMsg) (*MsgClaimHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimHTLC not implemented")
+}
+func (UnimplementedMsgServer) RefundHTLC(context.Context, *RefundHTLCMsg) (*MsgRefundHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundHTLC not implemented")
+}
+func (UnimplementedMsgServer) CancelChannelOrders(context.Context, *CancelChannelOrdersMsg) (*MsgCancelChannelOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelChannelOrders not implemented")
+}
+func (UnimplementedMsgServer) FillSignedOrder(context.Context, *FillSignedOrderMsg) (*MsgFillSignedOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FillSignedOrder not implemented")
+}
+func (UnimplementedMsgServer) UpdateSwapLists(context.Context, *UpdateSwapListsMsg) (*MsgUpdateSwapListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSwapLists not implemented")
+}
+func (UnimplementedMsgServer) SetRateLimits(context.Context, *SetRateLimitsMsg) (*MsgSetRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimits not implemented")
+}
+func (UnimplementedMsgServer) UnpauseChannel(context.Context, *UnpauseChannelMsg) (*MsgUnpauseChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseChannel not implemented")
+}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to MsgServer will
@@ -146,6 +306,204 @@ func _Msg_CancelSwap_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MakeBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MakeBidMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MakeBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Msg/MakeBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MakeBid(ctx, req.(*MakeBidMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptBidMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Msg/AcceptBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptBid(ctx, req.(*AcceptBidMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBidMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Msg/CancelBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBid(ctx, req.(*CancelBidMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockHTLCMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Msg/LockHTLC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockHTLC(ctx, req.(*LockHTLCMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimHTLCMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Msg/ClaimHTLC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimHTLC(ctx, req.(*ClaimHTLCMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundHTLCMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Msg/RefundHTLC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundHTLC(ctx, req.(*RefundHTLCMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelChannelOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelChannelOrdersMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelChannelOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Msg/CancelChannelOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelChannelOrders(ctx, req.(*CancelChannelOrdersMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FillSignedOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FillSignedOrderMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FillSignedOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Msg/FillSignedOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FillSignedOrder(ctx, req.(*FillSignedOrderMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSwapLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSwapListsMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSwapLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Msg/UpdateSwapLists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSwapLists(ctx, req.(*UpdateSwapListsMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRateLimitsMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Msg/SetRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimits(ctx, req.(*SetRateLimitsMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseChannelMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.atomic_swap.v1.Msg/UnpauseChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseChannel(ctx, req.(*UnpauseChannelMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -165,6 +523,50 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSwap",
 			Handler:    _Msg_CancelSwap_Handler,
 		},
+		{
+			MethodName: "MakeBid",
+			Handler:    _Msg_MakeBid_Handler,
+		},
+		{
+			MethodName: "AcceptBid",
+			Handler:    _Msg_AcceptBid_Handler,
+		},
+		{
+			MethodName: "CancelBid",
+			Handler:    _Msg_CancelBid_Handler,
+		},
+		{
+			MethodName: "LockHTLC",
+			Handler:    _Msg_LockHTLC_Handler,
+		},
+		{
+			MethodName: "ClaimHTLC",
+			Handler:    _Msg_ClaimHTLC_Handler,
+		},
+		{
+			MethodName: "RefundHTLC",
+			Handler:    _Msg_RefundHTLC_Handler,
+		},
+		{
+			MethodName: "CancelChannelOrders",
+			Handler:    _Msg_CancelChannelOrders_Handler,
+		},
+		{
+			MethodName: "FillSignedOrder",
+			Handler:    _Msg_FillSignedOrder_Handler,
+		},
+		{
+			MethodName: "UpdateSwapLists",
+			Handler:    _Msg_UpdateSwapLists_Handler,
+		},
+		{
+			MethodName: "SetRateLimits",
+			Handler:    _Msg_SetRateLimits_Handler,
+		},
+		{
+			MethodName: "UnpauseChannel",
+			Handler:    _Msg_UnpauseChannel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/atomic_swap/v1/tx.proto",
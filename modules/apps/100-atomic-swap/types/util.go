@@ -5,7 +5,10 @@ import (
 	"encoding/base64"
 )
 
-// generateRandomString generates a random string of the length n.
+// GenerateRandomString generates a random string of the length n.
+//
+// Deprecated: the order ids are derived from the sequence of the make swap packet, a random
+// path id is not computed the same by every validator.
 func GenerateRandomString(chainID string, n int) string {
 	b := make([]byte, n)
 	_, err := rand.Read(b)
@@ -25,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdShowInterchainLiquidityPool())
 	cmd.AddCommand(CmdListInterchainMarketMaker())
 	cmd.AddCommand(CmdShowInterchainMarketMaker())
+	cmd.AddCommand(CmdQuote())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
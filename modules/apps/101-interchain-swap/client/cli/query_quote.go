@@ -0,0 +1,159 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/101-interchain-swap/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdQuote groups the quotes of the swaps, deposits and withdrawals of a pool.
+func CmdQuote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "quote",
+		Short:                      "Quote the swaps, deposits and withdrawals of a pool",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQuoteSwap())
+	cmd.AddCommand(CmdQuoteSwapExactOut())
+	cmd.AddCommand(CmdQuoteSingleDeposit())
+	cmd.AddCommand(CmdQuoteWithdraw())
+
+	return cmd
+}
+
+func CmdQuoteSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap [pool-id] [token-in] [denom-out]",
+		Short: "quotes the output of a swap of token-in, its fee, price impact and spot price after the swap",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateSwap(context.Background(), &types.QueryEstimateSwapRequest{
+				PoolId:   args[0],
+				TokenIn:  tokenIn,
+				DenomOut: args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuoteSwapExactOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-out [pool-id] [token-out] [denom-in]",
+		Short: "quotes the input of a swap for token-out, its fee, price impact and spot price after the swap",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			tokenOut, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateSwapExactOut(context.Background(), &types.QueryEstimateSwapExactOutRequest{
+				PoolId:   args[0],
+				TokenOut: tokenOut,
+				DenomIn:  args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuoteSingleDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "single-deposit [pool-id] [token]",
+		Short: "quotes the pool tokens issued for a single asset deposit",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			token, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateSingleDeposit(context.Background(), &types.QueryEstimateSingleDepositRequest{
+				PoolId: args[0],
+				Token:  token,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuoteWithdraw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw [pool-token]",
+		Short: "quotes the assets redeemed by pool tokens, the denom of the pool tokens is the pool id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			poolToken, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateWithdraw(context.Background(), &types.QueryEstimateWithdrawRequest{
+				PoolId:    poolToken.Denom,
+				PoolToken: poolToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
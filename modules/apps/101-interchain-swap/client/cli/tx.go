@@ -16,6 +16,8 @@ var (
 
 const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagPoolType               = "pool-type"
+	flagAmplification          = "amplification"
 	listSeparator              = ","
 )
 
@@ -18,7 +18,7 @@ func CmdMakeMultiAssetDeposit() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "make_multi_asset_deposit [pool-id] [local sender] [remote sender] [pool-tokens(1000aside,1000bside)] [port] [channel]",
 		Short: "Broadcast message MakeMultiAssetDeposit",
-		Args:  cobra.ExactArgs(5),
+		Args:  cobra.ExactArgs(6),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argPoolId := args[0]
 			argLocalSender := args[1]
@@ -36,10 +36,30 @@ func CmdMakeMultiAssetDeposit() *cobra.Command {
 				return err
 			}
 
+			pool, err := QueryPool(clientCtx, argPoolId)
+			if err != nil {
+				return err
+			}
+
+			// the local sender deposits the assets of this chain first, the remote sender the others
+			coins := []*sdk.Coin{}
+			for index := range tokens {
+				coins = append(coins, &tokens[index])
+			}
+			localTokens := pool.FilterCoinsBySide(coins, types.PoolAssetSide_SOURCE)
+			remoteTokens := pool.FilterCoinsBySide(coins, types.PoolAssetSide_DESTINATION)
+			senders := []string{}
+			for range localTokens {
+				senders = append(senders, argLocalSender)
+			}
+			for range remoteTokens {
+				senders = append(senders, argRemoteSender)
+			}
+
 			msg := types.NewMsgMakeMultiAssetDeposit(
 				argPoolId,
-				[]string{argLocalSender, argRemoteSender},
-				tokens,
+				senders,
+				append(localTokens, remoteTokens...),
 				argPort,
 				argChannel,
 			)
@@ -47,11 +67,6 @@ func CmdMakeMultiAssetDeposit() *cobra.Command {
 			packetTimeoutHeight, err1 := cmd.Flags().GetString("packet-timeout-height")
 			packetTimeoutTimestamp, err2 := cmd.Flags().GetUint("packet-timeout-timestamp")
 
-			pool, err := QueryPool(clientCtx, argPoolId)
-			if err != nil {
-				return err
-			}
-
 			if err1 == nil && err2 == nil {
 				timeoutHeight, timeoutTimestamp, err := GetTimeOuts(clientCtx, pool.CounterPartyPort, pool.CounterPartyChannel, packetTimeoutHeight, uint64(packetTimeoutTimestamp), false)
 				fmt.Println("Timeout Height:", timeoutHeight)
@@ -15,9 +15,15 @@ import (
 
 func CmdMakePool() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "make-pool [creator] [counterPartyCreator] [weight] [tokens] [decimals] [swap-fee] [channel]",
+		Use:   "make-pool [creator] [counterPartyCreator] [weights] [tokens] [decimals] [swap-fee] [channel]",
 		Short: "Broadcast message MakePool",
-		Args:  cobra.ExactArgs(7),
+		Long: `Broadcast message MakePool. The weights, tokens and decimals are comma separated lists of 2 to 8 pool
+assets in the same order, for example 20,30,50 1000aside,1000bside,1000cside 6,6,6. The tokens of this
+chain are escrowed, the counterparty creator contributes the others when taking the pool.
+
+A stable pool, --pool-type stable, prices its assets with the StableSwap invariant of the amplification
+--amplification. The weights of a stable pool only split the initial pool tokens between the creators.`,
+		Args: cobra.ExactArgs(7),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -29,7 +35,7 @@ func CmdMakePool() *cobra.Command {
 				return err
 			}
 
-			tokens, err := sdk.ParseCoinsNormalized(args[3])
+			tokens, err := parseTokens(args[3])
 			if err != nil {
 				return err
 			}
@@ -48,25 +54,44 @@ func CmdMakePool() *cobra.Command {
 				return fmt.Errorf("invalid swap value. swapFee has to be in between 0 and 10000")
 			}
 
-			msg := types.NewMsgMakePool(
-				args[6], // argSourcePort
-				args[7], // argSourceChannel
-				args[0], // argSender
-				args[1], // argCounterPartySender
-				types.PoolAsset{
-					Side:    types.PoolAssetSide_SOURCE,
-					Balance: &tokens[0],
-					Weight:  weights[0],
-					Decimal: decimals[0],
-				},
-				types.PoolAsset{
-					Side:    types.PoolAssetSide_DESTINATION,
-					Balance: &tokens[1],
-					Weight:  weights[1],
-					Decimal: decimals[1],
-				},
-				uint32(swapFee),
-			)
+			if len(tokens) != len(weights) || len(decimals) != len(weights) {
+				return fmt.Errorf("the weights, tokens and decimals have to have the same length")
+			}
+
+			// the sides of the assets are set by the chain
+			liquidity := []*types.PoolAsset{}
+			for index := range tokens {
+				liquidity = append(liquidity, &types.PoolAsset{
+					Balance: &tokens[index],
+					Weight:  weights[index],
+					Decimal: decimals[index],
+				})
+			}
+
+			poolTypeStr, err := cmd.Flags().GetString(flagPoolType)
+			if err != nil {
+				return err
+			}
+			poolType, found := types.PoolType_value[strings.ToUpper(poolTypeStr)]
+			if !found {
+				return fmt.Errorf("invalid pool type %s", poolTypeStr)
+			}
+
+			amplification, err := cmd.Flags().GetUint64(flagAmplification)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgMakePoolRequest{
+				SourcePort:          types.PortID,
+				SourceChannel:       args[6],
+				Creator:             args[0],
+				CounterPartyCreator: args[1],
+				Liquidity:           liquidity,
+				SwapFee:             uint32(swapFee),
+				PoolType:            types.PoolType(poolType),
+				Amplification:       amplification,
+			}
 
 			packetTimeoutHeight, err1 := cmd.Flags().GetString("packet-timeout-height")
 			packetTimeoutTimestamp, err2 := cmd.Flags().GetUint("packet-timeout-timestamp")
@@ -88,6 +113,8 @@ func CmdMakePool() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String("packet-timeout-height", "", "Packet timeout height")
 	cmd.Flags().Uint("packet-timeout-timestamp", 0, "Packet timeout timestamp (in nanoseconds)")
+	cmd.Flags().String(flagPoolType, "weighted", "Pool type, weighted or stable")
+	cmd.Flags().Uint64(flagAmplification, 0, "Amplification of a stable pool")
 
 	return cmd
 }
@@ -104,7 +131,7 @@ func parseDecimals(decimalsStr string) ([]uint32, error) {
 		decimals = append(decimals, uint32(decimal))
 	}
 
-	if len(decimals) != 2 {
+	if len(decimals) < types.MinPoolAssets || len(decimals) > types.MaxPoolAssets {
 		return nil, fmt.Errorf("invalid decimals length %v", decimals)
 	}
 
@@ -113,7 +140,7 @@ func parseDecimals(decimalsStr string) ([]uint32, error) {
 
 func parseWeights(weightsStr string) ([]uint32, error) {
 	weights := strings.Split(weightsStr, ",")
-	if len(weights) != 2 {
+	if len(weights) < types.MinPoolAssets || len(weights) > types.MaxPoolAssets {
 		return nil, fmt.Errorf("invalid weights length %v", weights)
 	}
 
@@ -133,3 +160,16 @@ func parseWeights(weightsStr string) ([]uint32, error) {
 	}
 	return weightsAsInt, nil
 }
+
+// parseTokens parses a comma separated list of coins, keeping their order.
+func parseTokens(tokensStr string) ([]sdk.Coin, error) {
+	tokens := []sdk.Coin{}
+	for _, tokenStr := range strings.Split(tokensStr, ",") {
+		token, err := sdk.ParseCoinNormalized(tokenStr)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
@@ -8,13 +8,17 @@ import (
 	"github.com/sideprotocol/ibcswap/v6/modules/apps/101-interchain-swap/types"
 )
 
-func NewMarketFeeUpdateProposalHandler(k keeper.Keeper) govtypes.Handler {
+// NewProposalHandler returns the handler of the market fee update and amplification ramp proposals of the
+// module. The keeper is taken by reference as the governance router is built before the keeper.
+func NewProposalHandler(k *keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *types.MarketFeeUpdateProposal:
-			return keeper.HandleMarketFeeUpdateProposal(ctx, k, c)
+			return keeper.HandleMarketFeeUpdateProposal(ctx, *k, c)
+		case *types.AmplificationRampProposal:
+			return keeper.HandleAmplificationRampProposal(ctx, *k, c)
 		default:
-			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distr proposal content type: %T", c)
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized interchain swap proposal content type: %T", c)
 		}
 	}
 }
@@ -46,8 +46,8 @@ func (im IBCModule) OnChanOpenInit(
 		version = types.Version
 	}
 
-	if version != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	if !types.IsSupportedVersion(version) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "got %s, expected one of %s", version, strings.Join(types.SupportedVersions, ", "))
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -74,8 +74,10 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	// The channel uses the version proposed by the counterparty, which selects the packet encoding:
+	// JSON for the v1 version and protobuf for the v2 version.
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected one of %s", counterpartyVersion, strings.Join(types.SupportedVersions, ", "))
 	}
 
 	// OpenTry must claim the channelCapability that IBC passes into the callback
@@ -83,7 +85,7 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	return types.Version, nil
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -94,8 +96,8 @@ func (im IBCModule) OnChanOpenAck(
 	_,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected one of %s", counterpartyVersion, strings.Join(types.SupportedVersions, ", "))
 	}
 	return nil
 }
@@ -137,13 +139,14 @@ func (im IBCModule) OnRecvPacket(
 
 	logger := im.keeper.Logger(ctx)
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	cdc := im.keeper.PacketCodec(ctx, packet.GetDestPort(), packet.GetDestChannel())
 
-	var data types.IBCSwapPacketData
 	var ackErr error
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := cdc.UnmarshalPacketData(packet.GetData())
+	if err != nil {
 		ackErr = errorsmod.Wrapf(types.ErrInvalidType, "cannot unmarshal ICS-101 packet data")
 		logger.Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
-		ack = channeltypes.NewErrorAcknowledgement(ackErr)
+		ack = types.NewErrorAcknowledgement(ackErr)
 	}
 
 	// only attempt the application logic if the packet data
@@ -151,7 +154,7 @@ func (im IBCModule) OnRecvPacket(
 	if ack.Success() {
 		res, err := im.keeper.OnRecvPacket(ctx, packet, data)
 		if err != nil {
-			ack = channeltypes.NewErrorAcknowledgement(err)
+			ack = types.NewErrorAcknowledgement(err)
 			ackErr = err
 			logger.Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
 		} else {
@@ -176,7 +179,7 @@ func (im IBCModule) OnRecvPacket(
 		),
 	)
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
-	return ack
+	return cdc.NewAcknowledgement(ack)
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
@@ -186,12 +189,13 @@ func (im IBCModule) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	var ack channeltypes.Acknowledgement
-	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+	cdc := im.keeper.PacketCodec(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	ack, err := cdc.UnmarshalAcknowledgement(acknowledgement)
+	if err != nil {
 		return errorsmod.Wrapf(errorsmod.ErrUnknownRequest, "cannot unmarshal ICS-101 transfer packet acknowledgement: %v", err)
 	}
-	var data types.IBCSwapPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := cdc.UnmarshalPacketData(packet.GetData())
+	if err != nil {
 		return errorsmod.Wrapf(errorsmod.ErrUnknownRequest, "cannot unmarshal ICS-101 transfer packet data: %s", err.Error())
 	}
 
@@ -235,8 +239,8 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	var data types.IBCSwapPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := im.keeper.PacketCodec(ctx, packet.GetSourcePort(), packet.GetSourceChannel()).UnmarshalPacketData(packet.GetData())
+	if err != nil {
 		return errorsmod.Wrapf(types.ErrUnknownRequest, "cannot unmarshal ICS-101 transfer packet data: %s", err.Error())
 	}
 
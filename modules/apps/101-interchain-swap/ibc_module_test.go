@@ -145,6 +145,11 @@ func (suite *InterchainSwapTestSuite) TestOnChanOpenTry() {
 				path.EndpointA.ChannelConfig.PortID = ibctesting.MockPort
 			}, false,
 		},
+		{
+			"protobuf encoded version", func() {
+				counterpartyVersion = types.Version2
+			}, true,
+		},
 		{
 			"invalid counterparty version", func() {
 				counterpartyVersion = "version"
@@ -189,7 +194,7 @@ func (suite *InterchainSwapTestSuite) TestOnChanOpenTry() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(counterpartyVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal("", version)
@@ -209,6 +214,11 @@ func (suite *InterchainSwapTestSuite) TestOnChanOpenAck() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"protobuf encoded version", func() {
+				counterpartyVersion = types.Version2
+			}, true,
+		},
 		{
 			"invalid counterparty version", func() {
 				counterpartyVersion = "version"
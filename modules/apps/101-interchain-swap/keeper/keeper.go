@@ -33,6 +33,8 @@ type (
 		bankKeeper    types.BankKeeper
 		authKeeper    types.AccountKeeper
 		msgRouter     types.MessageRouter
+		listKeeper    types.SwapListKeeper
+		rateLimiter   types.EscrowRateLimiter
 	}
 )
 
@@ -47,7 +49,8 @@ func NewKeeper(
 	authKeeper types.AccountKeeper,
 	scopedKeeper capabilitykeeper.ScopedKeeper,
 	msgRouter types.MessageRouter,
-
+	listKeeper types.SwapListKeeper,
+	rateLimiter types.EscrowRateLimiter,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -65,9 +68,35 @@ func NewKeeper(
 		bankKeeper:    bankKeeper,
 		authKeeper:    authKeeper,
 		msgRouter:     msgRouter,
+		listKeeper:    listKeeper,
+		rateLimiter:   rateLimiter,
 	}
 }
 
+// validateSwapRoute checks the denoms and the channel against the governed swap lists, if any.
+func (k Keeper) validateSwapRoute(ctx sdk.Context, portID, channelID string, denoms ...string) error {
+	if k.listKeeper == nil {
+		return nil
+	}
+	return k.listKeeper.ValidateSwapRoute(ctx, portID, channelID, denoms...)
+}
+
+// validatePoolRoute checks the assets of a pool and the channel against the governed swap lists.
+func (k Keeper) validatePoolRoute(ctx sdk.Context, portID, channelID, poolId string) error {
+	pool, found := k.GetInterchainLiquidityPool(ctx, poolId)
+	if !found {
+		return nil
+	}
+	return k.validateSwapRoute(ctx, portID, channelID, pool.GetDenoms()...)
+}
+
+// PacketCodec returns the codec of the packets sent and received on a channel, which depends on
+// the negotiated channel version. The packets of an unknown channel are JSON encoded.
+func (k Keeper) PacketCodec(ctx sdk.Context, portID, channelID string) types.PacketCodec {
+	channel, _ := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	return types.NewPacketCodec(channel.Version)
+}
+
 // ----------------------------------------------------------------------------
 // IBC Keeper Logic
 // ----------------------------------------------------------------------------
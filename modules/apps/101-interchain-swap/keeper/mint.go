@@ -16,6 +16,9 @@ func (k Keeper) LockTokens(ctx sdk.Context, sourcePort string, sourceChannel str
 	); err != nil {
 		return err
 	}
+	if k.rateLimiter != nil {
+		k.rateLimiter.TrackEscrowInflow(ctx, sourcePort, sourceChannel, tokens)
+	}
 	return nil
 }
 
@@ -53,6 +56,13 @@ func (k Keeper) MintTokens(ctx sdk.Context, receiver sdk.AccAddress, tokens sdk.
 
 func (k Keeper) UnlockTokens(ctx sdk.Context, port string, channel string, receiver sdk.AccAddress, tokens sdk.Coins) error {
 
+	// the outflows of the escrow are checked against the rate limits of the channel
+	if k.rateLimiter != nil {
+		if err := k.rateLimiter.TrackEscrowOutflow(ctx, port, channel, tokens); err != nil {
+			return err
+		}
+	}
+
 	// create the escrow address for the tokens
 	escrowAddress := types.GetEscrowAddress(port, channel)
 
@@ -64,3 +74,12 @@ func (k Keeper) UnlockTokens(ctx sdk.Context, port string, channel string, recei
 	}
 	return nil
 }
+
+// RefundTokens returns tokens from the escrow account of a channel to the account which locked them.
+// The refunds are not checked against the rate limits of the channel, so they are processed while it is paused.
+func (k Keeper) RefundTokens(ctx sdk.Context, port string, channel string, receiver sdk.AccAddress, tokens sdk.Coins) error {
+	if k.rateLimiter != nil {
+		k.rateLimiter.TrackEscrowRefund(ctx, port, channel, tokens)
+	}
+	return k.bankKeeper.SendCoins(ctx, types.GetEscrowAddress(port, channel), receiver, tokens)
+}
@@ -37,14 +37,12 @@ func (k Keeper) OnMakePoolAcknowledged(ctx sdk.Context, msg *types.MsgMakePoolRe
 	)
 
 	pool.SourceChainId = ctx.ChainID()
+	pool.SetPoolType(msg.PoolType, msg.Amplification)
 
-	// Mint LP tokens
-	totalAmount := sdk.NewInt(0)
-	for _, asset := range msg.Liquidity {
-		totalAmount = totalAmount.Add(asset.Balance.Amount)
-	}
+	// Mint LP tokens for the weights of the assets of this chain
+	totalAmount := pool.SumOfPoolAssets()
 	err := k.MintTokens(ctx, sdk.MustAccAddressFromBech32(msg.Creator), sdk.Coin{
-		Denom: pool.Supply.Denom, Amount: totalAmount.Mul(sdk.NewInt(int64(msg.Liquidity[0].Weight))).Quo(sdk.NewInt((100))),
+		Denom: pool.Supply.Denom, Amount: totalAmount.Mul(sdk.NewInt(int64(pool.WeightOfSide(types.PoolAssetSide_SOURCE)))).Quo(sdk.NewInt((100))),
 	})
 
 	if err != nil {
@@ -98,11 +96,8 @@ func (k Keeper) OnCancelPoolAcknowledged(ctx sdk.Context, msg *types.MsgCancelPo
 	if !found {
 		return types.ErrNotFoundPool
 	}
-	sourceAsset, err := pool.FindAssetBySide(types.PoolAssetSide_SOURCE)
-	if err != nil {
-		return err
-	}
-	if err = k.UnlockTokens(ctx, msg.SourcePort, msg.SourceChannel, sdk.MustAccAddressFromBech32(msg.Creator), sdk.NewCoins(*sourceAsset)); err != nil {
+	sourceAssets := pool.FindAssetsBySide(types.PoolAssetSide_SOURCE)
+	if err := k.RefundTokens(ctx, msg.SourcePort, msg.SourceChannel, sdk.MustAccAddressFromBech32(msg.Creator), sourceAssets); err != nil {
 		return err
 	}
 
@@ -177,7 +172,8 @@ func (k Keeper) OnCancelMultiAssetDepositAcknowledged(ctx sdk.Context, req *type
 
 	// Create escrow module account here
 
-	if err := k.UnlockTokens(ctx, pool.CounterPartyPort, pool.CounterPartyChannel, sdk.MustAccAddressFromBech32(req.Creator), sdk.NewCoins(*order.Deposits[0])); err != nil {
+	deposits := pool.FilterCoinsBySide(order.Deposits, types.PoolAssetSide_SOURCE)
+	if err := k.RefundTokens(ctx, pool.CounterPartyPort, pool.CounterPartyChannel, sdk.MustAccAddressFromBech32(req.Creator), deposits); err != nil {
 		return types.ErrCancelOrder
 	}
 	k.RemoveMultiDepositOrder(ctx, req.PoolId, req.OrderId)
@@ -265,22 +261,12 @@ func (k Keeper) OnMultiAssetWithdrawAcknowledged(ctx sdk.Context, req *types.Msg
 	}
 	pool.SubtractPoolSupply(*req.PoolToken)
 
-	nativeToken, err := pool.FindDenomBySide(types.PoolAssetSide_SOURCE)
-	if err != nil {
-		return err
-	}
-
-	out, err := stateChange.FindOutByDenom(*nativeToken)
-	if err != nil {
-		return err
-	}
-
-	// unlock token
-	err = k.UnlockTokens(ctx,
+	// unlock the tokens of this chain
+	err := k.UnlockTokens(ctx,
 		pool.CounterPartyPort,
 		pool.CounterPartyChannel,
 		sdk.MustAccAddressFromBech32(req.Receiver),
-		sdk.NewCoins(*out),
+		pool.FilterCoinsBySide(stateChange.Out, types.PoolAssetSide_SOURCE),
 	)
 
 	if err != nil {
@@ -358,6 +344,20 @@ func (k Keeper) OnMakePoolReceived(ctx sdk.Context, msg *types.MsgMakePoolReques
 		return nil, types.ErrAlreadyExistPool
 	}
 
+	if err := types.ValidateLiquidityBasic(msg.Liquidity); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrFailedOnCreatePoolReceived, "due to %s", err)
+	}
+	if err := types.ValidatePoolType(msg.PoolType, msg.Amplification); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrFailedOnCreatePoolReceived, "due to %s", err)
+	}
+
+	// the destination assets of the maker chain are contributed by this chain
+	for _, liquidity := range msg.GetLiquidityBySide(types.PoolAssetSide_DESTINATION) {
+		if !k.bankKeeper.HasSupply(ctx, liquidity.Denom) {
+			return nil, errorsmod.Wrapf(types.ErrFailedOnDepositReceived, "due to %s", types.ErrInvalidDecimalPair)
+		}
+	}
+
 	// assume pool is ready when it is created.
 	pool := *types.NewInterchainLiquidityPool(
 		ctx,
@@ -372,10 +372,7 @@ func (k Keeper) OnMakePoolReceived(ctx sdk.Context, msg *types.MsgMakePoolReques
 	)
 
 	pool.SourceChainId = sourceChainId
-
-	if !k.bankKeeper.HasSupply(ctx, msg.Liquidity[1].Balance.Denom) {
-		return nil, errorsmod.Wrapf(types.ErrFailedOnDepositReceived, "due to %s", types.ErrInvalidDecimalPair)
-	}
+	pool.SetPoolType(msg.PoolType, msg.Amplification)
 
 	k.AppendInterchainLiquidityPool(ctx, pool)
 	// emit events
@@ -397,14 +394,10 @@ func (k Keeper) OnTakePoolReceived(ctx sdk.Context, msg *types.MsgTakePoolReques
 		return nil, types.ErrNotFoundPool
 	}
 
-	// mint voucher token
-	asset, err := pool.FindPoolAssetBySide(types.PoolAssetSide_DESTINATION)
-	if err != nil {
-		return nil, err
-	}
+	// mint voucher token for the weights of the assets of the counterparty chain
 	totalAmount := pool.SumOfPoolAssets()
-	if err = k.MintTokens(ctx, sdk.MustAccAddressFromBech32(pool.SourceCreator), sdk.Coin{
-		Denom: pool.Supply.Denom, Amount: totalAmount.Mul(sdk.NewInt(int64(asset.Weight))).Quo(sdk.NewInt((100))),
+	if err := k.MintTokens(ctx, sdk.MustAccAddressFromBech32(pool.SourceCreator), sdk.Coin{
+		Denom: pool.Supply.Denom, Amount: totalAmount.Mul(sdk.NewInt(int64(pool.WeightOfSide(types.PoolAssetSide_DESTINATION)))).Quo(sdk.NewInt((100))),
 	}); err != nil {
 		return nil, err
 	}
@@ -484,25 +477,31 @@ func (k Keeper) OnSingleAssetDepositReceived(ctx sdk.Context, msg *types.MsgSing
 // OnMultiAssetDepositReceived processes a double deposit request and returns a response or an error.
 func (k Keeper) OnMakeMultiAssetDepositReceived(ctx sdk.Context, msg *types.MsgMakeMultiAssetDepositRequest, stateChange *types.StateChange) (*types.MsgMultiAssetDepositResponse, error) {
 
-	// Verify the sender's address
-	_, err := sdk.AccAddressFromBech32(msg.Deposits[1].Sender)
-	if err != nil {
-		return nil, err
-	}
-
 	// Retrieve the liquidity pool
 	pool, found := k.GetInterchainLiquidityPool(ctx, msg.PoolId)
 	if !found {
 		return nil, errorsmod.Wrapf(types.ErrFailedMultiAssetDeposit, "%s", types.ErrNotFoundPool)
 	}
 
+	// the taker deposits the assets of this chain
+	taker, _, err := pool.GetDepositSenders(msg.Deposits)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrFailedMultiAssetDeposit, "%s", err)
+	}
+
+	// Verify the sender's address
+	_, err = sdk.AccAddressFromBech32(taker)
+	if err != nil {
+		return nil, err
+	}
+
 	// create order
 	order := types.MultiAssetDepositOrder{
 		Id:               stateChange.MultiDepositOrderId,
 		PoolId:           msg.PoolId,
 		ChainId:          pool.SourceChainId,
 		SourceMaker:      msg.Deposits[0].Sender,
-		DestinationTaker: msg.Deposits[1].Sender,
+		DestinationTaker: taker,
 		Deposits:         types.GetCoinsFromDepositAssets(msg.Deposits),
 		Status:           types.OrderStatus_PENDING,
 		CreatedAt:        ctx.BlockHeight(),
@@ -663,17 +662,9 @@ func (k Keeper) OnMultiAssetWithdrawReceived(ctx sdk.Context, msg *types.MsgMult
 	}
 	pool.SubtractPoolSupply(*msg.PoolToken)
 
-	nativeDenom, err := pool.FindDenomBySide(types.PoolAssetSide_SOURCE)
-	if err != nil {
-		return nil, err
-	}
-
-	out, err := stateChange.FindOutByDenom(*nativeDenom)
-	if err != nil {
-		return nil, err
-	}
 	// escrow operation
-	err = k.UnlockTokens(ctx, pool.CounterPartyPort, pool.CounterPartyChannel, sdk.MustAccAddressFromBech32(msg.CounterPartyReceiver), sdk.NewCoins(*out))
+	outs := pool.FilterCoinsBySide(stateChange.Out, types.PoolAssetSide_SOURCE)
+	err := k.UnlockTokens(ctx, pool.CounterPartyPort, pool.CounterPartyChannel, sdk.MustAccAddressFromBech32(msg.CounterPartyReceiver), outs)
 
 	if err != nil {
 		return nil, err
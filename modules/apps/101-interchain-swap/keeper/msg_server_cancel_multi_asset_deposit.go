@@ -30,12 +30,18 @@ func (k Keeper) CancelMultiAssetDeposit(ctx context.Context, msg *types.MsgCance
 	if order.Status == types.OrderStatus_COMPLETE {
 		return nil, errorsmod.Wrapf(types.ErrAlreadyCompletedOrder, ":%s", types.ErrCancelOrder)
 	}
+
+	// the deposit of a failed order has been refunded already
+	if order.Status == types.OrderStatus_FAILED {
+		return nil, errorsmod.Wrapf(types.ErrFailedOrder, ":%s", types.ErrCancelOrder)
+	}
 	if msg.Creator != order.SourceMaker {
 		return nil, errorsmod.Wrapf(types.ErrNotEnoughPermission, ":%s", types.ErrCancelOrder)
 	}
 
-	cancelOrderData := types.ModuleCdc.MustMarshalJSON(msg)
-	rawStateChange := types.ModuleCdc.MustMarshalJSON(&types.StateChange{
+	cdc := k.PacketCodec(sdkCtx, msg.SourcePort, msg.SourceChannel)
+	cancelOrderData := cdc.MustMarshalMsg(msg)
+	rawStateChange := cdc.MustMarshalMsg(&types.StateChange{
 		MultiDepositOrderId: order.Id,
 	})
 	// save order in source chain
@@ -40,8 +40,9 @@ func (k msgServer) CancelPool(ctx context.Context, msg *types.MsgCancelPoolReque
 
 	// Move initial funds to liquidity pool
 
-	cancelPoolData := types.ModuleCdc.MustMarshalJSON(msg)
-	rawStateChange := types.ModuleCdc.MustMarshalJSON(&types.StateChange{
+	cdc := k.PacketCodec(sdkCtx, msg.SourcePort, msg.SourceChannel)
+	cancelPoolData := cdc.MustMarshalMsg(msg)
+	rawStateChange := cdc.MustMarshalMsg(&types.StateChange{
 		PoolId:        msg.PoolId,
 		SourceChainId: sdkCtx.ChainID(),
 	})
@@ -23,6 +23,10 @@ func (k Keeper) MakeMultiAssetDeposit(ctx context.Context, msg *types.MsgMakeMul
 		return nil, errormod.Wrapf(types.ErrFailedMultiAssetDeposit, "%s", types.ErrNotFoundPool)
 	}
 
+	if err := k.validateSwapRoute(sdkCtx, msg.Port, msg.Channel, pool.GetDenoms()...); err != nil {
+		return nil, err
+	}
+
 	// Check initial deposit condition
 	if pool.Status != types.PoolStatus_ACTIVE {
 		return nil, errormod.Wrapf(types.ErrFailedMultiAssetDeposit, "%s", types.ErrNotReadyForSwap)
@@ -46,21 +50,26 @@ func (k Keeper) MakeMultiAssetDeposit(ctx context.Context, msg *types.MsgMakeMul
 	// 	return nil, errormod.Wrapf(types.ErrInvalidPairRatio, "%d:%d:%s", currentRatio, inputRatio, types.ErrFailedMultiAssetDeposit)
 	// }
 
+	// the maker deposits the assets of this chain and the taker the assets of the counterparty chain
+	maker, taker, err := pool.GetDepositSenders(msg.Deposits)
+	if err != nil {
+		return nil, errormod.Wrapf(types.ErrFailedMultiAssetDeposit, "due to %s", err)
+	}
+	if maker != msg.Deposits[0].Sender {
+		return nil, errormod.Wrapf(types.ErrFailedMultiAssetDeposit, "due to %s", types.ErrInvalidAddressPair)
+	}
+
 	// Create escrow module account here
-	err = k.LockTokens(sdkCtx, pool.CounterPartyPort, pool.CounterPartyChannel, sdk.MustAccAddressFromBech32(msg.Deposits[0].Sender), sdk.NewCoins(*msg.Deposits[0].Balance))
+	deposits := types.GetCoinsFromDepositAssets(msg.Deposits)
+	err = k.LockTokens(sdkCtx, pool.CounterPartyPort, pool.CounterPartyChannel, sdk.MustAccAddressFromBech32(maker), pool.FilterCoinsBySide(deposits, types.PoolAssetSide_SOURCE))
 
 	if err != nil {
 		return nil, errormod.Wrapf(types.ErrFailedMultiAssetDeposit, "due to %s", err)
 	}
 
-	amm := *types.NewInterchainMarketMaker(
-		&pool,
-	)
+	amm := types.NewMarketMaker(&pool, sdkCtx.BlockTime().Unix())
 
-	poolTokens, err := amm.DepositMultiAsset(sdk.Coins{
-		*msg.Deposits[0].Balance,
-		*msg.Deposits[1].Balance,
-	})
+	poolTokens, err := amm.DepositMultiAsset(types.GetCoinsFromPointers(deposits))
 
 	if err != nil {
 		return nil, err
@@ -70,9 +79,9 @@ func (k Keeper) MakeMultiAssetDeposit(ctx context.Context, msg *types.MsgMakeMul
 	order := types.MultiAssetDepositOrder{
 		PoolId:           msg.PoolId,
 		ChainId:          sdkCtx.ChainID(),
-		SourceMaker:      msg.Deposits[0].Sender,
-		DestinationTaker: msg.Deposits[1].Sender,
-		Deposits:         types.GetCoinsFromDepositAssets(msg.Deposits),
+		SourceMaker:      maker,
+		DestinationTaker: taker,
+		Deposits:         deposits,
 		Status:           types.OrderStatus_PENDING,
 		CreatedAt:        sdkCtx.BlockHeight(),
 	}
@@ -86,8 +95,9 @@ func (k Keeper) MakeMultiAssetDeposit(ctx context.Context, msg *types.MsgMakeMul
 
 	k.SetMultiDepositOrder(sdkCtx, order)
 	// Construct IBC packet
-	rawMsgData := types.ModuleCdc.MustMarshalJSON(msg)
-	rawStateChange := types.ModuleCdc.MustMarshalJSON(&types.StateChange{
+	cdc := k.PacketCodec(sdkCtx, msg.Port, msg.Channel)
+	rawMsgData := cdc.MustMarshalMsg(msg)
+	rawStateChange := cdc.MustMarshalMsg(&types.StateChange{
 		MultiDepositOrderId: order.Id,
 	})
 
@@ -45,27 +45,43 @@ func (k msgServer) MakePool(ctx context.Context, msg *types.MsgMakePoolRequest)
 		return nil, errormod.Wrapf(types.ErrFailedMakePool, "due to %s", err)
 	}
 
-	if !k.bankKeeper.HasSupply(sdkCtx, msg.Liquidity[0].Balance.Denom) {
+	if err := k.validateSwapRoute(sdkCtx, msg.SourcePort, msg.SourceChannel, denoms...); err != nil {
+		return nil, err
+	}
+
+	// the assets of this chain are the source assets, the counterparty chain contributes the others.
+	for _, asset := range msg.Liquidity {
+		if k.bankKeeper.HasSupply(sdkCtx, asset.Balance.Denom) {
+			asset.Side = types.PoolAssetSide_SOURCE
+		} else {
+			asset.Side = types.PoolAssetSide_DESTINATION
+		}
+	}
+
+	sourceLiquidity := msg.GetLiquidityBySide(types.PoolAssetSide_SOURCE)
+	if sourceLiquidity.Empty() || msg.GetLiquidityBySide(types.PoolAssetSide_DESTINATION).Empty() {
 		return nil, errormod.Wrapf(types.ErrFailedMakePool, "due to %s", types.ErrInvalidLiquidity)
 	}
 
 	// Check if user owns initial liquidity or not
 	senderAddress := sdk.MustAccAddressFromBech32(msg.Creator)
 
-	sourceLiquidity := k.bankKeeper.GetBalance(sdkCtx, senderAddress, msg.Liquidity[0].Balance.Denom)
-	if sourceLiquidity.Amount.LT(msg.Liquidity[0].Balance.Amount) {
-		return nil, types.ErrEmptyInitialLiquidity
+	for _, liquidity := range sourceLiquidity {
+		if k.bankKeeper.GetBalance(sdkCtx, senderAddress, liquidity.Denom).Amount.LT(liquidity.Amount) {
+			return nil, types.ErrEmptyInitialLiquidity
+		}
 	}
 
 	// Move initial funds to liquidity pool
-	err = k.LockTokens(sdkCtx, msg.SourcePort, msg.SourceChannel, senderAddress, sdk.NewCoins(*msg.Liquidity[0].Balance))
+	err = k.LockTokens(sdkCtx, msg.SourcePort, msg.SourceChannel, senderAddress, sourceLiquidity)
 
 	if err != nil {
 		return nil, err
 	}
 
-	poolData := types.ModuleCdc.MustMarshalJSON(msg)
-	rawStateChange := types.ModuleCdc.MustMarshalJSON(&types.StateChange{
+	cdc := k.PacketCodec(sdkCtx, msg.SourcePort, msg.SourceChannel)
+	poolData := cdc.MustMarshalMsg(msg)
+	rawStateChange := cdc.MustMarshalMsg(&types.StateChange{
 		PoolId:        poolId,
 		SourceChainId: sdkCtx.ChainID(),
 	})
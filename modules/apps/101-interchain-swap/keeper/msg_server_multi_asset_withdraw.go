@@ -34,9 +34,7 @@ func (k msgServer) MultiAssetWithdraw(goCtx context.Context, msg *types.MsgMulti
 		return nil, errorsmod.Wrapf(types.ErrFailedWithdraw, "because of %s", types.ErrNotFoundPool)
 	}
 
-	amm := *types.NewInterchainMarketMaker(
-		&pool,
-	)
+	amm := types.NewMarketMaker(&pool, ctx.BlockTime().Unix())
 
 	outs, err := amm.MultiAssetWithdraw(*msg.PoolToken)
 	if err != nil {
@@ -50,8 +48,9 @@ func (k msgServer) MultiAssetWithdraw(goCtx context.Context, msg *types.MsgMulti
 	}
 
 	// construct the IBC data packet
-	rawMsgData := types.ModuleCdc.MustMarshalJSON(msg)
-	rawStateChange := types.ModuleCdc.MustMarshalJSON(&types.StateChange{
+	cdc := k.PacketCodec(ctx, msg.Port, msg.Channel)
+	rawMsgData := cdc.MustMarshalMsg(msg)
+	rawStateChange := cdc.MustMarshalMsg(&types.StateChange{
 		Out:        outs,
 		PoolTokens: []*sdk.Coin{msg.PoolToken},
 	})
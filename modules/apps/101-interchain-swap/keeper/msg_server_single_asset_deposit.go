@@ -22,6 +22,10 @@ func (k Keeper) SingleAssetDeposit(ctx context.Context, msg *types.MsgSingleAsse
 		return nil, errormod.Wrapf(types.ErrFailedDeposit, "%s", types.ErrNotFoundPool)
 	}
 
+	if err := k.validateSwapRoute(sdkCtx, msg.Port, msg.Channel, pool.GetDenoms()...); err != nil {
+		return nil, err
+	}
+
 	// Deposit token to Escrow account
 	accAddress, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -42,7 +46,7 @@ func (k Keeper) SingleAssetDeposit(ctx context.Context, msg *types.MsgSingleAsse
 		return nil, errormod.Wrapf(types.ErrFailedDeposit, "%s", err)
 	}
 
-	amm := *types.NewInterchainMarketMaker(&pool)
+	amm := types.NewMarketMaker(&pool, sdkCtx.BlockTime().Unix())
 
 	poolToken, err := amm.DepositSingleAsset(*msg.Token)
 	if err != nil {
@@ -50,8 +54,9 @@ func (k Keeper) SingleAssetDeposit(ctx context.Context, msg *types.MsgSingleAsse
 	}
 
 	// Construct IBC packet
-	rawMsgData := types.ModuleCdc.MustMarshalJSON(msg)
-	rawStateChange := types.ModuleCdc.MustMarshalJSON(&types.StateChange{PoolTokens: []*sdk.Coin{poolToken}})
+	cdc := k.PacketCodec(sdkCtx, msg.Port, msg.Channel)
+	rawMsgData := cdc.MustMarshalMsg(msg)
+	rawStateChange := cdc.MustMarshalMsg(&types.StateChange{PoolTokens: []*sdk.Coin{poolToken}})
 
 	packet := types.IBCSwapPacketData{
 		Type:        types.SINGLE_DEPOSIT,
@@ -54,38 +54,47 @@ func (suite *KeeperTestSuite) SetupPoolWithDenomPair(denomPair []string) (*strin
 	suite.SetupTest()
 	path := NewInterchainSwapPath(suite.chainA, suite.chainB)
 	suite.coordinator.Setup(path)
+
+	// the first denom is an asset of chainA and the second one of chainB
+	ctxA := suite.chainA.GetContext()
+	ctxB := suite.chainB.GetContext()
+	liquidityA := sdk.NewCoins(sdk.NewCoin(denomPair[0], sdk.NewInt(1000)))
+	liquidityB := sdk.NewCoins(sdk.NewCoin(denomPair[1], sdk.NewInt(1000)))
+	if err := suite.chainA.GetSimApp().BankKeeper.MintCoins(ctxA, types.ModuleName, liquidityA); err != nil {
+		return nil, err
+	}
+	if err := suite.chainB.GetSimApp().BankKeeper.MintCoins(ctxB, types.ModuleName, liquidityB); err != nil {
+		return nil, err
+	}
+
 	msg := types.NewMsgMakePool(
 		path.EndpointA.ChannelConfig.PortID,
 		path.EndpointA.ChannelID,
 		suite.chainA.SenderAccount.GetAddress().String(),
 		suite.chainB.SenderAccount.GetAddress().String(),
 		types.PoolAsset{
-			Side: types.PoolAssetSide_SOURCE,
-			Balance: &sdk.Coin{
-				Denom:  sdk.DefaultBondDenom,
-				Amount: sdk.NewInt(1000),
-			},
+			Side:    types.PoolAssetSide_SOURCE,
+			Balance: &liquidityA[0],
 			Weight:  50,
 			Decimal: 6,
 		},
 
 		types.PoolAsset{
-			Side: types.PoolAssetSide_SOURCE,
-			Balance: &sdk.Coin{
-				Denom:  sdk.DefaultBondDenom,
-				Amount: sdk.NewInt(1000),
-			},
+			Side:    types.PoolAssetSide_DESTINATION,
+			Balance: &liquidityB[0],
 			Weight:  50,
 			Decimal: 6,
 		},
 		300,
 	)
 
-	ctxA := suite.chainA.GetContext()
 	poolId := types.GetPoolId(ctxA.ChainID(), suite.chainB.ChainID, msg.GetLiquidityDenoms())
-	suite.chainA.GetSimApp().InterchainSwapKeeper.OnMakePoolAcknowledged(ctxA, msg, poolId)
-	ctxB := suite.chainB.GetContext()
-	suite.chainB.GetSimApp().InterchainSwapKeeper.OnMakePoolAcknowledged(ctxB, msg, poolId)
+	if _, err := suite.chainB.GetSimApp().InterchainSwapKeeper.OnMakePoolReceived(ctxB, msg, poolId, ctxA.ChainID()); err != nil {
+		return nil, err
+	}
+	if err := suite.chainA.GetSimApp().InterchainSwapKeeper.OnMakePoolAcknowledged(ctxA, msg, poolId); err != nil {
+		return nil, err
+	}
 
 	return &poolId, nil
 }
@@ -24,17 +24,25 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwapRequest) (*type
 		return nil, errorsmod.Wrapf(types.ErrFailedSwap, "pool not found: %s", types.ErrNotFoundPool)
 	}
 
+	if err := k.validateSwapRoute(ctx, msg.Port, msg.Channel, msg.TokenIn.Denom, msg.TokenOut.Denom); err != nil {
+		return nil, err
+	}
+
 	if pool.Status != types.PoolStatus_ACTIVE {
 		return nil, errorsmod.Wrapf(types.ErrFailedSwap, "pool not ready for swap: %s", types.ErrNotReadyForSwap)
 	}
 
+	if err := validateSwapDenoms(pool, msg.TokenIn.Denom, msg.TokenOut.Denom); err != nil {
+		return nil, err
+	}
+
 	// Lock swap-in token to the swap module
 	err = k.LockTokens(ctx, pool.CounterPartyPort, pool.CounterPartyChannel, sdk.MustAccAddressFromBech32(msg.Sender), sdk.NewCoins(*msg.TokenIn))
 	if err != nil {
 		return nil, err
 	}
 
-	amm := *types.NewInterchainMarketMaker(&pool)
+	amm := types.NewMarketMaker(&pool, ctx.BlockTime().Unix())
 
 	var tokenOut *sdk.Coin
 	var msgType types.SwapMessageType
@@ -69,8 +77,9 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwapRequest) (*type
 
 	msg.TokenOut = tokenOut
 	// Construct the IBC data packet
-	swapData := types.ModuleCdc.MustMarshalJSON(msg)
-	rawStateChange := types.ModuleCdc.MustMarshalJSON(&types.StateChange{Out: []*sdk.Coin{tokenOut}})
+	cdc := k.PacketCodec(ctx, msg.Port, msg.Channel)
+	swapData := cdc.MustMarshalMsg(msg)
+	rawStateChange := cdc.MustMarshalMsg(&types.StateChange{Out: []*sdk.Coin{tokenOut}})
 
 	packet := types.IBCSwapPacketData{
 		Type:        msgType,
@@ -125,3 +134,17 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwapRequest) (*type
 		Tokens:   []*sdk.Coin{msg.TokenIn, tokenOut},
 	}, nil
 }
+
+// validateSwapDenoms checks that the token in is escrowed on this chain and the token out is released on
+// the counterparty chain.
+func validateSwapDenoms(pool types.InterchainLiquidityPool, denomIn, denomOut string) error {
+	assetIn, err := pool.FindAssetByDenom(denomIn)
+	if err != nil || assetIn.Side != types.PoolAssetSide_SOURCE {
+		return errorsmod.Wrapf(types.ErrFailedSwap, "token in %s is not an asset of this chain: %s", denomIn, types.ErrNotNativeDenom)
+	}
+	assetOut, err := pool.FindAssetByDenom(denomOut)
+	if err != nil || assetOut.Side != types.PoolAssetSide_DESTINATION {
+		return errorsmod.Wrapf(types.ErrFailedSwap, "token out %s is not an asset of the counterparty chain: %s", denomOut, types.ErrInvalidDenomPair)
+	}
+	return nil
+}
@@ -24,6 +24,10 @@ func (k Keeper) TakeMultiAssetDeposit(ctx context.Context, msg *types.MsgTakeMul
 		return nil, errorsmod.Wrapf(types.ErrFailedMultiAssetDeposit, "%s", types.ErrNotFoundPool)
 	}
 
+	if err := k.validateSwapRoute(sdkCtx, msg.Port, msg.Channel, pool.GetDenoms()...); err != nil {
+		return nil, err
+	}
+
 	order, found := k.GetMultiDepositOrder(sdkCtx, msg.PoolId, msg.OrderId)
 	if !found {
 		return nil, errorsmod.Wrapf(types.ErrNotFoundMultiDepositOrder, "%s", types.ErrFailedMultiAssetDeposit)
@@ -37,35 +41,40 @@ func (k Keeper) TakeMultiAssetDeposit(ctx context.Context, msg *types.MsgTakeMul
 		return nil, errorsmod.Wrapf(types.ErrAlreadyCompletedOrder, "due to %s of other's", types.ErrFailedMultiAssetDeposit)
 	}
 
+	if order.Status == types.OrderStatus_FAILED {
+		return nil, errorsmod.Wrapf(types.ErrFailedOrder, "%s", types.ErrFailedMultiAssetDeposit)
+	}
+
 	// estimate pool token
-	amm := types.NewInterchainMarketMaker(&pool)
-	poolTokens, err := amm.DepositMultiAsset(sdk.Coins{
-		*order.Deposits[0],
-		*order.Deposits[1],
-	})
+	amm := types.NewMarketMaker(&pool, sdkCtx.BlockTime().Unix())
+	poolTokens, err := amm.DepositMultiAsset(types.GetCoinsFromPointers(order.Deposits))
 
 	// check asset owned status
-	asset := order.Deposits[1]
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "due to %s of other's", types.ErrFailedMultiAssetDeposit)
 	}
 
-	balance := k.bankKeeper.GetBalance(sdkCtx, sdk.MustAccAddressFromBech32(msg.Sender), asset.Denom)
+	// the taker deposits the assets of this chain
+	assets := pool.FilterCoinsBySide(order.Deposits, types.PoolAssetSide_SOURCE)
+	for _, asset := range assets {
+		balance := k.bankKeeper.GetBalance(sdkCtx, sdk.MustAccAddressFromBech32(msg.Sender), asset.Denom)
 
-	if balance.Amount.LT(asset.Amount) {
-		return nil, errorsmod.Wrapf(types.ErrInEnoughAmount, "due to %s of Lp", types.ErrFailedMultiAssetDeposit)
+		if balance.Amount.LT(asset.Amount) {
+			return nil, errorsmod.Wrapf(types.ErrInEnoughAmount, "due to %s of Lp", types.ErrFailedMultiAssetDeposit)
+		}
 	}
 
 	// Create escrow module account here
-	err = k.LockTokens(sdkCtx, pool.CounterPartyPort, pool.CounterPartyChannel, sdk.MustAccAddressFromBech32(msg.Sender), sdk.NewCoins(*asset))
+	err = k.LockTokens(sdkCtx, pool.CounterPartyPort, pool.CounterPartyChannel, sdk.MustAccAddressFromBech32(msg.Sender), assets)
 
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "due to %s", types.ErrFailedMultiAssetDeposit)
 	}
 
 	// Construct IBC packet
-	rawMsgData := types.ModuleCdc.MustMarshalJSON(msg)
-	rawStateChange := types.ModuleCdc.MustMarshalJSON(&types.StateChange{PoolTokens: poolTokens})
+	cdc := k.PacketCodec(sdkCtx, msg.Port, msg.Channel)
+	rawMsgData := cdc.MustMarshalMsg(msg)
+	rawStateChange := cdc.MustMarshalMsg(&types.StateChange{PoolTokens: poolTokens})
 
 	packet := types.IBCSwapPacketData{
 		Type:        types.TAKE_MULTI_DEPOSIT,
@@ -26,27 +26,31 @@ func (k msgServer) TakePool(ctx context.Context, msg *types.MsgTakePoolRequest)
 		return nil, errorsmod.Wrapf(types.ErrFailedTakePool, "due to %", types.ErrNotEnoughPermission)
 	}
 
-	creatorAddr := sdk.MustAccAddressFromBech32(msg.Creator)
-
-	asset, err := pool.FindAssetBySide(types.PoolAssetSide_SOURCE)
-	if err != nil {
-		return nil, errorsmod.Wrapf(types.ErrFailedTakePool, "due to %", err)
+	if err := k.validateSwapRoute(sdkCtx, msg.Port, msg.Channel, pool.GetDenoms()...); err != nil {
+		return nil, err
 	}
 
-	liquidity := k.bankKeeper.GetBalance(sdkCtx, creatorAddr, asset.Denom)
-	if liquidity.Amount.LTE(sdk.NewInt(0)) {
-		return nil, errorsmod.Wrapf(types.ErrInEnoughAmount, "due to %s", types.ErrFailedOnDepositReceived)
+	creatorAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	// the taker contributes the assets of this chain
+	assets := pool.FindAssetsBySide(types.PoolAssetSide_SOURCE)
+	for _, asset := range assets {
+		liquidity := k.bankKeeper.GetBalance(sdkCtx, creatorAddr, asset.Denom)
+		if liquidity.Amount.LT(asset.Amount) {
+			return nil, errorsmod.Wrapf(types.ErrInEnoughAmount, "due to %s", types.ErrFailedOnDepositReceived)
+		}
 	}
 
 	// Move initial funds to liquidity pool
-	err = k.LockTokens(sdkCtx, pool.CounterPartyPort, pool.CounterPartyChannel, creatorAddr, sdk.NewCoins(*asset))
+	err := k.LockTokens(sdkCtx, pool.CounterPartyPort, pool.CounterPartyChannel, creatorAddr, assets)
 
 	if err != nil {
 		return nil, errorsmod.Wrapf(types.ErrInEnoughAmount, "due to %s", types.ErrFailedOnDepositReceived)
 	}
 
-	rawMsg := types.ModuleCdc.MustMarshalJSON(msg)
-	rawStateChange := types.ModuleCdc.MustMarshalJSON(&types.StateChange{})
+	cdc := k.PacketCodec(sdkCtx, msg.Port, msg.Channel)
+	rawMsg := cdc.MustMarshalMsg(msg)
+	rawStateChange := cdc.MustMarshalMsg(&types.StateChange{})
 	// Construct IBC data packet
 	packet := types.IBCSwapPacketData{
 		Type:        types.TAKE_POOL,
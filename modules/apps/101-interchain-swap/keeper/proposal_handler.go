@@ -2,6 +2,8 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsmod "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	"github.com/sideprotocol/ibcswap/v6/modules/apps/101-interchain-swap/types"
 )
 
@@ -13,3 +15,72 @@ func HandleMarketFeeUpdateProposal(ctx sdk.Context, k Keeper, p *types.MarketFee
 	logger.Info("updated market pool: %s", p.PoolId, "feeRate:", p.FeeRate)
 	return nil
 }
+
+// HandleAmplificationRampProposal ramps the amplification of a stable pool from its current value to
+// the future amplification of the proposal. The ramp is governed on the source chain of the pool: it is
+// sent to the counterparty chain and applied on both chains once the counterparty chain acknowledges it,
+// so that both copies of the pool compute the swaps with the same amplification.
+func HandleAmplificationRampProposal(ctx sdk.Context, k Keeper, p *types.AmplificationRampProposal) error {
+	pool, found := k.GetInterchainLiquidityPool(ctx, p.PoolId)
+	if !found {
+		return types.ErrNotFoundPool
+	}
+	if pool.PoolType != types.PoolType_STABLE {
+		return errorsmod.Wrapf(types.ErrInvalidPoolType, "pool %s is not a stable pool", p.PoolId)
+	}
+	if pool.SourceChainId != ctx.ChainID() {
+		return errorsmod.Wrapf(types.ErrNotPoolSourceChain, "the amplification of pool %s is governed on %s", p.PoolId, pool.SourceChainId)
+	}
+
+	now := ctx.BlockTime().Unix()
+	initialA := pool.Amplification.At(now)
+	if err := types.ValidateAmplificationRamp(initialA, p.FutureA, now, p.FutureTime); err != nil {
+		return err
+	}
+	ramp := &types.Amplification{
+		InitialA:    initialA,
+		FutureA:     p.FutureA,
+		InitialTime: now,
+		FutureTime:  p.FutureTime,
+	}
+
+	cdc := k.PacketCodec(ctx, pool.CounterPartyPort, pool.CounterPartyChannel)
+	packet := types.IBCSwapPacketData{
+		Type:        types.AMPLIFICATION_RAMP,
+		Data:        cdc.MustMarshalMsg(ramp),
+		StateChange: cdc.MustMarshalMsg(&types.StateChange{PoolId: p.PoolId}),
+	}
+	// the revision of the counterparty chain is not known here, the ramp only times out by timestamp
+	_, timeoutTimestamp := types.GetDefaultTimeOut(&ctx)
+	if _, err := k.SendIBCSwapPacket(ctx, pool.CounterPartyPort, pool.CounterPartyChannel, clienttypes.ZeroHeight(), timeoutTimestamp, packet); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("sending amplification ramp", "pool", p.PoolId, "from", initialA, "to", p.FutureA, "until", p.FutureTime)
+	return nil
+}
+
+// setAmplificationRamp applies an amplification ramp to a stable pool. The ramps are applied in the order of
+// their start, a ramp superseded by a later ramp is ignored.
+func (k Keeper) setAmplificationRamp(ctx sdk.Context, poolId string, ramp *types.Amplification) error {
+	pool, found := k.GetInterchainLiquidityPool(ctx, poolId)
+	if !found {
+		return types.ErrNotFoundPool
+	}
+	if pool.PoolType != types.PoolType_STABLE {
+		return errorsmod.Wrapf(types.ErrInvalidPoolType, "pool %s is not a stable pool", poolId)
+	}
+	if err := types.ValidateAmplificationRamp(ramp.InitialA, ramp.FutureA, ramp.InitialTime, ramp.FutureTime); err != nil {
+		return err
+	}
+	if pool.Amplification != nil && ramp.InitialTime < pool.Amplification.InitialTime {
+		return nil
+	}
+	pool.Amplification = ramp
+	k.SetInterchainLiquidityPool(ctx, pool)
+
+	logger := k.Logger(ctx)
+	logger.Info("ramping amplification", "pool", poolId, "from", ramp.InitialA, "to", ramp.FutureA, "until", ramp.FutureTime)
+	return nil
+}
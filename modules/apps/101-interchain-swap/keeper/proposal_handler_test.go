@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/101-interchain-swap/keeper"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/101-interchain-swap/types"
+	ibctesting "github.com/sideprotocol/ibcswap/v6/testing"
+)
+
+func (suite *KeeperTestSuite) TestHandleAmplificationRampProposal() {
+	path := NewInterchainSwapPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// chain A is the source chain of the pool
+	ctx, ctxB := suite.chainA.GetContext(), suite.chainB.GetContext()
+	k, kB := suite.chainA.GetSimApp().InterchainSwapKeeper, suite.chainB.GetSimApp().InterchainSwapKeeper
+	msg, poolId := suite.makeStablePool(path)
+
+	pool, found := k.GetInterchainLiquidityPool(ctx, poolId)
+	suite.Require().True(found)
+	suite.Require().Equal(types.PoolType_STABLE, pool.PoolType)
+	suite.Require().Equal(types.NewAmplification(100), pool.Amplification)
+
+	now := ctx.BlockTime().Unix()
+	futureTime := now + 2*types.MinAmplificationRampDuration
+
+	// the ramp is bounded in duration and in amplitude
+	err := keeper.HandleAmplificationRampProposal(ctx, k, types.NewAmplificationRampProposal("ramp", "ramp", poolId, 2000, futureTime))
+	suite.Require().ErrorIs(err, types.ErrInvalidAmplificationRamp)
+	err = keeper.HandleAmplificationRampProposal(ctx, k, types.NewAmplificationRampProposal("ramp", "ramp", poolId, 200, now+1))
+	suite.Require().ErrorIs(err, types.ErrInvalidAmplificationRamp)
+	err = keeper.HandleAmplificationRampProposal(ctx, k, types.NewAmplificationRampProposal("ramp", "ramp", "pool", 200, futureTime))
+	suite.Require().ErrorIs(err, types.ErrNotFoundPool)
+
+	// the amplification is governed on the source chain of the pool only
+	err = keeper.HandleAmplificationRampProposal(ctxB, kB, types.NewAmplificationRampProposal("ramp", "ramp", poolId, 200, futureTime))
+	suite.Require().ErrorIs(err, types.ErrNotPoolSourceChain)
+
+	cdc := k.PacketCodec(ctx, types.PortID, path.EndpointA.ChannelID)
+	rampPacket := func(ramp *types.Amplification) *types.IBCSwapPacketData {
+		return &types.IBCSwapPacketData{
+			Type:        types.AMPLIFICATION_RAMP,
+			Data:        cdc.MustMarshalMsg(ramp),
+			StateChange: cdc.MustMarshalMsg(&types.StateChange{PoolId: poolId}),
+		}
+	}
+	sendPacket := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: path.EndpointA.ChannelID}
+	recvPacket := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: path.EndpointB.ChannelID}
+
+	// the ramp is sent to the counterparty chain, it is applied on the source chain once acknowledged
+	suite.Require().NoError(keeper.HandleAmplificationRampProposal(ctx, k, types.NewAmplificationRampProposal("ramp", "ramp", poolId, 200, futureTime)))
+	pool, _ = k.GetInterchainLiquidityPool(ctx, poolId)
+	suite.Require().Equal(types.NewAmplification(100), pool.Amplification)
+
+	ramp := &types.Amplification{InitialA: 100, FutureA: 200, InitialTime: now, FutureTime: futureTime}
+	res, err := kB.OnRecvPacket(ctxB, recvPacket, *rampPacket(ramp))
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.OnAcknowledgementPacket(ctx, sendPacket, rampPacket(ramp), channeltypes.NewResultAcknowledgement(res)))
+	pool, _ = k.GetInterchainLiquidityPool(ctx, poolId)
+	suite.Require().Equal(ramp, pool.Amplification)
+	suite.Require().Equal(uint64(150), pool.Amplification.At(now+types.MinAmplificationRampDuration))
+	poolB, _ := kB.GetInterchainLiquidityPool(ctxB, poolId)
+	suite.Require().Equal(ramp, poolB.Amplification)
+
+	// a new ramp starts from the current amplification
+	rampCtx := ctx.WithBlockTime(ctx.BlockTime().Add(types.MinAmplificationRampDuration * 1e9))
+	suite.Require().NoError(keeper.HandleAmplificationRampProposal(rampCtx, k, types.NewAmplificationRampProposal("ramp", "ramp", poolId, 100, futureTime+types.MinAmplificationRampDuration)))
+	next := &types.Amplification{InitialA: 150, FutureA: 100, InitialTime: now + types.MinAmplificationRampDuration, FutureTime: futureTime + types.MinAmplificationRampDuration}
+	res, err = kB.OnRecvPacket(ctxB, recvPacket, *rampPacket(next))
+	suite.Require().NoError(err)
+
+	// a superseded ramp is not applied
+	_, err = kB.OnRecvPacket(ctxB, recvPacket, *rampPacket(ramp))
+	suite.Require().NoError(err)
+	poolB, _ = kB.GetInterchainLiquidityPool(ctxB, poolId)
+	suite.Require().Equal(next, poolB.Amplification)
+
+	// a ramp timing out is not applied on the source chain
+	suite.Require().NoError(k.OnTimeoutPacket(rampCtx, sendPacket, rampPacket(next)))
+	pool, _ = k.GetInterchainLiquidityPool(ctx, poolId)
+	suite.Require().Equal(ramp, pool.Amplification)
+
+	// weighted pools are not amplified
+	msg.PoolType = types.PoolType_WEIGHTED
+	msg.Amplification = 0
+	msg.Liquidity[0].Balance.Denom = "cside"
+	suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("cside", sdk.NewInt(1000)))))
+	weightedPoolId := types.GetPoolId(ctx.ChainID(), suite.chainB.ChainID, msg.GetLiquidityDenoms())
+	suite.Require().NoError(k.OnMakePoolAcknowledged(ctx, msg, weightedPoolId))
+	err = keeper.HandleAmplificationRampProposal(ctx, k, types.NewAmplificationRampProposal("ramp", "ramp", weightedPoolId, 200, futureTime))
+	suite.Require().ErrorIs(err, types.ErrInvalidPoolType)
+}
+
+func (suite *KeeperTestSuite) TestAmplificationRampProposalRoute() {
+	path := NewInterchainSwapPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := suite.chainA.GetContext()
+	app := suite.chainA.GetSimApp()
+	_, poolId := suite.makeStablePool(path)
+
+	// the proposal is routed to the module by the governance router
+	proposal := types.NewAmplificationRampProposal("ramp", "ramp", poolId, 200, ctx.BlockTime().Unix()+2*types.MinAmplificationRampDuration)
+	suite.Require().True(app.GovKeeper.LegacyRouter().HasRoute(proposal.ProposalRoute()))
+	handler := app.GovKeeper.LegacyRouter().GetRoute(proposal.ProposalRoute())
+	suite.Require().NoError(handler(ctx, proposal))
+
+	// the ramp is sent to the counterparty chain of the pool
+	sequence, found := app.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, types.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().NotNil(app.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, types.PortID, path.EndpointA.ChannelID, sequence-1))
+
+	proposal = types.NewAmplificationRampProposal("ramp", "ramp", "pool", 200, ctx.BlockTime().Unix()+2*types.MinAmplificationRampDuration)
+	suite.Require().ErrorIs(handler(ctx, proposal), types.ErrNotFoundPool)
+}
+
+// makeStablePool creates a stable pool whose source chain is chain A on both chains of the path.
+func (suite *KeeperTestSuite) makeStablePool(path *ibctesting.Path) (*types.MsgMakePoolRequest, string) {
+	ctx, ctxB := suite.chainA.GetContext(), suite.chainB.GetContext()
+	k, kB := suite.chainA.GetSimApp().InterchainSwapKeeper, suite.chainB.GetSimApp().InterchainSwapKeeper
+	suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("aside", sdk.NewInt(1000)))))
+
+	msg := &types.MsgMakePoolRequest{
+		SourcePort:          types.PortID,
+		SourceChannel:       path.EndpointA.ChannelID,
+		Creator:             suite.chainA.SenderAccount.GetAddress().String(),
+		CounterPartyCreator: suite.chainB.SenderAccount.GetAddress().String(),
+		Liquidity: []*types.PoolAsset{
+			{Balance: &sdk.Coin{Denom: "aside", Amount: sdk.NewInt(1000)}, Weight: 50, Decimal: 6},
+			{Balance: &sdk.Coin{Denom: "bside", Amount: sdk.NewInt(1000)}, Weight: 50, Decimal: 6},
+		},
+		SwapFee:       30,
+		PoolType:      types.PoolType_STABLE,
+		Amplification: 100,
+	}
+	suite.Require().NoError(msg.ValidateBasic())
+	poolId := types.GetPoolId(ctx.ChainID(), suite.chainB.ChainID, msg.GetLiquidityDenoms())
+	suite.Require().NoError(k.OnMakePoolAcknowledged(ctx, msg, poolId))
+	suite.Require().NoError(suite.chainB.GetSimApp().BankKeeper.MintCoins(ctxB, types.ModuleName, sdk.NewCoins(sdk.NewCoin("aside", sdk.NewInt(1000)), sdk.NewCoin("bside", sdk.NewInt(1000)))))
+	_, err := kB.OnMakePoolReceived(ctxB, msg, poolId, ctx.ChainID())
+	suite.Require().NoError(err)
+	return msg, poolId
+}
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/101-interchain-swap/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EstimateSwap quotes a left swap with the market maker of the pool, as computed by Swap.
+func (k Keeper) EstimateSwap(goCtx context.Context, req *types.QueryEstimateSwapRequest) (*types.QueryEstimateSwapResponse, error) {
+	if req == nil || !req.TokenIn.IsValid() || !req.TokenIn.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, err := k.getSwapPool(ctx, req.PoolId, req.TokenIn.Denom, req.DenomOut)
+	if err != nil {
+		return nil, err
+	}
+	amm := types.NewMarketMaker(&pool, ctx.BlockTime().Unix())
+
+	spotPrice, err := amm.MarketPrice(req.TokenIn.Denom, req.DenomOut)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tokenOut, err := amm.LeftSwap(req.TokenIn, req.DenomOut)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	fee := sdk.NewDecFromInt(req.TokenIn.Amount).Sub(amm.MinusFees(req.TokenIn.Amount)).Ceil().TruncateInt()
+
+	spotPriceAfter, err := k.spotPriceAfterSwap(ctx, req.PoolId, req.TokenIn, *tokenOut)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateSwapResponse{
+		TokenOut:       *tokenOut,
+		Fee:            sdk.NewCoin(req.TokenIn.Denom, fee),
+		PriceImpact:    priceImpact(*spotPrice, req.TokenIn.Amount.Sub(fee), tokenOut.Amount),
+		SpotPriceAfter: spotPriceAfter,
+	}, nil
+}
+
+// EstimateSwapExactOut quotes a right swap with the market maker of the pool. The fee is the difference
+// with the input required by the pool without swap fee.
+func (k Keeper) EstimateSwapExactOut(goCtx context.Context, req *types.QueryEstimateSwapExactOutRequest) (*types.QueryEstimateSwapExactOutResponse, error) {
+	if req == nil || !req.TokenOut.IsValid() || !req.TokenOut.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, err := k.getSwapPool(ctx, req.PoolId, req.DenomIn, req.TokenOut.Denom)
+	if err != nil {
+		return nil, err
+	}
+	amm := types.NewMarketMaker(&pool, ctx.BlockTime().Unix())
+
+	spotPrice, err := amm.MarketPrice(req.DenomIn, req.TokenOut.Denom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tokenIn, err := amm.InGivenOut(req.DenomIn, req.TokenOut)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	poolWithoutFee := pool
+	poolWithoutFee.SwapFee = 0
+	tokenInWithoutFee, err := types.NewMarketMaker(&poolWithoutFee, ctx.BlockTime().Unix()).InGivenOut(req.DenomIn, req.TokenOut)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	fee := sdk.MaxInt(tokenIn.Amount.Sub(tokenInWithoutFee.Amount), sdk.ZeroInt())
+
+	spotPriceAfter, err := k.spotPriceAfterSwap(ctx, req.PoolId, *tokenIn, req.TokenOut)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateSwapExactOutResponse{
+		TokenIn:        *tokenIn,
+		Fee:            sdk.NewCoin(req.DenomIn, fee),
+		PriceImpact:    priceImpact(*spotPrice, tokenIn.Amount.Sub(fee), req.TokenOut.Amount),
+		SpotPriceAfter: spotPriceAfter,
+	}, nil
+}
+
+// EstimateSingleDeposit quotes the pool tokens issued for a single asset deposit, as computed by
+// SingleAssetDeposit.
+func (k Keeper) EstimateSingleDeposit(goCtx context.Context, req *types.QueryEstimateSingleDepositRequest) (*types.QueryEstimateSingleDepositResponse, error) {
+	if req == nil || !req.Token.IsValid() || !req.Token.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, found := k.GetInterchainLiquidityPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrNotFoundPool.Error())
+	}
+
+	poolToken, err := types.NewMarketMaker(&pool, ctx.BlockTime().Unix()).DepositSingleAsset(req.Token)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryEstimateSingleDepositResponse{PoolToken: *poolToken}, nil
+}
+
+// EstimateWithdraw quotes the assets redeemed by pool tokens, as computed by MultiAssetWithdraw.
+func (k Keeper) EstimateWithdraw(goCtx context.Context, req *types.QueryEstimateWithdrawRequest) (*types.QueryEstimateWithdrawResponse, error) {
+	if req == nil || !req.PoolToken.IsValid() || !req.PoolToken.IsPositive() || req.PoolToken.Denom != req.PoolId {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, found := k.GetInterchainLiquidityPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrNotFoundPool.Error())
+	}
+
+	outs, err := types.NewMarketMaker(&pool, ctx.BlockTime().Unix()).MultiAssetWithdraw(req.PoolToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tokens := []sdk.Coin{}
+	for _, out := range outs {
+		tokens = append(tokens, *out)
+	}
+	return &types.QueryEstimateWithdrawResponse{Tokens: tokens}, nil
+}
+
+// getSwapPool returns the pool of a swap from denomIn to denomOut, it has to be active.
+func (k Keeper) getSwapPool(ctx sdk.Context, poolId, denomIn, denomOut string) (types.InterchainLiquidityPool, error) {
+	pool, found := k.GetInterchainLiquidityPool(ctx, poolId)
+	if !found {
+		return pool, status.Error(codes.NotFound, types.ErrNotFoundPool.Error())
+	}
+	if pool.Status != types.PoolStatus_ACTIVE {
+		return pool, status.Error(codes.FailedPrecondition, types.ErrNotReadyForSwap.Error())
+	}
+	if err := validateSwapDenoms(pool, denomIn, denomOut); err != nil {
+		return pool, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return pool, nil
+}
+
+// spotPriceAfterSwap returns the spot price of denom in per denom out of the pool once tokenIn is added
+// and tokenOut removed. The pool is read again from the store as the balances are updated in place.
+func (k Keeper) spotPriceAfterSwap(ctx sdk.Context, poolId string, tokenIn, tokenOut sdk.Coin) (sdk.Dec, error) {
+	pool, _ := k.GetInterchainLiquidityPool(ctx, poolId)
+	if err := pool.AddAsset(tokenIn); err != nil {
+		return sdk.Dec{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := pool.SubtractAsset(tokenOut); err != nil {
+		return sdk.Dec{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	price, err := types.NewMarketMaker(&pool, ctx.BlockTime().Unix()).MarketPrice(tokenIn.Denom, tokenOut.Denom)
+	if err != nil {
+		return sdk.Dec{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return *price, nil
+}
+
+// priceImpact returns 1 - spotPrice * amountOut / amountIn, the relative difference of the execution
+// price of amountIn without fee for amountOut and the spot price before the swap.
+func priceImpact(spotPrice sdk.Dec, amountIn, amountOut sdk.Int) sdk.Dec {
+	if !amountIn.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return sdk.OneDec().Sub(spotPrice.MulInt(amountOut).QuoInt(amountIn))
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sideprotocol/ibcswap/v6/modules/apps/101-interchain-swap/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (suite *KeeperTestSuite) TestEstimateQueries() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().InterchainSwapKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	newPool := func(id string, poolType types.PoolType) types.InterchainLiquidityPool {
+		pool := types.InterchainLiquidityPool{
+			Id: id,
+			Assets: []*types.PoolAsset{
+				{Side: types.PoolAssetSide_SOURCE, Balance: &sdk.Coin{Denom: "aside", Amount: sdk.NewInt(1_000_000_000)}, Weight: 50, Decimal: 6},
+				{Side: types.PoolAssetSide_DESTINATION, Balance: &sdk.Coin{Denom: "bside", Amount: sdk.NewInt(1_000_000_000)}, Weight: 50, Decimal: 6},
+			},
+			Supply:  &sdk.Coin{Denom: id, Amount: sdk.NewInt(2_000_000_000)},
+			SwapFee: 30,
+			Status:  types.PoolStatus_ACTIVE,
+		}
+		pool.SetPoolType(poolType, 100)
+		k.AppendInterchainLiquidityPool(ctx, pool)
+		return pool
+	}
+	weighted := newPool("weighted", types.PoolType_WEIGHTED)
+	stable := newPool("stable", types.PoolType_STABLE)
+	tokenIn := sdk.NewCoin("aside", sdk.NewInt(10_000_000))
+
+	// the quote of a swap is computed by the market maker of the pool
+	for _, pool := range []types.InterchainLiquidityPool{weighted, stable} {
+		amm := types.NewMarketMaker(&pool, ctx.BlockTime().Unix())
+		expectedOut, err := amm.LeftSwap(tokenIn, "bside")
+		suite.Require().NoError(err)
+
+		res, err := k.EstimateSwap(goCtx, &types.QueryEstimateSwapRequest{PoolId: pool.Id, TokenIn: tokenIn, DenomOut: "bside"})
+		suite.Require().NoError(err)
+		suite.Require().Equal(*expectedOut, res.TokenOut)
+		suite.Require().Equal(sdk.NewCoin("aside", sdk.NewInt(30_000)), res.Fee)
+		suite.Require().True(res.PriceImpact.IsPositive(), res.PriceImpact.String())
+		suite.Require().True(res.SpotPriceAfter.GT(sdk.OneDec()), res.SpotPriceAfter.String())
+
+		// the exact output quote of the output of the swap requires the input of the swap
+		exactOut, err := k.EstimateSwapExactOut(goCtx, &types.QueryEstimateSwapExactOutRequest{PoolId: pool.Id, TokenOut: res.TokenOut, DenomIn: "aside"})
+		suite.Require().NoError(err)
+		expectedIn, err := amm.InGivenOut("aside", res.TokenOut)
+		suite.Require().NoError(err)
+		suite.Require().Equal(*expectedIn, exactOut.TokenIn)
+		suite.Require().True(exactOut.SpotPriceAfter.GT(sdk.OneDec()), exactOut.SpotPriceAfter.String())
+	}
+
+	// the stable pool slips less than the weighted pool
+	weightedQuote, err := k.EstimateSwap(goCtx, &types.QueryEstimateSwapRequest{PoolId: "weighted", TokenIn: tokenIn, DenomOut: "bside"})
+	suite.Require().NoError(err)
+	stableQuote, err := k.EstimateSwap(goCtx, &types.QueryEstimateSwapRequest{PoolId: "stable", TokenIn: tokenIn, DenomOut: "bside"})
+	suite.Require().NoError(err)
+	suite.Require().True(stableQuote.TokenOut.Amount.GT(weightedQuote.TokenOut.Amount))
+	suite.Require().True(stableQuote.PriceImpact.LT(weightedQuote.PriceImpact))
+
+	// the right swaps of weighted pools are not charged, the stable pools gross up the input by the fee
+	exactOut, err := k.EstimateSwapExactOut(goCtx, &types.QueryEstimateSwapExactOutRequest{PoolId: "weighted", TokenOut: sdk.NewCoin("bside", sdk.NewInt(1_000_000)), DenomIn: "aside"})
+	suite.Require().NoError(err)
+	suite.Require().True(exactOut.Fee.IsZero())
+	exactOut, err = k.EstimateSwapExactOut(goCtx, &types.QueryEstimateSwapExactOutRequest{PoolId: "stable", TokenOut: sdk.NewCoin("bside", sdk.NewInt(1_000_000)), DenomIn: "aside"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(3_010), exactOut.Fee.Amount)
+
+	// deposits and withdrawals
+	deposit, err := k.EstimateSingleDeposit(goCtx, &types.QueryEstimateSingleDepositRequest{PoolId: "stable", Token: tokenIn})
+	suite.Require().NoError(err)
+	expectedPoolToken, err := types.NewMarketMaker(&stable, ctx.BlockTime().Unix()).DepositSingleAsset(tokenIn)
+	suite.Require().NoError(err)
+	suite.Require().Equal(*expectedPoolToken, deposit.PoolToken)
+
+	withdraw, err := k.EstimateWithdraw(goCtx, &types.QueryEstimateWithdrawRequest{PoolId: "weighted", PoolToken: sdk.NewCoin("weighted", sdk.NewInt(200_000_000))})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.Coin{sdk.NewCoin("aside", sdk.NewInt(100_000_000)), sdk.NewCoin("bside", sdk.NewInt(100_000_000))}, withdraw.Tokens)
+
+	// invalid quotes
+	_, err = k.EstimateSwap(goCtx, &types.QueryEstimateSwapRequest{PoolId: "pool", TokenIn: tokenIn, DenomOut: "bside"})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+	_, err = k.EstimateSwap(goCtx, &types.QueryEstimateSwapRequest{PoolId: "weighted", TokenIn: sdk.NewCoin("bside", sdk.NewInt(1000)), DenomOut: "aside"})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	_, err = k.EstimateWithdraw(goCtx, &types.QueryEstimateWithdrawRequest{PoolId: "weighted", PoolToken: sdk.NewCoin("stable", sdk.NewInt(1000))})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	_, err = k.EstimateSwap(goCtx, nil)
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -53,20 +54,21 @@ func (k Keeper) SendIBCSwapPacket(
 		return nil, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
-	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, swapPacket.GetBytes())
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, k.PacketCodec(ctx, sourcePort, sourceChannel).MarshalPacketData(swapPacket))
 	return &sequence, err
 }
 
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IBCSwapPacketData) ([]byte, error) {
+	cdc := k.PacketCodec(ctx, packet.DestinationPort, packet.DestinationChannel)
 	var stateChange types.StateChange
-	if err := types.ModuleCdc.UnmarshalJSON(data.StateChange, &stateChange); err != nil {
+	if err := cdc.UnmarshalMsg(data.StateChange, &stateChange); err != nil {
 		return nil, err
 	}
 
 	switch data.Type {
 	case types.MAKE_POOL:
 		var msg types.MsgMakePoolRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 			return nil, err
 		}
 
@@ -74,107 +76,143 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			return nil, types.ErrEmptyPoolId
 		}
 
+		if err := k.validateSwapRoute(ctx, packet.DestinationPort, packet.DestinationChannel, msg.GetLiquidityDenoms()...); err != nil {
+			return nil, err
+		}
+
 		poolId, err := k.OnMakePoolReceived(ctx, &msg, stateChange.PoolId, stateChange.SourceChainId)
 		if err != nil {
 			return nil, err
 		}
-		resData, err := types.ModuleCdc.MarshalJSON(&types.MsgMakePoolResponse{PoolId: *poolId})
+		resData, err := cdc.MarshalMsg(&types.MsgMakePoolResponse{PoolId: *poolId})
 		return resData, err
 
 	case types.TAKE_POOL:
 		var msg types.MsgTakePoolRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+			return nil, err
+		}
+		if err := k.validatePoolRoute(ctx, packet.DestinationPort, packet.DestinationChannel, msg.PoolId); err != nil {
 			return nil, err
 		}
 		ackRes, err := k.OnTakePoolReceived(ctx, &msg)
 		if err != nil {
 			return nil, err
 		}
-		resData, err := types.ModuleCdc.MarshalJSON(ackRes)
+		resData, err := cdc.MarshalMsg(ackRes)
 		return resData, err
 
 	case types.CANCEL_POOL:
 		var msg types.MsgCancelPoolRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 			return nil, err
 		}
 		ackRes, err := k.OnCancelPoolReceived(ctx, &msg)
 		if err != nil {
 			return nil, err
 		}
-		resData, err := types.ModuleCdc.MarshalJSON(ackRes)
+		resData, err := cdc.MarshalMsg(ackRes)
 		return resData, err
 
 	case types.SINGLE_DEPOSIT:
 		var msg types.MsgSingleAssetDepositRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+			return nil, err
+		}
+		if err := k.validatePoolRoute(ctx, packet.DestinationPort, packet.DestinationChannel, msg.PoolId); err != nil {
 			return nil, err
 		}
 		res, err := k.OnSingleAssetDepositReceived(ctx, &msg, &stateChange)
 		if err != nil {
 			return nil, err
 		}
-		resData, err := types.ModuleCdc.Marshal(res)
+		resData, err := cdc.MarshalMsg(res)
 		return resData, err
 
 	case types.MAKE_MULTI_DEPOSIT:
 		var msg types.MsgMakeMultiAssetDepositRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+			return nil, err
+		}
+		if err := k.validatePoolRoute(ctx, packet.DestinationPort, packet.DestinationChannel, msg.PoolId); err != nil {
 			return nil, err
 		}
 		res, err := k.OnMakeMultiAssetDepositReceived(ctx, &msg, &stateChange)
 		if err != nil {
 			return nil, err
 		}
-		resData, err := types.ModuleCdc.MarshalJSON(res)
+		resData, err := cdc.MarshalMsg(res)
 		return resData, err
 
 	case types.TAKE_MULTI_DEPOSIT:
 		var msg types.MsgTakeMultiAssetDepositRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+			return nil, err
+		}
+		if err := k.validatePoolRoute(ctx, packet.DestinationPort, packet.DestinationChannel, msg.PoolId); err != nil {
 			return nil, err
 		}
 		res, err := k.OnTakeMultiAssetDepositReceived(ctx, &msg, &stateChange)
 		if err != nil {
 			return nil, err
 		}
-		resData, err := types.ModuleCdc.MarshalJSON(res)
+		resData, err := cdc.MarshalMsg(res)
 		return resData, err
 
 	case types.CANCEL_MULTI_DEPOSIT:
 		var msg types.MsgCancelMultiAssetDepositRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 			return nil, err
 		}
 		res, err := k.OnCancelMultiAssetDepositReceived(ctx, &msg)
 		if err != nil {
 			return nil, err
 		}
-		resData, err := types.ModuleCdc.MarshalJSON(res)
+		resData, err := cdc.MarshalMsg(res)
 		return resData, err
 
 	case types.MULTI_WITHDRAW:
 		var msg types.MsgMultiAssetWithdrawRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 			return nil, err
 		}
 		res, err := k.OnMultiAssetWithdrawReceived(ctx, &msg, &stateChange)
 		if err != nil {
 			return nil, err
 		}
-		resData, err := types.ModuleCdc.MarshalJSON(res)
+		resData, err := cdc.MarshalMsg(res)
 		return resData, err
 
 	case types.LEFT_SWAP, types.RIGHT_SWAP:
 		var msg types.MsgSwapRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+			return nil, err
+		}
+		if msg.TokenIn == nil || msg.TokenOut == nil {
+			return nil, types.ErrInvalidAmount
+		}
+		if err := k.validateSwapRoute(ctx, packet.DestinationPort, packet.DestinationChannel, msg.TokenIn.Denom, msg.TokenOut.Denom); err != nil {
 			return nil, err
 		}
 		res, err := k.OnSwapReceived(ctx, &msg, &stateChange)
 		if err != nil {
 			return nil, err
 		}
-		resData, err := types.ModuleCdc.MarshalJSON(res)
+		resData, err := cdc.MarshalMsg(res)
+		return resData, err
+
+	case types.AMPLIFICATION_RAMP:
+		var ramp types.Amplification
+		if err := cdc.UnmarshalMsg(data.Data, &ramp); err != nil {
+			return nil, err
+		}
+		if err := k.validatePoolRoute(ctx, packet.DestinationPort, packet.DestinationChannel, stateChange.PoolId); err != nil {
+			return nil, err
+		}
+		if err := k.setAmplificationRamp(ctx, stateChange.PoolId, &ramp); err != nil {
+			return nil, err
+		}
+		resData, err := cdc.MarshalMsg(&ramp)
 		return resData, err
 
 	default:
@@ -185,20 +223,22 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 // OnAcknowledgementPacket processes the packet acknowledgement and performs actions based on the acknowledgement type
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data *types.IBCSwapPacketData, ack channeltypes.Acknowledgement) error {
 	logger := k.Logger(ctx)
+	cdc := k.PacketCodec(ctx, packet.SourcePort, packet.SourceChannel)
 
 	var stateChange types.StateChange
-	if err := types.ModuleCdc.UnmarshalJSON(data.StateChange, &stateChange); err != nil {
+	if err := cdc.UnmarshalMsg(data.StateChange, &stateChange); err != nil {
 		return err
 	}
 
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		ackError, _ := types.ParseErrorAcknowledgement(ack)
+		return k.refundPacketToken(ctx, packet, data, ackError)
 	default:
 		switch data.Type {
 		case types.MAKE_POOL:
 			var msg types.MsgMakePoolRequest
-			if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 				logger.Debug(err.Error())
 				return err
 			}
@@ -209,7 +249,7 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 
 		case types.TAKE_POOL:
 			var msg types.MsgTakePoolRequest
-			if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 				logger.Debug(err.Error())
 				return err
 			}
@@ -221,7 +261,7 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 
 		case types.CANCEL_POOL:
 			var msg types.MsgCancelPoolRequest
-			if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 				logger.Debug(err.Error())
 				return err
 			}
@@ -234,12 +274,12 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 		case types.SINGLE_DEPOSIT:
 			var msg types.MsgSingleAssetDepositRequest
 			var res types.MsgSingleAssetDepositResponse
-			if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 				logger.Debug("Deposit:packet:", err.Error())
 				return err
 			}
 
-			if err := types.ModuleCdc.UnmarshalJSON(ack.GetResult(), &res); err != nil {
+			if err := cdc.UnmarshalMsg(ack.GetResult(), &res); err != nil {
 				logger.Debug("Deposit:ack:", err.Error())
 				return err
 			}
@@ -252,14 +292,14 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 
 		case types.MAKE_MULTI_DEPOSIT:
 			var msg types.MsgMakeMultiAssetDepositRequest
-			if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 				return err
 			}
 			return nil
 
 		case types.TAKE_MULTI_DEPOSIT:
 			var msg types.MsgTakeMultiAssetDepositRequest
-			if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 				return err
 			}
 
@@ -273,7 +313,7 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 		case types.CANCEL_MULTI_DEPOSIT:
 			var msg types.MsgCancelMultiAssetDepositRequest
 
-			if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 				return err
 			}
 
@@ -287,7 +327,7 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 		case types.MULTI_WITHDRAW:
 			var msg types.MsgMultiAssetWithdrawRequest
 			//var res types.MsgMultiAssetWithdrawResponse
-			if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 				return err
 			}
 			if err := k.OnMultiAssetWithdrawAcknowledged(ctx, &msg, stateChange); err != nil {
@@ -299,16 +339,23 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 			var msg types.MsgSwapRequest
 			var res types.MsgSwapResponse
 
-			if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+			if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 				return err
 			}
-			if err := types.ModuleCdc.UnmarshalJSON(ack.GetResult(), &res); err != nil {
+			if err := cdc.UnmarshalMsg(ack.GetResult(), &res); err != nil {
 				return err
 			}
 			if err := k.OnSwapAcknowledged(ctx, &msg, &res); err != nil {
 				return err
 			}
 			return nil
+		case types.AMPLIFICATION_RAMP:
+			// the counterparty chain applied the ramp, it is applied on the source chain of the pool
+			var ramp types.Amplification
+			if err := cdc.UnmarshalMsg(data.Data, &ramp); err != nil {
+				return err
+			}
+			return k.setAmplificationRamp(ctx, stateChange.PoolId, &ramp)
 		}
 	}
 	return nil
@@ -316,60 +363,99 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 
 // OnTimeoutPacket processes a timeout packet and refunds the tokens
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data *types.IBCSwapPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+	return k.refundPacketToken(ctx, packet, data, nil)
 }
 
-// refundPacketToken refunds tokens in case of a timeout
-func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data *types.IBCSwapPacketData) error {
-	var token sdk.Coin
+// refundPacketToken refunds tokens in case of a timeout, or of an error acknowledgement of the
+// counterparty chain. The error of the acknowledgement is recorded on the deposit orders.
+func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data *types.IBCSwapPacketData, ackError *types.ErrorAcknowledgement) error {
+	var tokens sdk.Coins
 	var sender string
 	var stateChange types.StateChange
-	if err := types.ModuleCdc.UnmarshalJSON(data.StateChange, &stateChange); err != nil {
+	cdc := k.PacketCodec(ctx, packet.SourcePort, packet.SourceChannel)
+	if err := cdc.UnmarshalMsg(data.StateChange, &stateChange); err != nil {
 		return err
 	}
 	switch data.Type {
+	case types.AMPLIFICATION_RAMP:
+		// the ramp is applied once acknowledged only, the pool keeps its amplification on both chains
+		k.Logger(ctx).Info("amplification ramp not applied", "pool", stateChange.PoolId)
+		return nil
 	case types.MAKE_POOL:
 		var msg types.MsgMakePoolRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 			return err
 		}
-		// Refund initial liquidity
+		// Refund initial liquidity of this chain
 		sender = msg.Creator
-		token = *msg.Liquidity[0].Balance
+		tokens = msg.GetLiquidityBySide(types.PoolAssetSide_SOURCE)
+
+	case types.TAKE_POOL:
+		var msg types.MsgTakePoolRequest
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
+			return err
+		}
+		pool, found := k.GetInterchainLiquidityPool(ctx, msg.PoolId)
+		if !found {
+			return types.ErrNotFoundPool
+		}
+		// Refund liquidity of the taker
+		sender = msg.Creator
+		tokens = pool.FindAssetsBySide(types.PoolAssetSide_SOURCE)
 
 	case types.SINGLE_DEPOSIT:
 		var msg types.MsgSingleAssetDepositRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 			return err
 		}
-		token = *msg.Token
+		tokens = sdk.NewCoins(*msg.Token)
 		sender = msg.Sender
 	case types.MAKE_MULTI_DEPOSIT:
 		var msg types.MsgMakeMultiAssetDepositRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 			return err
 		}
-		token = *msg.Deposits[0].Balance
+		pool, found := k.GetInterchainLiquidityPool(ctx, msg.PoolId)
+		if !found {
+			return types.ErrNotFoundPool
+		}
+		tokens = pool.FilterCoinsBySide(types.GetCoinsFromDepositAssets(msg.Deposits), types.PoolAssetSide_SOURCE)
 		sender = msg.Deposits[0].Sender
-		// remove if I encounter timeout
-		k.RemoveMultiDepositOrder(ctx, msg.PoolId, stateChange.MultiDepositOrderId)
+		order, found := k.GetMultiDepositOrder(ctx, msg.PoolId, stateChange.MultiDepositOrderId)
+		if ackError != nil && found {
+			// keep the rejected order with its failure for querying, it can not be taken or cancelled
+			order.Status = types.OrderStatus_FAILED
+			order.AckError = ackError
+			k.SetMultiDepositOrder(ctx, order)
+		} else {
+			// remove if I encounter timeout
+			k.RemoveMultiDepositOrder(ctx, msg.PoolId, stateChange.MultiDepositOrderId)
+		}
 	case types.TAKE_MULTI_DEPOSIT:
 		var msg types.MsgTakeMultiAssetDepositRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 			return err
 		}
+		pool, found := k.GetInterchainLiquidityPool(ctx, msg.PoolId)
+		if !found {
+			return types.ErrNotFoundPool
+		}
 		order, found := k.GetMultiDepositOrder(ctx, msg.PoolId, msg.OrderId)
 		if !found {
 			return types.ErrNotFoundMultiDepositOrder
 		}
-		token = *order.Deposits[1]
+		tokens = pool.FilterCoinsBySide(order.Deposits, types.PoolAssetSide_SOURCE)
 		sender = msg.Sender
+		if ackError != nil {
+			order.AckError = ackError
+			k.SetMultiDepositOrder(ctx, order)
+		}
 	case types.MULTI_WITHDRAW:
 		var msg types.MsgMultiAssetWithdrawRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 			return err
 		}
-		token = *msg.PoolToken
+		tokens = sdk.NewCoins(*msg.PoolToken)
 		sender = msg.Receiver
 		//burn voucher token.
 		err := k.MintTokens(ctx, sdk.MustAccAddressFromBech32(msg.Receiver), *msg.PoolToken)
@@ -378,27 +464,39 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 		}
 	case types.RIGHT_SWAP:
 		var msg types.MsgSwapRequest
-		if err := types.ModuleCdc.UnmarshalJSON(data.Data, &msg); err != nil {
+		if err := cdc.UnmarshalMsg(data.Data, &msg); err != nil {
 			return err
 		}
-		token = *msg.TokenIn
+		tokens = sdk.NewCoins(*msg.TokenIn)
 		sender = msg.Sender
 	default:
 		return types.ErrUnknownDataPacket
 	}
 
-	escrowAccount := types.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
-	err := k.bankKeeper.SendCoins(ctx, escrowAccount, sdk.AccAddress(sender), sdk.NewCoins(token))
-	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeTimeout, sdk.Attribute{
-		Key:   "sender",
-		Value: sender,
-	}, sdk.Attribute{
-		Key:   "denom",
-		Value: token.Denom,
-	},
-		sdk.Attribute{
-			Key:   "value",
-			Value: token.Amount.String(),
-		}))
+	senderAddress, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+	err = k.RefundTokens(ctx, packet.SourcePort, packet.SourceChannel, senderAddress, tokens)
+	attributes := []sdk.Attribute{
+		{Key: "sender", Value: sender},
+	}
+	for _, token := range tokens {
+		attributes = append(attributes,
+			sdk.Attribute{Key: "denom", Value: token.Denom},
+			sdk.Attribute{Key: "value", Value: token.Amount.String()},
+		)
+	}
+	if ackError != nil {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyRefundReason, types.RefundReasonErrorAck),
+			sdk.NewAttribute(types.AttributeKeyAckErrorCodespace, ackError.Codespace),
+			sdk.NewAttribute(types.AttributeKeyAckErrorCode, fmt.Sprint(ackError.Code)),
+			sdk.NewAttribute(types.AttributeKeyAckErrorReason, ackError.Reason),
+		)
+	} else {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyRefundReason, types.RefundReasonTimeout))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeTimeout, attributes...))
 	return err
 }
@@ -4,8 +4,10 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsmod "github.com/cosmos/cosmos-sdk/types/errors"
 
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	"github.com/sideprotocol/ibcswap/v6/modules/apps/101-interchain-swap/types"
 	ibctesting "github.com/sideprotocol/ibcswap/v6/testing"
 	"github.com/sideprotocol/ibcswap/v6/testing/testutil/sample"
@@ -209,7 +211,7 @@ func (suite *KeeperTestSuite) TestOnReceived() {
 					types.PoolAsset{
 						Side: types.PoolAssetSide_SOURCE,
 						Balance: &sdk.Coin{
-							Denom:  sdk.DefaultBondDenom,
+							Denom:  "bside",
 							Amount: sdk.NewInt(1000),
 						},
 						Weight:  50,
@@ -219,7 +221,7 @@ func (suite *KeeperTestSuite) TestOnReceived() {
 				)
 
 				poolId := types.GetPoolId(suite.chainA.ChainID, suite.chainB.ChainID, []string{
-					sdk.DefaultBondDenom, sdk.DefaultBondDenom,
+					sdk.DefaultBondDenom, "bside",
 				})
 				_, err := suite.chainA.GetSimApp().InterchainSwapKeeper.OnMakePoolReceived(
 					ctx,
@@ -250,3 +252,125 @@ func (suite *KeeperTestSuite) TestOnReceived() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMultiDepositErrorAcknowledgement() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().InterchainSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	maker := suite.chainA.SenderAccount.GetAddress().String()
+	deposit := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	escrowAddr := types.GetEscrowAddress(types.PortID, ibctesting.FirstChannelID)
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: ibctesting.FirstChannelID}
+
+	k.AppendInterchainLiquidityPool(ctx, types.InterchainLiquidityPool{
+		Id: "pool",
+		Assets: []*types.PoolAsset{
+			{Side: types.PoolAssetSide_SOURCE, Balance: &deposit, Weight: 50},
+			{Side: types.PoolAssetSide_DESTINATION, Balance: &sdk.Coin{Denom: "bside", Amount: sdk.NewInt(100)}, Weight: 50},
+		},
+	})
+
+	makeOrder := func(orderId string) *types.IBCSwapPacketData {
+		suite.Require().NoError(bankKeeper.SendCoins(ctx, suite.chainA.SenderAccount.GetAddress(), escrowAddr, sdk.NewCoins(deposit)))
+		k.SetMultiDepositOrder(ctx, types.MultiAssetDepositOrder{
+			Id:               orderId,
+			PoolId:           "pool",
+			SourceMaker:      maker,
+			DestinationTaker: maker,
+			Deposits:         []*sdk.Coin{&deposit, &deposit},
+			Status:           types.OrderStatus_PENDING,
+		})
+		msg := &types.MsgMakeMultiAssetDepositRequest{
+			PoolId:   "pool",
+			Deposits: []*types.DepositAsset{{Sender: maker, Balance: &deposit}},
+		}
+		return &types.IBCSwapPacketData{
+			Type:        types.MAKE_MULTI_DEPOSIT,
+			Data:        types.ModuleCdc.MustMarshalJSON(msg),
+			StateChange: types.ModuleCdc.MustMarshalJSON(&types.StateChange{MultiDepositOrderId: orderId}),
+		}
+	}
+
+	// the rejected order is kept with the error of the counterparty chain
+	data := makeOrder("rejected")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ack := types.NewErrorAcknowledgement(errorsmod.Wrapf(types.ErrNotFoundPool, "pool %s", "pool"))
+	suite.Require().NoError(k.OnAcknowledgementPacket(ctx, packet, data, ack))
+
+	order, found := k.GetMultiDepositOrder(ctx, "pool", "rejected")
+	suite.Require().True(found)
+	suite.Require().Equal(types.OrderStatus_FAILED, order.Status)
+	ackError := &types.ErrorAcknowledgement{Codespace: types.ModuleName, Code: 1502, Reason: "did not find pool"}
+	suite.Require().Equal(ackError, order.AckError)
+	suite.Require().True(bankKeeper.GetAllBalances(ctx, escrowAddr).IsZero())
+
+	attributes := map[string]string{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeTimeout {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+	}
+	suite.Require().Equal(types.RefundReasonErrorAck, attributes[types.AttributeKeyRefundReason])
+	suite.Require().Equal("1502", attributes[types.AttributeKeyAckErrorCode])
+	suite.Require().Equal("did not find pool", attributes[types.AttributeKeyAckErrorReason])
+
+	// the order of a timed out packet is removed
+	data = makeOrder("timeout")
+	suite.Require().NoError(k.OnTimeoutPacket(ctx, packet, data))
+	_, found = k.GetMultiDepositOrder(ctx, "pool", "timeout")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestMultiAssetPoolRefund() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.GetSimApp().InterchainSwapKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	creator := suite.chainA.SenderAccount.GetAddress()
+	escrowAddr := types.GetEscrowAddress(types.PortID, ibctesting.FirstChannelID)
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: ibctesting.FirstChannelID}
+
+	// aside and cside are assets of chainA, bside and dside of the counterparty chain
+	sourceLiquidity := sdk.NewCoins(sdk.NewCoin("aside", sdk.NewInt(1000)), sdk.NewCoin("cside", sdk.NewInt(2000)))
+	suite.Require().NoError(bankKeeper.MintCoins(ctx, types.ModuleName, sourceLiquidity))
+	suite.Require().NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, escrowAddr, sourceLiquidity))
+
+	liquidity := []*types.PoolAsset{
+		{Side: types.PoolAssetSide_SOURCE, Balance: &sdk.Coin{Denom: "aside", Amount: sdk.NewInt(1000)}, Weight: 10, Decimal: 6},
+		{Side: types.PoolAssetSide_DESTINATION, Balance: &sdk.Coin{Denom: "bside", Amount: sdk.NewInt(1000)}, Weight: 20, Decimal: 6},
+		{Side: types.PoolAssetSide_SOURCE, Balance: &sdk.Coin{Denom: "cside", Amount: sdk.NewInt(2000)}, Weight: 30, Decimal: 6},
+		{Side: types.PoolAssetSide_DESTINATION, Balance: &sdk.Coin{Denom: "dside", Amount: sdk.NewInt(1000)}, Weight: 40, Decimal: 6},
+	}
+	msg := &types.MsgMakePoolRequest{
+		SourcePort:          types.PortID,
+		SourceChannel:       ibctesting.FirstChannelID,
+		Creator:             creator.String(),
+		CounterPartyCreator: suite.chainB.SenderAccount.GetAddress().String(),
+		Liquidity:           liquidity,
+		SwapFee:             300,
+	}
+	suite.Require().NoError(msg.ValidateBasic())
+	poolId := types.GetPoolId(ctx.ChainID(), suite.chainB.ChainID, msg.GetLiquidityDenoms())
+
+	// all the assets of chainA are refunded on timeout
+	data := &types.IBCSwapPacketData{
+		Type:        types.MAKE_POOL,
+		Data:        types.ModuleCdc.MustMarshalJSON(msg),
+		StateChange: types.ModuleCdc.MustMarshalJSON(&types.StateChange{PoolId: poolId, SourceChainId: ctx.ChainID()}),
+	}
+	suite.Require().NoError(k.OnTimeoutPacket(ctx, packet, data))
+	suite.Require().True(bankKeeper.GetAllBalances(ctx, escrowAddr).IsZero())
+	suite.Require().True(bankKeeper.GetAllBalances(ctx, creator).IsAllGTE(sourceLiquidity))
+
+	// the creator receives the pool tokens of the weights of the assets of chainA
+	suite.Require().NoError(k.OnMakePoolAcknowledged(ctx, msg, poolId))
+	pool, found := k.GetInterchainLiquidityPool(ctx, poolId)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(40), pool.WeightOfSide(types.PoolAssetSide_SOURCE))
+	suite.Require().Equal(sdk.NewInt(5000), pool.Supply.Amount)
+	suite.Require().Equal(sdk.NewInt(2000), bankKeeper.GetBalance(ctx, creator, poolId).Amount)
+}
@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -55,6 +56,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSwapRequest{},
 	)
 
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&AmplificationRampProposal{},
+	)
+
 	// this line is used by starport scaffolding # 3
 
 	//msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
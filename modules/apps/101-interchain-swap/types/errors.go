@@ -82,4 +82,10 @@ var (
 	ErrCancelPool                     = errorsmod.Register(ModuleName, 1569, "failed to cancel pool")
 	ErrCancelOrder                    = errorsmod.Register(ModuleName, 1570, "failed to cancel order")
 	ErrInvalidPoolId                  = errorsmod.Register(ModuleName, 1571, "invalid poolID")
+	ErrFailedOrder                    = errorsmod.Register(ModuleName, 1572, "order failed on the counterparty chain")
+	ErrInvalidPoolType                = errorsmod.Register(ModuleName, 1573, "invalid pool type")
+	ErrInvalidAmplification           = errorsmod.Register(ModuleName, 1574, "invalid amplification")
+	ErrInvalidAmplificationRamp       = errorsmod.Register(ModuleName, 1575, "invalid amplification ramp")
+	ErrInvariantNotConverged          = errorsmod.Register(ModuleName, 1576, "stable swap invariant did not converge")
+	ErrNotPoolSourceChain             = errorsmod.Register(ModuleName, 1577, "not the source chain of the pool")
 )
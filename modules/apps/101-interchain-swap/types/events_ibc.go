@@ -16,6 +16,11 @@ const (
 	AttributeKeyAck        = "acknowledgement"
 	AttributeKeyAckError   = "error"
 
+	AttributeKeyRefundReason      = "reason"
+	AttributeKeyAckErrorCodespace = "error_codespace"
+	AttributeKeyAckErrorCode      = "error_code"
+	AttributeKeyAckErrorReason    = "error_reason"
+
 	AttributeKeyAction              = "action"
 	AttributeKeyPoolId              = "pool_id"
 	AttributeKeyMultiDepositOrderId = "order_id"
@@ -52,3 +57,9 @@ const (
 	EventValueSuffixReceived     = "received"
 	EventValueSuffixAcknowledged = "acknowledged"
 )
+
+// refund reasons
+const (
+	RefundReasonTimeout  = "timeout"
+	RefundReasonErrorAck = "error_acknowledgement"
+)
@@ -30,3 +30,15 @@ type BankKeeper interface {
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	BlockedAddr(addr sdk.AccAddress) bool
 }
+
+// SwapListKeeper defines the expected interface of the governed swap lists shared with the atomic swap module.
+type SwapListKeeper interface {
+	ValidateSwapRoute(ctx sdk.Context, portID, channelID string, denoms ...string) error
+}
+
+// EscrowRateLimiter defines the expected interface of the escrow rate limits shared with the atomic swap module.
+type EscrowRateLimiter interface {
+	TrackEscrowOutflow(ctx sdk.Context, portID, channelID string, coins sdk.Coins) error
+	TrackEscrowInflow(ctx sdk.Context, portID, channelID string, coins sdk.Coins)
+	TrackEscrowRefund(ctx sdk.Context, portID, channelID string, coins sdk.Coins)
+}
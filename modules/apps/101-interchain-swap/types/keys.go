@@ -8,6 +8,10 @@ const (
 	// module supports
 	Version = "ics101-1"
 
+	// Version2 defines the version of the IBC swap encoding the packet data, the messages and state
+	// changes it carries and the acknowledgements with deterministic protobuf instead of JSON.
+	Version2 = "ics101-2"
+
 	// PortID is the default port id that swap module binds to
 	PortID = ModuleName
 
@@ -25,6 +29,18 @@ const (
 
 	MaxPoolCount = 100000000
 
+	// MinPoolAssets and MaxPoolAssets bound the number of assets of a weighted pool, each chain
+	// contributes one asset at least.
+	MinPoolAssets = 2
+	MaxPoolAssets = 8
+
+	// MaxAmplification bounds the amplification of a stable pool. A ramp lasts
+	// MinAmplificationRampDuration seconds at least and multiplies or divides the amplification by
+	// MaxAmplificationChange at most.
+	MaxAmplification             = 1000000
+	MinAmplificationRampDuration = 86400
+	MaxAmplificationChange       = 10
+
 	MULTI_DEPOSIT_PENDING_LIMIT = 10
 )
 
@@ -35,6 +51,24 @@ var (
 	CurrentPoolCountKey    = []byte{0x03}
 )
 
+// SupportedVersions are the channel versions supported by the interchain swap module.
+var SupportedVersions = []string{Version, Version2}
+
+// IsSupportedVersion returns true if the channel version is supported by the interchain swap module.
+func IsSupportedVersion(version string) bool {
+	for _, supported := range SupportedVersions {
+		if version == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// IsProtoVersion returns true if the packets of a channel with the given version are protobuf encoded.
+func IsProtoVersion(version string) bool {
+	return version == Version2
+}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
@@ -2,7 +2,6 @@ package types
 
 import (
 	"fmt"
-	"math"
 
 	"github.com/cosmos/cosmos-sdk/types"
 )
@@ -53,11 +52,28 @@ func NewInterchainLiquidityPool(
 	return &pool
 }
 
+// set the invariant of the pool, a stable pool starts with a constant amplification
+func (ilp *InterchainLiquidityPool) SetPoolType(poolType PoolType, amplification uint64) {
+	ilp.PoolType = poolType
+	if poolType == PoolType_STABLE {
+		ilp.Amplification = NewAmplification(amplification)
+	}
+}
+
 // find pool asset by denom
 func (ilp *InterchainLiquidityPool) SetSupply(amount types.Int) {
 	ilp.Supply = &types.Coin{Denom: ilp.Id, Amount: amount}
 }
 
+// get the denoms of the pool assets
+func (ilp *InterchainLiquidityPool) GetDenoms() []string {
+	denoms := []string{}
+	for _, asset := range ilp.Assets {
+		denoms = append(denoms, asset.Balance.Denom)
+	}
+	return denoms
+}
+
 // find pool asset by denom
 func (ilp *InterchainLiquidityPool) FindAssetByDenom(denom string) (*PoolAsset, error) {
 	for _, asset := range ilp.Assets {
@@ -98,6 +114,67 @@ func (ilp *InterchainLiquidityPool) FindPoolAssetBySide(side PoolAssetSide) (*Po
 	return nil, ErrNotFoundDenomInPool
 }
 
+// find the balances of the pool assets of a side
+func (ilp *InterchainLiquidityPool) FindAssetsBySide(side PoolAssetSide) types.Coins {
+	coins := []types.Coin{}
+	for _, asset := range ilp.Assets {
+		if asset.Side == side {
+			coins = append(coins, *asset.Balance)
+		}
+	}
+	return types.NewCoins(coins...)
+}
+
+// sum of the weights of the pool assets of a side
+func (ilp *InterchainLiquidityPool) WeightOfSide(side PoolAssetSide) uint32 {
+	weight := uint32(0)
+	for _, asset := range ilp.Assets {
+		if asset.Side == side {
+			weight += asset.Weight
+		}
+	}
+	return weight
+}
+
+// filter the coins of the pool assets of a side, the coins of other denoms are dropped
+func (ilp *InterchainLiquidityPool) FilterCoinsBySide(coins []*types.Coin, side PoolAssetSide) types.Coins {
+	filtered := []types.Coin{}
+	for _, coin := range coins {
+		asset, err := ilp.FindAssetByDenom(coin.Denom)
+		if err == nil && asset.Side == side {
+			filtered = append(filtered, *coin)
+		}
+	}
+	return types.NewCoins(filtered...)
+}
+
+// get the senders of the deposits of the source and destination assets. The deposits have to
+// cover each pool asset once, and the deposits of a side have to be sent by the same account.
+func (ilp *InterchainLiquidityPool) GetDepositSenders(deposits []*DepositAsset) (string, string, error) {
+	if len(deposits) != len(ilp.Assets) {
+		return "", "", ErrInvalidDenomPair
+	}
+	senders := map[PoolAssetSide]string{}
+	denoms := map[string]bool{}
+	for _, deposit := range deposits {
+		asset, err := ilp.FindAssetByDenom(deposit.Balance.Denom)
+		if err != nil {
+			return "", "", err
+		}
+		if denoms[deposit.Balance.Denom] {
+			return "", "", ErrInvalidDenomPair
+		}
+		denoms[deposit.Balance.Denom] = true
+
+		sender, found := senders[asset.Side]
+		if found && sender != deposit.Sender {
+			return "", "", ErrInvalidAddressPair
+		}
+		senders[asset.Side] = deposit.Sender
+	}
+	return senders[PoolAssetSide_SOURCE], senders[PoolAssetSide_DESTINATION], nil
+}
+
 // update denom
 func (ilp *InterchainLiquidityPool) UpdateAssetPoolSide(denom string, side PoolAssetSide) (*PoolAsset, error) {
 	for index, asset := range ilp.Assets {
@@ -173,6 +250,30 @@ func (ilp *InterchainLiquidityPool) SumOfPoolAssets() types.Int {
 	return totalAssets
 }
 
+// MarketMaker computes the swaps, deposits and withdrawals of a pool with the invariant of its type.
+type MarketMaker interface {
+	MarketPrice(denomIn, denomOut string) (*types.Dec, error)
+	DepositSingleAsset(token types.Coin) (*types.Coin, error)
+	DepositMultiAsset(coins types.Coins) ([]*types.Coin, error)
+	MultiAssetWithdraw(redeem types.Coin) ([]*types.Coin, error)
+	LeftSwap(amountIn types.Coin, denomOut string) (*types.Coin, error)
+	RightSwap(amountIn types.Coin, amountOut types.Coin) (*types.Coin, error)
+	InGivenOut(denomIn string, amountOut types.Coin) (*types.Coin, error)
+	MinusFees(amount types.Int) types.Dec
+	Invariant() types.Dec
+}
+
+var _ MarketMaker = &InterchainMarketMaker{}
+
+// NewMarketMaker returns the market maker of the pool type, the amplification of a stable pool is
+// taken at blockTime, a unix time in seconds.
+func NewMarketMaker(pool *InterchainLiquidityPool, blockTime int64) MarketMaker {
+	if pool.PoolType == PoolType_STABLE {
+		return NewStableSwapMarketMaker(pool, pool.Amplification.At(blockTime))
+	}
+	return NewInterchainMarketMaker(pool)
+}
+
 // Create new market maker
 func NewInterchainMarketMaker(
 	pool *InterchainLiquidityPool,
@@ -224,19 +325,13 @@ func (imm *InterchainMarketMaker) DepositSingleAsset(token types.Coin) (*types.C
 	decToken := (types.NewDecCoinFromCoin(token))
 	decAsset := types.NewDecCoinFromCoin(*asset.Balance)
 
-	var issueAmount types.Int
 	if imm.Pool.Status != PoolStatus_ACTIVE {
-		return nil, err
-	} else {
-		weight := types.NewDec(int64(asset.Weight)).Quo(types.NewDec(100)) // divide by 100
-		ratio := decToken.Amount.Quo(decAsset.Amount).Add(types.NewDec(1))
-		exponent := (math.Pow(ratio.MustFloat64(), weight.MustFloat64()) - 1) * Multiplier //Ln(ratio).Mul(weight)
-		factor, err := types.NewDecFromStr(fmt.Sprintf("%f", exponent/1e8))
-		if err != nil {
-			return nil, err
-		}
-		issueAmount = imm.Pool.Supply.Amount.Mul(factor.RoundInt()).Quo(types.NewInt(1e10))
+		return nil, ErrNotReadyForSwap
 	}
+	weight := types.NewDec(int64(asset.Weight)).Quo(types.NewDec(100)) // divide by 100
+	ratio := decToken.Amount.Quo(decAsset.Amount).Add(types.NewDec(1))
+	factor := Pow(ratio, weight).Sub(types.NewDec(1))
+	issueAmount := types.NewDecFromInt(imm.Pool.Supply.Amount).Mul(factor).TruncateInt()
 
 	outputToken := &types.Coin{
 		Amount: issueAmount,
@@ -336,7 +431,7 @@ func (imm *InterchainMarketMaker) MultiAssetWithdraw(redeem types.Coin) ([]*type
 
 // LeftSwap implements OutGivenIn
 // Input how many coins you want to sell, output an amount you will receive
-// Ao = Bo * ((1 - Bi / (Bi + Ai)) ** Wi/Wo)
+// Ao = Bo * (1 - (Bi / (Bi + Ai)) ** Wi/Wo)
 func (imm *InterchainMarketMaker) LeftSwap(amountIn types.Coin, denomOut string) (*types.Coin, error) {
 	assetIn, err := imm.Pool.FindAssetByDenom(amountIn.Denom)
 	if err != nil {
@@ -354,16 +449,15 @@ func (imm *InterchainMarketMaker) LeftSwap(amountIn types.Coin, denomOut string)
 	weightOut := types.NewDec(int64(assetOut.Weight)).Quo(types.NewDec(100))
 	amount := imm.MinusFees(amountIn.Amount)
 
-	// Ao = Bo * ((1 - Bi / (Bi + Ai)) ** Wi/Wo)
+	// Ao = Bo * (1 - (Bi / (Bi + Ai)) ** Wi/Wo)
+	// the weights apply to the ratio of the balances in, the inverse of RightSwap.
 	balanceInPlusAmount := balanceIn.Add(amount)
 	ratio := balanceIn.Quo(balanceInPlusAmount)
-	oneMinusRatio := types.NewDec(1).Sub(ratio)
 
 	power := weightIn.Quo(weightOut)
-	factor := math.Pow(oneMinusRatio.MustFloat64(), power.MustFloat64()) * Multiplier
-	finalFactor := factor / 1e8
+	factor := types.NewDec(1).Sub(Pow(ratio, power))
 
-	amountOut := balanceOut.Mul(types.MustNewDecFromStr(fmt.Sprintf("%f", finalFactor))).Quo(types.NewDec(1e10))
+	amountOut := balanceOut.Mul(factor)
 	return &types.Coin{
 		Amount: amountOut.RoundInt(),
 		Denom:  denomOut,
@@ -374,19 +468,34 @@ func (imm *InterchainMarketMaker) LeftSwap(amountIn types.Coin, denomOut string)
 // Input how many coins you want to buy, output an amount you need to pay
 // Ai = Bi * ((Bo/(Bo - Ao)) ** Wo/Wi -1)
 func (imm *InterchainMarketMaker) RightSwap(amountIn types.Coin, amountOut types.Coin) (*types.Coin, error) {
-	assetIn, err := imm.Pool.FindAssetByDenom(amountIn.Denom)
+	amountRequired, err := imm.InGivenOut(amountIn.Denom, amountOut)
+	if err != nil {
+		return nil, err
+	}
+	if amountIn.Amount.LT(amountRequired.Amount) {
+		return nil, fmt.Errorf("right swap failed: insufficient amount")
+	}
+	return amountRequired, nil
+}
+
+// InGivenOut returns the amount of denomIn to pay for amountOut
+// Ai = Bi * ((Bo/(Bo - Ao)) ** Wo/Wi -1)
+func (imm *InterchainMarketMaker) InGivenOut(denomIn string, amountOut types.Coin) (*types.Coin, error) {
+	assetIn, err := imm.Pool.FindAssetByDenom(denomIn)
 	if err != nil {
 		return nil, fmt.Errorf("right swap failed: could not find asset in by denom")
 	}
 
 	assetOut, err := imm.Pool.FindAssetByDenom(amountOut.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("right swap failed: could not find asset out by denom")
+	}
 
 	decAmountOut := types.NewDecCoinFromCoin(amountOut)
 	decAssetIn := types.NewDecCoinFromCoin(*assetIn.Balance)
 	decAssetOut := types.NewDecCoinFromCoin(*assetOut.Balance)
-
-	if err != nil {
-		return nil, fmt.Errorf("right swap failed: could not find asset out by denom")
+	if decAmountOut.Amount.GTE(decAssetOut.Amount) {
+		return nil, fmt.Errorf("right swap failed: insufficient liquidity")
 	}
 
 	// Ai = Bi * ((Bo/(Bo - Ao)) ** Wo/Wi -1)
@@ -398,15 +507,12 @@ func (imm *InterchainMarketMaker) RightSwap(amountIn types.Coin, amountOut types
 	power := weightOut.Quo(weightIn)
 	denominator := decAssetOut.Amount.Sub(decAmountOut.Amount)
 	base := numerator.Quo(denominator)
-	factor := Exp(Ln(base).Mul(power)).Sub(types.NewDec(1)).Mul(types.NewDec(Multiplier))
-	amountRequired := balanceIn.Mul(factor).Quo(types.NewDec(Multiplier)).RoundInt()
+	factor := Pow(base, power).Sub(types.NewDec(1))
+	amountRequired := balanceIn.Mul(factor).RoundInt()
 
-	if amountIn.Amount.LT(amountRequired) {
-		return nil, fmt.Errorf("right swap failed: insufficient amount")
-	}
 	return &types.Coin{
 		Amount: amountRequired,
-		Denom:  amountIn.Denom,
+		Denom:  denomIn,
 	}, nil
 }
 
@@ -431,41 +537,11 @@ func (imm *InterchainMarketMaker) Invariant() types.Dec {
 		w := types.NewDec(int64(asset.Weight)).Quo(types.NewDec(100))                                  // divide by 100
 		balance := types.NewDecFromBigIntWithPrec(asset.Balance.Amount.BigInt(), int64(asset.Decimal)) //types.NewDecFromBigInt(asset.Balance.Amount.Quo(decimal).BigInt())
 
-		// Raise balance to the power of w using logarithm and exponential functions
-		exponent := Ln(balance).Mul(w)
-		v = v.Mul(Exp(exponent))
+		v = v.Mul(Pow(balance, w))
 	}
 	return v
 }
 
-// Natural logarithm with the Newton-Raphson method
-func Ln(dec types.Dec) types.Dec {
-	// You can adjust the precision and initial guess based on your requirements
-	const maxIterations = 500
-	guess, _ := types.NewDecFromStr("1.0")
-
-	two := types.NewDec(2)
-	for i := 0; i < maxIterations; i++ {
-		guessSquared := guess.Mul(guess)
-		guess = guess.Add((dec.Quo(guess).Sub(guessSquared)).Quo(two))
-	}
-	return guess
-}
-
-// Exponential function
-func Exp(dec types.Dec) types.Dec {
-	// Again, adjust the precision and the number of terms in the series based on your needs
-	const maxIterations = 500
-	result, _ := types.NewDecFromStr("1.0")
-	term := result
-
-	for i := 1; i < maxIterations; i++ {
-		term = term.Mul(dec).QuoInt64(int64(i))
-		result = result.Add(term)
-	}
-	return result
-}
-
 // func (imm *InterchainMarketMaker) InvariantWithInput(tokenIn types.Coin) types.Dec {
 // 	v := types.NewDec(1)
 // 	totalBalance := types.NewDec(0)
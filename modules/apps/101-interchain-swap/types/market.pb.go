@@ -74,21 +74,53 @@ func (PoolStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b958a5b8f2d9fd58, []int{1}
 }
 
+// the invariant of the market maker of a pool
+type PoolType int32
+
+const (
+	// Balancer weighted invariant
+	PoolType_WEIGHTED PoolType = 0
+	// Curve StableSwap invariant, for assets of the same value
+	PoolType_STABLE PoolType = 1
+)
+
+var PoolType_name = map[int32]string{
+	0: "WEIGHTED",
+	1: "STABLE",
+}
+
+var PoolType_value = map[string]int32{
+	"WEIGHTED": 0,
+	"STABLE":   1,
+}
+
+func (x PoolType) String() string {
+	return proto.EnumName(PoolType_name, int32(x))
+}
+
+func (PoolType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b958a5b8f2d9fd58, []int{2}
+}
+
 type OrderStatus int32
 
 const (
 	OrderStatus_PENDING  OrderStatus = 0
 	OrderStatus_COMPLETE OrderStatus = 1
+	// the make packet of the order was rejected by the counterparty chain
+	OrderStatus_FAILED OrderStatus = 2
 )
 
 var OrderStatus_name = map[int32]string{
 	0: "PENDING",
 	1: "COMPLETE",
+	2: "FAILED",
 }
 
 var OrderStatus_value = map[string]int32{
 	"PENDING":  0,
 	"COMPLETE": 1,
+	"FAILED":   2,
 }
 
 func (x OrderStatus) String() string {
@@ -96,7 +128,77 @@ func (x OrderStatus) String() string {
 }
 
 func (OrderStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b958a5b8f2d9fd58, []int{2}
+	return fileDescriptor_b958a5b8f2d9fd58, []int{3}
+}
+
+// the amplification of a stable pool, it ramps linearly from initialA at initialTime to futureA at
+// futureTime. The times are unix timestamps in seconds.
+type Amplification struct {
+	InitialA    uint64 `protobuf:"varint,1,opt,name=initialA,proto3" json:"initialA,omitempty"`
+	FutureA     uint64 `protobuf:"varint,2,opt,name=futureA,proto3" json:"futureA,omitempty"`
+	InitialTime int64  `protobuf:"varint,3,opt,name=initialTime,proto3" json:"initialTime,omitempty"`
+	FutureTime  int64  `protobuf:"varint,4,opt,name=futureTime,proto3" json:"futureTime,omitempty"`
+}
+
+func (m *Amplification) Reset()         { *m = Amplification{} }
+func (m *Amplification) String() string { return proto.CompactTextString(m) }
+func (*Amplification) ProtoMessage()    {}
+func (*Amplification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b958a5b8f2d9fd58, []int{0}
+}
+func (m *Amplification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Amplification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Amplification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Amplification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Amplification.Merge(m, src)
+}
+func (m *Amplification) XXX_Size() int {
+	return m.Size()
+}
+func (m *Amplification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Amplification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Amplification proto.InternalMessageInfo
+
+func (m *Amplification) GetInitialA() uint64 {
+	if m != nil {
+		return m.InitialA
+	}
+	return 0
+}
+
+func (m *Amplification) GetFutureA() uint64 {
+	if m != nil {
+		return m.FutureA
+	}
+	return 0
+}
+
+func (m *Amplification) GetInitialTime() int64 {
+	if m != nil {
+		return m.InitialTime
+	}
+	return 0
+}
+
+func (m *Amplification) GetFutureTime() int64 {
+	if m != nil {
+		return m.FutureTime
+	}
+	return 0
 }
 
 type PoolAsset struct {
@@ -110,7 +212,7 @@ func (m *PoolAsset) Reset()         { *m = PoolAsset{} }
 func (m *PoolAsset) String() string { return proto.CompactTextString(m) }
 func (*PoolAsset) ProtoMessage()    {}
 func (*PoolAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b958a5b8f2d9fd58, []int{0}
+	return fileDescriptor_b958a5b8f2d9fd58, []int{1}
 }
 func (m *PoolAsset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,13 +281,16 @@ type InterchainLiquidityPool struct {
 	SourceChainId       string       `protobuf:"bytes,9,opt,name=sourceChainId,proto3" json:"sourceChainId,omitempty"`
 	CounterPartyPort    string       `protobuf:"bytes,12,opt,name=counterPartyPort,proto3" json:"counterPartyPort,omitempty"`
 	CounterPartyChannel string       `protobuf:"bytes,13,opt,name=counterPartyChannel,proto3" json:"counterPartyChannel,omitempty"`
+	PoolType            PoolType     `protobuf:"varint,14,opt,name=poolType,proto3,enum=ibc.applications.interchain_swap.v1.PoolType" json:"poolType,omitempty"`
+	// the amplification of a stable pool, unset for weighted pools
+	Amplification *Amplification `protobuf:"bytes,15,opt,name=amplification,proto3" json:"amplification,omitempty"`
 }
 
 func (m *InterchainLiquidityPool) Reset()         { *m = InterchainLiquidityPool{} }
 func (m *InterchainLiquidityPool) String() string { return proto.CompactTextString(m) }
 func (*InterchainLiquidityPool) ProtoMessage()    {}
 func (*InterchainLiquidityPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b958a5b8f2d9fd58, []int{1}
+	return fileDescriptor_b958a5b8f2d9fd58, []int{2}
 }
 func (m *InterchainLiquidityPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,6 +396,20 @@ func (m *InterchainLiquidityPool) GetCounterPartyChannel() string {
 	return ""
 }
 
+func (m *InterchainLiquidityPool) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return PoolType_WEIGHTED
+}
+
+func (m *InterchainLiquidityPool) GetAmplification() *Amplification {
+	if m != nil {
+		return m.Amplification
+	}
+	return nil
+}
+
 type InterchainMarketMaker struct {
 	PoolId string                   `protobuf:"bytes,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
 	Pool   *InterchainLiquidityPool `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
@@ -300,7 +419,7 @@ func (m *InterchainMarketMaker) Reset()         { *m = InterchainMarketMaker{} }
 func (m *InterchainMarketMaker) String() string { return proto.CompactTextString(m) }
 func (*InterchainMarketMaker) ProtoMessage()    {}
 func (*InterchainMarketMaker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b958a5b8f2d9fd58, []int{2}
+	return fileDescriptor_b958a5b8f2d9fd58, []int{3}
 }
 func (m *InterchainMarketMaker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,7 +474,7 @@ func (m *MarketFeeUpdateProposal) Reset()         { *m = MarketFeeUpdateProposal
 func (m *MarketFeeUpdateProposal) String() string { return proto.CompactTextString(m) }
 func (*MarketFeeUpdateProposal) ProtoMessage()    {}
 func (*MarketFeeUpdateProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b958a5b8f2d9fd58, []int{3}
+	return fileDescriptor_b958a5b8f2d9fd58, []int{4}
 }
 func (m *MarketFeeUpdateProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,6 +503,49 @@ func (m *MarketFeeUpdateProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarketFeeUpdateProposal proto.InternalMessageInfo
 
+// AmplificationRampProposal ramps the amplification of a stable pool linearly from its current value
+// to future_a at future_time, a unix timestamp in seconds.
+type AmplificationRampProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolId      string `protobuf:"bytes,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	FutureA     uint64 `protobuf:"varint,4,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
+	FutureTime  int64  `protobuf:"varint,5,opt,name=future_time,json=futureTime,proto3" json:"future_time,omitempty"`
+}
+
+func (m *AmplificationRampProposal) Reset()         { *m = AmplificationRampProposal{} }
+func (m *AmplificationRampProposal) String() string { return proto.CompactTextString(m) }
+func (*AmplificationRampProposal) ProtoMessage()    {}
+func (*AmplificationRampProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b958a5b8f2d9fd58, []int{5}
+}
+func (m *AmplificationRampProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AmplificationRampProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AmplificationRampProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AmplificationRampProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmplificationRampProposal.Merge(m, src)
+}
+func (m *AmplificationRampProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AmplificationRampProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmplificationRampProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmplificationRampProposal proto.InternalMessageInfo
+
 // multi asset deposit order
 type MultiAssetDepositOrder struct {
 	Id               string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -394,13 +556,15 @@ type MultiAssetDepositOrder struct {
 	Deposits         []*types.Coin `protobuf:"bytes,6,rep,name=deposits,proto3" json:"deposits,omitempty"`
 	Status           OrderStatus   `protobuf:"varint,8,opt,name=status,proto3,enum=ibc.applications.interchain_swap.v1.OrderStatus" json:"status,omitempty"`
 	CreatedAt        int64         `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// the error acknowledgement of the last failed packet of the order
+	AckError *ErrorAcknowledgement `protobuf:"bytes,10,opt,name=ackError,proto3" json:"ackError,omitempty"`
 }
 
 func (m *MultiAssetDepositOrder) Reset()         { *m = MultiAssetDepositOrder{} }
 func (m *MultiAssetDepositOrder) String() string { return proto.CompactTextString(m) }
 func (*MultiAssetDepositOrder) ProtoMessage()    {}
 func (*MultiAssetDepositOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b958a5b8f2d9fd58, []int{4}
+	return fileDescriptor_b958a5b8f2d9fd58, []int{6}
 }
 func (m *MultiAssetDepositOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,14 +649,24 @@ func (m *MultiAssetDepositOrder) GetCreatedAt() int64 {
 	return 0
 }
 
+func (m *MultiAssetDepositOrder) GetAckError() *ErrorAcknowledgement {
+	if m != nil {
+		return m.AckError
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ibc.applications.interchain_swap.v1.PoolAssetSide", PoolAssetSide_name, PoolAssetSide_value)
 	proto.RegisterEnum("ibc.applications.interchain_swap.v1.PoolStatus", PoolStatus_name, PoolStatus_value)
+	proto.RegisterEnum("ibc.applications.interchain_swap.v1.PoolType", PoolType_name, PoolType_value)
 	proto.RegisterEnum("ibc.applications.interchain_swap.v1.OrderStatus", OrderStatus_name, OrderStatus_value)
+	proto.RegisterType((*Amplification)(nil), "ibc.applications.interchain_swap.v1.Amplification")
 	proto.RegisterType((*PoolAsset)(nil), "ibc.applications.interchain_swap.v1.PoolAsset")
 	proto.RegisterType((*InterchainLiquidityPool)(nil), "ibc.applications.interchain_swap.v1.InterchainLiquidityPool")
 	proto.RegisterType((*InterchainMarketMaker)(nil), "ibc.applications.interchain_swap.v1.InterchainMarketMaker")
 	proto.RegisterType((*MarketFeeUpdateProposal)(nil), "ibc.applications.interchain_swap.v1.MarketFeeUpdateProposal")
+	proto.RegisterType((*AmplificationRampProposal)(nil), "ibc.applications.interchain_swap.v1.AmplificationRampProposal")
 	proto.RegisterType((*MultiAssetDepositOrder)(nil), "ibc.applications.interchain_swap.v1.MultiAssetDepositOrder")
 }
 
@@ -501,61 +675,116 @@ func init() {
 }
 
 var fileDescriptor_b958a5b8f2d9fd58 = []byte{
-	// 849 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x72, 0x1b, 0x45,
-	0x10, 0xd6, 0xca, 0xb2, 0x7e, 0x5a, 0x71, 0x50, 0x0d, 0x21, 0x9e, 0xa4, 0x40, 0x51, 0x09, 0x0e,
-	0xc2, 0x45, 0x76, 0x2d, 0xa7, 0xe0, 0x40, 0x71, 0x11, 0xb2, 0x1c, 0xb6, 0xca, 0x96, 0x55, 0x6b,
-	0x85, 0x43, 0x2e, 0xae, 0xd1, 0x6c, 0xc7, 0x9e, 0xca, 0x6a, 0x67, 0xd9, 0x99, 0x75, 0xca, 0x37,
-	0xb8, 0x71, 0x84, 0x37, 0xc8, 0x73, 0xf0, 0x02, 0x70, 0xcc, 0x91, 0x23, 0x65, 0x5f, 0x78, 0x0c,
-	0x6a, 0x66, 0x57, 0x96, 0xec, 0x04, 0x70, 0x6e, 0xdb, 0xdf, 0x74, 0xcf, 0x7c, 0xf3, 0xf5, 0xd7,
-	0xb3, 0xb0, 0x2d, 0x66, 0xdc, 0x63, 0x49, 0x12, 0x09, 0xce, 0xb4, 0x90, 0xb1, 0xf2, 0x44, 0xac,
-	0x31, 0xe5, 0xa7, 0x4c, 0xc4, 0xc7, 0xea, 0x15, 0x4b, 0xbc, 0xb3, 0xbe, 0x37, 0x67, 0xe9, 0x4b,
-	0xd4, 0x6e, 0x92, 0x4a, 0x2d, 0xc9, 0xa7, 0x62, 0xc6, 0xdd, 0xd5, 0x0a, 0xf7, 0x46, 0x85, 0x7b,
-	0xd6, 0x7f, 0xd8, 0xe6, 0x52, 0xcd, 0xa5, 0xf2, 0x66, 0x4c, 0xa1, 0x77, 0xd6, 0x9f, 0xa1, 0x66,
-	0x7d, 0x8f, 0x4b, 0x11, 0xe7, 0x9b, 0x3c, 0xbc, 0x77, 0x22, 0x4f, 0xa4, 0xfd, 0xf4, 0xcc, 0x57,
-	0x8e, 0x76, 0x7f, 0x73, 0xa0, 0x31, 0x91, 0x32, 0x1a, 0x28, 0x85, 0x9a, 0xec, 0x41, 0x45, 0x89,
-	0x10, 0xa9, 0xd3, 0x71, 0x7a, 0x77, 0x77, 0x76, 0xdc, 0x5b, 0x9c, 0xeb, 0x5e, 0x55, 0x1f, 0x89,
-	0x10, 0x03, 0x5b, 0x4f, 0x9e, 0x40, 0x6d, 0xc6, 0x22, 0x16, 0x73, 0xa4, 0xe5, 0x8e, 0xd3, 0x6b,
-	0xee, 0x3c, 0x70, 0x73, 0x76, 0xae, 0x61, 0xe7, 0x16, 0xec, 0xdc, 0xa1, 0x14, 0x71, 0xb0, 0xc8,
-	0x24, 0xf7, 0xa1, 0xfa, 0x0a, 0xc5, 0xc9, 0xa9, 0xa6, 0x6b, 0x1d, 0xa7, 0xb7, 0x11, 0x14, 0x11,
-	0xa1, 0x50, 0x0b, 0x91, 0x8b, 0x39, 0x8b, 0x68, 0xc5, 0x2e, 0x2c, 0xc2, 0xee, 0x8f, 0x15, 0xd8,
-	0xf4, 0xaf, 0x18, 0xed, 0x8b, 0x1f, 0x32, 0x11, 0x0a, 0x7d, 0x6e, 0x18, 0x91, 0xbb, 0x50, 0x16,
-	0xa1, 0xbd, 0x48, 0x23, 0x28, 0x8b, 0x90, 0x7c, 0x06, 0x1b, 0x4a, 0x66, 0x29, 0xc7, 0x61, 0x8a,
-	0x4c, 0xcb, 0xd4, 0x12, 0x6b, 0x04, 0xd7, 0x41, 0xe2, 0x02, 0x09, 0x51, 0x69, 0x11, 0xdb, 0xfb,
-	0x2e, 0x52, 0xd7, 0x6c, 0xea, 0x3b, 0x56, 0xc8, 0x1e, 0x54, 0x99, 0xb9, 0xbb, 0xa2, 0x95, 0xce,
-	0x5a, 0xaf, 0xb9, 0xe3, 0xbe, 0x9f, 0x64, 0x41, 0x51, 0x6d, 0xee, 0x68, 0x16, 0xf7, 0x10, 0xe9,
-	0x7a, 0x7e, 0xc7, 0x22, 0x24, 0x7d, 0xa8, 0xaa, 0x2c, 0x49, 0xa2, 0x73, 0x5a, 0xfd, 0x3f, 0x25,
-	0x8b, 0x44, 0xf2, 0x14, 0xaa, 0x4a, 0x33, 0x9d, 0x29, 0x5a, 0xb3, 0x7d, 0xf4, 0x6e, 0x4d, 0xea,
-	0xc8, 0x96, 0x05, 0x45, 0x39, 0xf9, 0x04, 0x20, 0x91, 0x32, 0x3a, 0x4e, 0x52, 0xc1, 0x91, 0xd6,
-	0x3b, 0x4e, 0xaf, 0x12, 0x34, 0x0c, 0x32, 0x31, 0xc0, 0x8a, 0xa4, 0x66, 0x23, 0x3f, 0xa4, 0x8d,
-	0x6b, 0x92, 0xe6, 0x20, 0xd9, 0x82, 0x16, 0x97, 0x99, 0x39, 0x6f, 0xc2, 0x52, 0xd3, 0x9c, 0x54,
-	0xd3, 0x3b, 0x36, 0xf1, 0x2d, 0x9c, 0x6c, 0xc3, 0x87, 0xab, 0xd8, 0xf0, 0x94, 0xc5, 0x31, 0x46,
-	0x74, 0xc3, 0xa6, 0xbf, 0x6b, 0xa9, 0xfb, 0x93, 0x03, 0x1f, 0x2d, 0x2d, 0x70, 0x60, 0xa7, 0xe6,
-	0x80, 0xbd, 0xc4, 0xd4, 0xd8, 0xc9, 0x50, 0xf5, 0x17, 0x26, 0x28, 0x22, 0x32, 0x81, 0x8a, 0xf9,
-	0x2a, 0x8c, 0xf9, 0xcd, 0xad, 0xb4, 0xf9, 0x17, 0x93, 0x05, 0x76, 0xa7, 0xee, 0xaf, 0x0e, 0x6c,
-	0xe6, 0x27, 0xef, 0x21, 0x3e, 0x4b, 0x42, 0xa6, 0x71, 0x92, 0xca, 0x44, 0x2a, 0x16, 0x91, 0x7b,
-	0xb0, 0xae, 0x85, 0x8e, 0xb0, 0x20, 0x91, 0x07, 0xa4, 0x03, 0xcd, 0x10, 0x15, 0x4f, 0x45, 0x62,
-	0x8e, 0x2c, 0xac, 0xb8, 0x0a, 0x91, 0x4d, 0xa8, 0x59, 0xe9, 0x45, 0x58, 0xb8, 0x6f, 0x41, 0xff,
-	0x01, 0xd4, 0x5f, 0x20, 0x1e, 0xa7, 0x4c, 0xe3, 0x62, 0x1c, 0x5e, 0x20, 0x06, 0x4c, 0xe3, 0xd7,
-	0xf0, 0xf3, 0xeb, 0x47, 0xa5, 0xbf, 0x5f, 0x3f, 0x2a, 0x51, 0xa7, 0xfb, 0x7b, 0x19, 0xee, 0x1f,
-	0x64, 0x91, 0x16, 0xd6, 0x67, 0xbb, 0x98, 0x48, 0x25, 0xf4, 0x61, 0x1a, 0x62, 0xfa, 0xd6, 0x64,
-	0x2c, 0x85, 0x2a, 0x5f, 0x3b, 0x89, 0x42, 0x8d, 0x17, 0x8d, 0xcd, 0x29, 0x2c, 0x42, 0x43, 0x3f,
-	0xef, 0xb1, 0x55, 0xda, 0xd2, 0x68, 0x04, 0xab, 0x90, 0x69, 0xfa, 0xca, 0xb4, 0x4c, 0x6d, 0xda,
-	0x7a, 0xde, 0xf4, 0x9b, 0x38, 0xf9, 0x12, 0xea, 0x61, 0xce, 0x4f, 0xd1, 0xaa, 0x9d, 0xa2, 0xff,
-	0xf0, 0xf8, 0x55, 0x2a, 0xf9, 0xee, 0xca, 0xe5, 0x75, 0xeb, 0xf2, 0xed, 0x5b, 0x75, 0xd2, 0x4a,
-	0x70, 0xc3, 0xe6, 0x1f, 0x43, 0x83, 0x9b, 0x79, 0xc6, 0x70, 0xa0, 0xad, 0x87, 0xd7, 0x82, 0x25,
-	0xb0, 0xf5, 0x05, 0x6c, 0x5c, 0x7b, 0xe2, 0x08, 0x40, 0xf5, 0xe8, 0xf0, 0x59, 0x30, 0x1c, 0xb5,
-	0x4a, 0xe4, 0x03, 0x68, 0xee, 0x8e, 0x8e, 0xa6, 0xfe, 0x78, 0x30, 0xf5, 0x0f, 0xc7, 0x2d, 0x67,
-	0xeb, 0x73, 0x80, 0xe5, 0x20, 0x99, 0x65, 0x7f, 0xec, 0x4f, 0xfd, 0xc1, 0xbe, 0xff, 0x7c, 0xb4,
-	0xdb, 0x2a, 0x99, 0xda, 0xc1, 0x70, 0xea, 0x7f, 0x3f, 0x6a, 0x39, 0x5b, 0x3d, 0x68, 0xae, 0xb0,
-	0x21, 0x4d, 0xa8, 0x4d, 0x46, 0xe3, 0x5d, 0x7f, 0xfc, 0xb4, 0x55, 0x22, 0x77, 0xa0, 0x3e, 0x3c,
-	0x3c, 0x98, 0xec, 0x8f, 0xa6, 0xa3, 0x96, 0xf3, 0x2d, 0xff, 0xe3, 0xa2, 0xed, 0xbc, 0xb9, 0x68,
-	0x3b, 0x7f, 0x5d, 0xb4, 0x9d, 0x5f, 0x2e, 0xdb, 0xa5, 0x37, 0x97, 0xed, 0xd2, 0x9f, 0x97, 0xed,
-	0xd2, 0x73, 0xff, 0x44, 0xe8, 0xd3, 0x6c, 0xe6, 0x72, 0x39, 0xf7, 0xcc, 0xcb, 0x6b, 0x1f, 0x75,
-	0x2e, 0x23, 0x4f, 0xcc, 0x78, 0xfe, 0x2b, 0xf9, 0xca, 0x9b, 0xcb, 0x30, 0x8b, 0x50, 0x99, 0x5f,
-	0x8e, 0xf2, 0xfa, 0xdb, 0xfd, 0xc7, 0x4b, 0x59, 0x1e, 0xdb, 0x1c, 0x7d, 0x9e, 0xa0, 0x9a, 0x55,
-	0x6d, 0xed, 0x93, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x68, 0xe4, 0x93, 0xf9, 0x9f, 0x06, 0x00,
-	0x00,
+	// 1051 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x9b, 0x34, 0x7f, 0x5e, 0x36, 0xdd, 0x68, 0x58, 0xb6, 0xd3, 0x0a, 0xd2, 0x2a, 0xec,
+	0xa1, 0x54, 0xd4, 0x6e, 0xba, 0x80, 0xc4, 0x8a, 0x8b, 0x37, 0x4d, 0xbb, 0x96, 0xfa, 0x27, 0x72,
+	0x53, 0x40, 0x7b, 0xa9, 0x26, 0xe3, 0x69, 0x3b, 0xaa, 0xe3, 0x31, 0x9e, 0x49, 0xab, 0x1e, 0x39,
+	0xc1, 0x11, 0xbe, 0x00, 0xda, 0x0b, 0x5f, 0x80, 0x23, 0x9f, 0x80, 0xe3, 0x1e, 0x39, 0xa2, 0xf6,
+	0xc2, 0xc7, 0x40, 0x33, 0x76, 0xd2, 0xa4, 0xbb, 0x40, 0x38, 0xec, 0xcd, 0xf3, 0xe6, 0xf7, 0x9b,
+	0xf9, 0xbd, 0xe7, 0xf7, 0x7e, 0x36, 0x6c, 0xf2, 0x3e, 0x75, 0x48, 0x1c, 0x87, 0x9c, 0x12, 0xc5,
+	0x45, 0x24, 0x1d, 0x1e, 0x29, 0x96, 0xd0, 0x73, 0xc2, 0xa3, 0x13, 0x79, 0x45, 0x62, 0xe7, 0xb2,
+	0xe5, 0x0c, 0x48, 0x72, 0xc1, 0x94, 0x1d, 0x27, 0x42, 0x09, 0xf4, 0x11, 0xef, 0x53, 0x7b, 0x92,
+	0x61, 0xdf, 0x63, 0xd8, 0x97, 0xad, 0xe5, 0x06, 0x15, 0x72, 0x20, 0xa4, 0xd3, 0x27, 0x92, 0x39,
+	0x97, 0xad, 0x3e, 0x53, 0xa4, 0xe5, 0x50, 0xc1, 0xa3, 0xf4, 0x90, 0xe5, 0x47, 0x67, 0xe2, 0x4c,
+	0x98, 0x47, 0x47, 0x3f, 0x65, 0xd1, 0x99, 0xc4, 0xc4, 0x84, 0x8e, 0xc5, 0x34, 0xbf, 0xb7, 0xa0,
+	0xe6, 0x0e, 0xe2, 0x90, 0x9f, 0x66, 0x14, 0xb4, 0x0c, 0x65, 0x1e, 0x71, 0xc5, 0x49, 0xe8, 0x62,
+	0x6b, 0xd5, 0x5a, 0x2b, 0xf8, 0xe3, 0x35, 0xc2, 0x50, 0x3a, 0x1d, 0xaa, 0x61, 0xc2, 0x5c, 0x3c,
+	0x67, 0xb6, 0x46, 0x4b, 0xb4, 0x0a, 0xd5, 0x0c, 0xd5, 0xe3, 0x03, 0x86, 0xf3, 0xab, 0xd6, 0x5a,
+	0xde, 0x9f, 0x0c, 0xa1, 0x06, 0x40, 0x0a, 0x36, 0x80, 0x82, 0x01, 0x4c, 0x44, 0x9a, 0xbf, 0x59,
+	0x50, 0xe9, 0x0a, 0x11, 0xba, 0x52, 0x32, 0x85, 0x76, 0xa0, 0x20, 0x79, 0xc0, 0x8c, 0x82, 0x85,
+	0xad, 0x2d, 0x7b, 0x86, 0x9a, 0xd9, 0x63, 0xf6, 0x11, 0x0f, 0x98, 0x6f, 0xf8, 0xe8, 0x29, 0x94,
+	0xfa, 0x24, 0x24, 0x11, 0x65, 0x46, 0x71, 0x75, 0x6b, 0xc9, 0x4e, 0x2b, 0x6b, 0xeb, 0xca, 0xda,
+	0x59, 0x65, 0xed, 0xb6, 0xe0, 0x91, 0x3f, 0x42, 0xa2, 0xc7, 0x50, 0xbc, 0x62, 0xfc, 0xec, 0x5c,
+	0x99, 0x3c, 0x6a, 0x7e, 0xb6, 0xd2, 0xe9, 0x07, 0x8c, 0xf2, 0x01, 0x09, 0x8d, 0xfe, 0x9a, 0x3f,
+	0x5a, 0x36, 0x7f, 0x99, 0x87, 0x45, 0x6f, 0xac, 0x68, 0x8f, 0x7f, 0x3b, 0xe4, 0x01, 0x57, 0xd7,
+	0x5a, 0x11, 0x5a, 0x80, 0x39, 0x1e, 0x98, 0x44, 0x2a, 0xfe, 0x1c, 0x0f, 0xd0, 0x13, 0xa8, 0x49,
+	0x31, 0x4c, 0x28, 0x6b, 0x27, 0x8c, 0x28, 0x91, 0x18, 0x61, 0x15, 0x7f, 0x3a, 0x88, 0x6c, 0x40,
+	0x01, 0x93, 0x8a, 0x47, 0x26, 0xdf, 0x11, 0x34, 0x6f, 0xa0, 0x6f, 0xd9, 0x41, 0x3b, 0x50, 0x24,
+	0x3a, 0x77, 0x89, 0x0b, 0xab, 0xf9, 0xb5, 0xea, 0x96, 0xfd, 0xff, 0x4a, 0xe6, 0x67, 0x6c, 0x9d,
+	0xa3, 0xde, 0xdc, 0x61, 0x0c, 0xcf, 0xa7, 0x39, 0x66, 0x4b, 0xd4, 0x82, 0xa2, 0x1c, 0xc6, 0x71,
+	0x78, 0x8d, 0x8b, 0xff, 0x55, 0xc9, 0x0c, 0x88, 0x76, 0xa1, 0x28, 0x15, 0x51, 0x43, 0x89, 0x4b,
+	0xe6, 0x3d, 0x3a, 0x33, 0x8b, 0x3a, 0x32, 0x34, 0x3f, 0xa3, 0xa3, 0x0f, 0x01, 0x62, 0x21, 0xc2,
+	0x93, 0x38, 0xe1, 0x94, 0xe1, 0xb2, 0xe9, 0xbd, 0x8a, 0x8e, 0x74, 0x75, 0x60, 0xa2, 0xa4, 0xfa,
+	0x20, 0x2f, 0xc0, 0x95, 0xa9, 0x92, 0xa6, 0x41, 0xb4, 0x0e, 0x75, 0x2a, 0x86, 0xfa, 0xbe, 0x2e,
+	0x49, 0xf4, 0xcb, 0x49, 0x14, 0x7e, 0x60, 0x80, 0x6f, 0xc4, 0xd1, 0x26, 0xbc, 0x37, 0x19, 0x6b,
+	0x9f, 0x93, 0x28, 0x62, 0x21, 0xae, 0x19, 0xf8, 0xdb, 0xb6, 0x90, 0x07, 0x65, 0x2d, 0xa8, 0x77,
+	0x1d, 0x33, 0xbc, 0x60, 0xb2, 0xdd, 0x98, 0x39, 0x5b, 0x4d, 0xf2, 0xc7, 0x74, 0xf4, 0x0d, 0xd4,
+	0xc8, 0xe4, 0x4c, 0xe2, 0x87, 0xa6, 0xe0, 0xb3, 0x4d, 0xc1, 0xd4, 0x34, 0xfb, 0xd3, 0x07, 0x35,
+	0xbf, 0xb3, 0xe0, 0xfd, 0xbb, 0x3e, 0xdd, 0x37, 0xb6, 0xb4, 0x4f, 0x2e, 0x58, 0xa2, 0x7b, 0x5e,
+	0xdf, 0xef, 0x8d, 0x3a, 0x35, 0x5b, 0xa1, 0x2e, 0x14, 0xf4, 0x53, 0x36, 0x3d, 0x5f, 0xce, 0x24,
+	0xe1, 0x1f, 0x26, 0xc1, 0x37, 0x27, 0x35, 0x7f, 0xb2, 0x60, 0x31, 0xbd, 0x79, 0x87, 0xb1, 0xe3,
+	0x38, 0x20, 0x8a, 0x75, 0x13, 0x11, 0x0b, 0x49, 0x42, 0xf4, 0x08, 0xe6, 0x15, 0x57, 0x21, 0xcb,
+	0x44, 0xa4, 0x0b, 0x6d, 0x2e, 0x01, 0x93, 0x34, 0xe1, 0xb1, 0xa9, 0x46, 0x3a, 0x2f, 0x93, 0x21,
+	0xb4, 0x08, 0x25, 0xd3, 0x1f, 0x3c, 0xc0, 0xf9, 0x29, 0xf9, 0x4b, 0x50, 0x3e, 0x65, 0xec, 0x24,
+	0x21, 0x8a, 0x8d, 0x66, 0xf6, 0x94, 0x31, 0x9f, 0x28, 0xf6, 0x0c, 0x7e, 0x78, 0xb5, 0x92, 0xfb,
+	0xeb, 0xd5, 0x4a, 0x0e, 0x5b, 0xcd, 0x5f, 0x2d, 0x58, 0x9a, 0x2e, 0x1c, 0x19, 0xc4, 0xef, 0x56,
+	0x95, 0x71, 0xbe, 0x13, 0x82, 0x0b, 0xd3, 0x46, 0xba, 0x02, 0xd5, 0x6c, 0x4b, 0xf1, 0x41, 0x3a,
+	0x83, 0x53, 0x3e, 0xf9, 0xac, 0x3c, 0x92, 0xdd, 0xfc, 0x39, 0x0f, 0x8f, 0xf7, 0x87, 0xa1, 0xe2,
+	0x66, 0x82, 0xb7, 0x59, 0x2c, 0x24, 0x57, 0x87, 0x49, 0xc0, 0x92, 0x37, 0x3c, 0xe7, 0xee, 0xed,
+	0xce, 0x4d, 0x09, 0xc1, 0x50, 0xa2, 0xd9, 0xc8, 0xa4, 0x0a, 0x47, 0x4b, 0x9d, 0x5d, 0x3a, 0x3d,
+	0xa6, 0x3d, 0x8c, 0xca, 0x8a, 0x3f, 0x19, 0xd2, 0xe3, 0x34, 0xe1, 0x43, 0x3d, 0x03, 0x9b, 0x4f,
+	0xc7, 0xe9, 0x7e, 0x1c, 0x7d, 0x06, 0xe5, 0x20, 0xd5, 0x27, 0x71, 0x71, 0x35, 0xff, 0xef, 0xee,
+	0x31, 0x86, 0xa2, 0x17, 0x63, 0xff, 0x28, 0x9b, 0x89, 0xda, 0x9c, 0xa9, 0xfd, 0x4c, 0x09, 0xee,
+	0x19, 0xc8, 0x07, 0x50, 0xa1, 0xda, 0x29, 0x59, 0xe0, 0x2a, 0xe3, 0x0e, 0x79, 0xff, 0x2e, 0x80,
+	0x8e, 0xa1, 0x4c, 0xe8, 0x45, 0x27, 0x49, 0x44, 0x82, 0xc1, 0x34, 0xfa, 0x17, 0x33, 0xdd, 0x64,
+	0x18, 0x2e, 0xbd, 0x88, 0xc4, 0x55, 0xc8, 0x82, 0x33, 0x36, 0x60, 0x91, 0xf2, 0xc7, 0x47, 0xad,
+	0x7f, 0x02, 0xb5, 0xa9, 0x6f, 0x12, 0x02, 0x28, 0x1e, 0x1d, 0x1e, 0xfb, 0xed, 0x4e, 0x3d, 0x87,
+	0x1e, 0x42, 0x75, 0xbb, 0x73, 0xd4, 0xf3, 0x0e, 0xdc, 0x9e, 0x77, 0x78, 0x50, 0xb7, 0xd6, 0x3f,
+	0x06, 0xb8, 0x73, 0x3e, 0xbd, 0xed, 0x1d, 0x78, 0x3d, 0xcf, 0xdd, 0xf3, 0x5e, 0x76, 0xb6, 0xeb,
+	0x39, 0xcd, 0x75, 0xdb, 0x3d, 0xef, 0xab, 0x4e, 0xdd, 0x5a, 0x7f, 0x02, 0xe5, 0x91, 0x6d, 0xa0,
+	0x07, 0x50, 0xfe, 0xba, 0xe3, 0xed, 0xbe, 0xe8, 0x8d, 0x50, 0x47, 0x3d, 0xf7, 0xf9, 0x9e, 0x46,
+	0x7d, 0x0a, 0xd5, 0x89, 0x52, 0xa0, 0x2a, 0x94, 0xba, 0x9d, 0x83, 0x6d, 0xef, 0x60, 0xb7, 0x9e,
+	0xd3, 0xac, 0xf6, 0xe1, 0x7e, 0x77, 0xaf, 0xd3, 0xeb, 0xd4, 0x2d, 0xcd, 0xda, 0x71, 0xbd, 0xbd,
+	0xce, 0x76, 0x7d, 0xee, 0x39, 0xfd, 0xfd, 0xa6, 0x61, 0xbd, 0xbe, 0x69, 0x58, 0x7f, 0xde, 0x34,
+	0xac, 0x1f, 0x6f, 0x1b, 0xb9, 0xd7, 0xb7, 0x8d, 0xdc, 0x1f, 0xb7, 0x8d, 0xdc, 0x4b, 0xef, 0x8c,
+	0xab, 0xf3, 0x61, 0xdf, 0xa6, 0x62, 0xe0, 0xe8, 0x8f, 0xab, 0xf9, 0x83, 0xa0, 0x22, 0x74, 0x78,
+	0x9f, 0xa6, 0x3f, 0x17, 0x9f, 0x3b, 0x03, 0x11, 0x0c, 0x43, 0x26, 0xf5, 0x4f, 0x88, 0x74, 0x5a,
+	0x9b, 0xad, 0x8d, 0xbb, 0xaa, 0x6d, 0x18, 0x8c, 0xba, 0x8e, 0x99, 0xec, 0x17, 0x0d, 0xf7, 0xe9,
+	0xdf, 0x03, 0x00, 0xf6, 0xc5, 0x4b, 0xf5, 0x3e, 0x09, 0x00, 0x00,
+}
+
+func (m *Amplification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Amplification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Amplification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FutureTime != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.FutureTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InitialTime != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.InitialTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FutureA != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.FutureA))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InitialA != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.InitialA))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *PoolAsset) Marshal() (dAtA []byte, err error) {
@@ -628,6 +857,23 @@ func (m *InterchainLiquidityPool) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Amplification != nil {
+		{
+			size, err := m.Amplification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.CounterPartyChannel) > 0 {
 		i -= len(m.CounterPartyChannel)
 		copy(dAtA[i:], m.CounterPartyChannel)
@@ -805,6 +1051,60 @@ func (m *MarketFeeUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *AmplificationRampProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AmplificationRampProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AmplificationRampProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FutureTime != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.FutureTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FutureA != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.FutureA))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MultiAssetDepositOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -825,6 +1125,18 @@ func (m *MultiAssetDepositOrder) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.AckError != nil {
+		{
+			size, err := m.AckError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.CreatedAt != 0 {
 		i = encodeVarintMarket(dAtA, i, uint64(m.CreatedAt))
 		i--
@@ -898,6 +1210,27 @@ func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *Amplification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitialA != 0 {
+		n += 1 + sovMarket(uint64(m.InitialA))
+	}
+	if m.FutureA != 0 {
+		n += 1 + sovMarket(uint64(m.FutureA))
+	}
+	if m.InitialTime != 0 {
+		n += 1 + sovMarket(uint64(m.InitialTime))
+	}
+	if m.FutureTime != 0 {
+		n += 1 + sovMarket(uint64(m.FutureTime))
+	}
+	return n
+}
+
 func (m *PoolAsset) Size() (n int) {
 	if m == nil {
 		return 0
@@ -969,6 +1302,13 @@ func (m *InterchainLiquidityPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMarket(uint64(l))
 	}
+	if m.PoolType != 0 {
+		n += 1 + sovMarket(uint64(m.PoolType))
+	}
+	if m.Amplification != nil {
+		l = m.Amplification.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
 	return n
 }
 
@@ -1013,6 +1353,33 @@ func (m *MarketFeeUpdateProposal) Size() (n int) {
 	return n
 }
 
+func (m *AmplificationRampProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.FutureA != 0 {
+		n += 1 + sovMarket(uint64(m.FutureA))
+	}
+	if m.FutureTime != 0 {
+		n += 1 + sovMarket(uint64(m.FutureTime))
+	}
+	return n
+}
+
 func (m *MultiAssetDepositOrder) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1051,6 +1418,10 @@ func (m *MultiAssetDepositOrder) Size() (n int) {
 	if m.CreatedAt != 0 {
 		n += 1 + sovMarket(uint64(m.CreatedAt))
 	}
+	if m.AckError != nil {
+		l = m.AckError.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
 	return n
 }
 
@@ -1060,6 +1431,132 @@ func sovMarket(x uint64) (n int) {
 func sozMarket(x uint64) (n int) {
 	return sovMarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *Amplification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Amplification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Amplification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
+			}
+			m.InitialA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialA |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
+			}
+			m.FutureA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureA |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialTime", wireType)
+			}
+			m.InitialTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureTime", wireType)
+			}
+			m.FutureTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PoolAsset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1551,6 +2048,61 @@ func (m *InterchainLiquidityPool) Unmarshal(dAtA []byte) error {
 			}
 			m.CounterPartyChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amplification == nil {
+				m.Amplification = &Amplification{}
+			}
+			if err := m.Amplification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarket(dAtA[iNdEx:])
@@ -1855,6 +2407,190 @@ func (m *MarketFeeUpdateProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AmplificationRampProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AmplificationRampProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AmplificationRampProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
+			}
+			m.FutureA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureA |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureTime", wireType)
+			}
+			m.FutureTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MultiAssetDepositOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2116,6 +2852,42 @@ func (m *MultiAssetDepositOrder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AckError == nil {
+				m.AckError = &ErrorAcknowledgement{}
+			}
+			if err := m.AckError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarket(dAtA[iNdEx:])
@@ -343,3 +343,279 @@ func TestMultiDeposit(t *testing.T) {
 	fmt.Println(outToken)
 	require.NoError(t, err)
 }
+
+// TestWeightedPoolGoldenVectors checks the swap, deposit and invariant math of a 20/80 pool against
+// reference values computed with 80 digits of precision.
+func TestWeightedPoolGoldenVectors(t *testing.T) {
+	denoms := []string{"uatom", "uosmo"}
+	poolId := GetPoolId("test", "test", denoms)
+	pool := InterchainLiquidityPool{
+		Id: poolId,
+		Assets: []*PoolAsset{
+			{Side: PoolAssetSide_SOURCE, Balance: &types.Coin{Denom: denoms[0], Amount: types.NewInt(1_000_000_000)}, Weight: 20, Decimal: 6},
+			{Side: PoolAssetSide_DESTINATION, Balance: &types.Coin{Denom: denoms[1], Amount: types.NewInt(4_000_000_000)}, Weight: 80, Decimal: 6},
+		},
+		Supply:  &types.Coin{Denom: poolId, Amount: types.NewInt(5_000_000_000)},
+		SwapFee: 300,
+		Status:  PoolStatus_ACTIVE,
+	}
+	amm := NewInterchainMarketMaker(&pool)
+
+	out, err := amm.LeftSwap(types.Coin{Denom: denoms[0], Amount: types.NewInt(10_000_000)}, denoms[1])
+	require.NoError(t, err)
+	require.Equal(t, types.Coin{Denom: denoms[1], Amount: types.NewInt(9_641_618)}, *out)
+
+	out, err = amm.LeftSwap(types.Coin{Denom: denoms[1], Amount: types.NewInt(10_000_000)}, denoms[0])
+	require.NoError(t, err)
+	require.Equal(t, types.Coin{Denom: denoms[0], Amount: types.NewInt(9_641_478)}, *out)
+
+	in, err := amm.RightSwap(types.Coin{Denom: denoms[0], Amount: types.NewInt(20_000_000)}, types.Coin{Denom: denoms[1], Amount: types.NewInt(10_000_000)})
+	require.NoError(t, err)
+	require.Equal(t, types.Coin{Denom: denoms[0], Amount: types.NewInt(10_062_814)}, *in)
+
+	_, err = amm.RightSwap(types.Coin{Denom: denoms[0], Amount: types.NewInt(20_000_000)}, types.Coin{Denom: denoms[1], Amount: types.NewInt(4_000_000_000)})
+	require.Error(t, err)
+
+	poolToken, err := amm.DepositSingleAsset(types.Coin{Denom: denoms[0], Amount: types.NewInt(100_000_000)})
+	require.NoError(t, err)
+	require.Equal(t, types.Coin{Denom: poolId, Amount: types.NewInt(96_224_382)}, *poolToken)
+
+	// 1000^0.2 * 4000^0.8
+	expected := types.MustNewDecFromStr("3031.433133020796164695")
+	require.True(t, amm.Invariant().Sub(expected).Abs().LTE(types.NewDecWithPrec(1, 12)), amm.Invariant().String())
+}
+
+// TestMultiAssetPool checks the sides, the deposit senders and the math of a 20/30/50 pool where the
+// counterparty chain contributes two assets, against reference values computed with 80 digits of precision.
+func TestMultiAssetPool(t *testing.T) {
+	denoms := []string{"uatom", "uosmo", "ujuno"}
+	poolId := GetPoolId("test", "test1", append([]string{}, denoms...))
+	pool := InterchainLiquidityPool{
+		Id: poolId,
+		Assets: []*PoolAsset{
+			{Side: PoolAssetSide_SOURCE, Balance: &types.Coin{Denom: denoms[0], Amount: types.NewInt(1_000_000_000)}, Weight: 20, Decimal: 6},
+			{Side: PoolAssetSide_DESTINATION, Balance: &types.Coin{Denom: denoms[1], Amount: types.NewInt(2_000_000_000)}, Weight: 30, Decimal: 6},
+			{Side: PoolAssetSide_DESTINATION, Balance: &types.Coin{Denom: denoms[2], Amount: types.NewInt(5_000_000_000)}, Weight: 50, Decimal: 6},
+		},
+		Supply:  &types.Coin{Denom: poolId, Amount: types.NewInt(8_000_000_000)},
+		SwapFee: 300,
+		Status:  PoolStatus_ACTIVE,
+	}
+
+	require.Equal(t, uint32(20), pool.WeightOfSide(PoolAssetSide_SOURCE))
+	require.Equal(t, uint32(80), pool.WeightOfSide(PoolAssetSide_DESTINATION))
+	require.Equal(t, "5000000000ujuno,2000000000uosmo", pool.FindAssetsBySide(PoolAssetSide_DESTINATION).String())
+
+	coins := []*types.Coin{
+		{Denom: denoms[0], Amount: types.NewInt(10)},
+		{Denom: denoms[1], Amount: types.NewInt(20)},
+		{Denom: denoms[2], Amount: types.NewInt(50)},
+		{Denom: "unknown", Amount: types.NewInt(1)},
+	}
+	require.Equal(t, "10uatom", pool.FilterCoinsBySide(coins, PoolAssetSide_SOURCE).String())
+	require.Equal(t, "50ujuno,20uosmo", pool.FilterCoinsBySide(coins, PoolAssetSide_DESTINATION).String())
+
+	// the deposits cover each asset once, the deposits of a side are sent by the same account
+	deposits := []*DepositAsset{
+		{Sender: "maker", Balance: coins[0]},
+		{Sender: "taker", Balance: coins[1]},
+		{Sender: "taker", Balance: coins[2]},
+	}
+	maker, taker, err := pool.GetDepositSenders(deposits)
+	require.NoError(t, err)
+	require.Equal(t, "maker", maker)
+	require.Equal(t, "taker", taker)
+
+	_, _, err = pool.GetDepositSenders(deposits[:2])
+	require.ErrorIs(t, err, ErrInvalidDenomPair)
+	_, _, err = pool.GetDepositSenders([]*DepositAsset{deposits[0], deposits[1], deposits[1]})
+	require.ErrorIs(t, err, ErrInvalidDenomPair)
+	_, _, err = pool.GetDepositSenders([]*DepositAsset{deposits[0], deposits[1], {Sender: "other", Balance: coins[2]}})
+	require.ErrorIs(t, err, ErrInvalidAddressPair)
+	_, _, err = pool.GetDepositSenders([]*DepositAsset{deposits[0], deposits[1], {Sender: "taker", Balance: coins[3]}})
+	require.ErrorIs(t, err, ErrNotFoundDenomInPool)
+
+	amm := NewInterchainMarketMaker(&pool)
+
+	out, err := amm.LeftSwap(types.Coin{Denom: denoms[0], Amount: types.NewInt(10_000_000)}, denoms[2])
+	require.NoError(t, err)
+	require.Equal(t, types.Coin{Denom: denoms[2], Amount: types.NewInt(19_269_288)}, *out)
+
+	in, err := amm.RightSwap(types.Coin{Denom: denoms[1], Amount: types.NewInt(20_000_000)}, types.Coin{Denom: denoms[2], Amount: types.NewInt(10_000_000)})
+	require.NoError(t, err)
+	require.Equal(t, types.Coin{Denom: denoms[1], Amount: types.NewInt(6_684_488)}, *in)
+
+	// a deposit of 1% of each asset issues 1% of the supply
+	poolTokens, err := amm.DepositMultiAsset(types.Coins{
+		{Denom: denoms[0], Amount: types.NewInt(10_000_000)},
+		{Denom: denoms[1], Amount: types.NewInt(20_000_000)},
+		{Denom: denoms[2], Amount: types.NewInt(50_000_000)},
+	})
+	require.NoError(t, err)
+	issued := types.ZeroInt()
+	for _, poolToken := range poolTokens {
+		issued = issued.Add(poolToken.Amount)
+	}
+	require.Equal(t, types.NewInt(80_000_000), issued)
+
+	// a withdrawal of 1% of the supply redeems 1% of each asset
+	outs, err := amm.MultiAssetWithdraw(types.Coin{Denom: poolId, Amount: types.NewInt(80_000_000)})
+	require.NoError(t, err)
+	require.Equal(t, []*types.Coin{
+		{Denom: denoms[0], Amount: types.NewInt(10_000_000)},
+		{Denom: denoms[1], Amount: types.NewInt(20_000_000)},
+		{Denom: denoms[2], Amount: types.NewInt(50_000_000)},
+	}, outs)
+
+	// 1000^0.2 * 2000^0.3 * 5000^0.5
+	expected := types.MustNewDecFromStr("2752.922598358332052096")
+	require.True(t, amm.Invariant().Sub(expected).Abs().LTE(types.NewDecWithPrec(1, 12)), amm.Invariant().String())
+}
+
+func TestStableSwapPool(t *testing.T) {
+	usdt := types.NewInt(1_000_000).Mul(types.NewIntFromUint64(1e18))
+	pool := InterchainLiquidityPool{
+		Id: "pool",
+		Assets: []*PoolAsset{
+			{Side: PoolAssetSide_SOURCE, Balance: &types.Coin{Denom: "uusdc", Amount: types.NewInt(1_000_000_000_000)}, Weight: 50, Decimal: 6},
+			{Side: PoolAssetSide_DESTINATION, Balance: &types.Coin{Denom: "ausdt", Amount: usdt}, Weight: 50, Decimal: 18},
+		},
+		Supply:        &types.Coin{Denom: "pool", Amount: types.NewInt(2_000_000_000_000)},
+		SwapFee:       30,
+		Status:        PoolStatus_ACTIVE,
+		PoolType:      PoolType_STABLE,
+		Amplification: NewAmplification(100),
+	}
+	amm := NewMarketMaker(&pool, 0)
+	require.IsType(t, &StableSwapMarketMaker{}, amm)
+
+	// the balances are normalized to 18 decimals, D of a balanced pool is the sum of the balances
+	invariant := amm.Invariant()
+	require.Equal(t, types.NewDec(2_000_000), invariant)
+	price, err := amm.MarketPrice("uusdc", "ausdt")
+	require.NoError(t, err)
+	require.Equal(t, types.NewDecWithPrec(1, 12), *price)
+
+	// a swap of 10% of a balance loses 0.05% besides the 0.3% fee, 9.3% under the weighted invariant
+	out, err := amm.LeftSwap(types.NewCoin("uusdc", types.NewInt(100_000_000_000)), "ausdt")
+	require.NoError(t, err)
+	expectedOut, _ := types.NewIntFromString("99650080568750348894768")
+	require.Equal(t, types.NewCoin("ausdt", expectedOut), *out)
+
+	// the input required for the output of the left swap is the input of the left swap
+	in, err := amm.RightSwap(types.NewCoin("uusdc", types.NewInt(200_000_000_000)), *out)
+	require.NoError(t, err)
+	require.Equal(t, types.NewCoin("uusdc", types.NewInt(100_000_000_000)), *in)
+	_, err = amm.RightSwap(types.NewCoin("uusdc", types.NewInt(99_000_000_000)), *out)
+	require.Error(t, err)
+
+	// the fees of the swap increase D
+	pool.AddAsset(types.NewCoin("uusdc", types.NewInt(100_000_000_000)))
+	pool.SubtractAsset(*out)
+	require.True(t, amm.Invariant().GT(invariant))
+
+	// a single asset deposit into the larger balance issues less than its share, less the imbalance fee
+	poolToken, err := amm.DepositSingleAsset(types.NewCoin("uusdc", types.NewInt(10_000_000_000)))
+	require.NoError(t, err)
+	require.True(t, poolToken.Amount.LT(types.NewInt(10_000_000_000)), poolToken.String())
+	require.True(t, poolToken.Amount.GT(types.NewInt(9_975_000_000)), poolToken.String())
+}
+
+func TestStableSwapDeposits(t *testing.T) {
+	newPool := func(status PoolStatus) *InterchainLiquidityPool {
+		return &InterchainLiquidityPool{
+			Id: "pool",
+			Assets: []*PoolAsset{
+				{Side: PoolAssetSide_SOURCE, Balance: &types.Coin{Denom: "aside", Amount: types.NewInt(1_000_000_000_000)}, Weight: 50, Decimal: 6},
+				{Side: PoolAssetSide_DESTINATION, Balance: &types.Coin{Denom: "bside", Amount: types.NewInt(1_000_000_000_000)}, Weight: 50, Decimal: 6},
+			},
+			Supply:        &types.Coin{Denom: "pool", Amount: types.NewInt(2_000_000_000_000)},
+			SwapFee:       30,
+			Status:        status,
+			PoolType:      PoolType_STABLE,
+			Amplification: NewAmplification(100),
+		}
+	}
+
+	// a single asset deposit withdrawn in all the assets pays about the fee of the swap of the same amount
+	deposit := types.NewCoin("aside", types.NewInt(100_000_000_000))
+	pool := newPool(PoolStatus_ACTIVE)
+	amm := NewStableSwapMarketMaker(pool, 100)
+	poolToken, err := amm.DepositSingleAsset(deposit)
+	require.NoError(t, err)
+	pool.AddAsset(deposit)
+	pool.AddPoolSupply(*poolToken)
+	outs, err := amm.MultiAssetWithdraw(*poolToken)
+	require.NoError(t, err)
+	require.Equal(t, "aside", outs[0].Denom)
+
+	swapped, err := NewStableSwapMarketMaker(newPool(PoolStatus_ACTIVE), 100).LeftSwap(deposit.Sub(*outs[0]), "bside")
+	require.NoError(t, err)
+	feeless := newPool(PoolStatus_ACTIVE)
+	feeless.SwapFee = 0
+	swappedWithoutFee, err := NewStableSwapMarketMaker(feeless, 100).LeftSwap(deposit.Sub(*outs[0]), "bside")
+	require.NoError(t, err)
+	swapFee := swappedWithoutFee.Amount.Sub(swapped.Amount)
+	imbalanceFee := swappedWithoutFee.Amount.Sub(outs[1].Amount)
+	require.True(t, imbalanceFee.MulRaw(100).GTE(swapFee.MulRaw(99)), "imbalance fee %s swap fee %s", imbalanceFee, swapFee)
+
+	// a proportional deposit pays no imbalance fee
+	proportional, err := NewStableSwapMarketMaker(newPool(PoolStatus_ACTIVE), 100).DepositMultiAsset(types.NewCoins(deposit, types.NewCoin("bside", deposit.Amount)))
+	require.NoError(t, err)
+	require.Equal(t, types.NewInt(100_000_000_000), proportional[0].Amount)
+
+	// the single asset deposits need an active pool
+	_, err = NewStableSwapMarketMaker(newPool(PoolStatus_INITIALIZED), 100).DepositSingleAsset(deposit)
+	require.ErrorIs(t, err, ErrNotReadyForSwap)
+
+	// the initial deposit issues the invariant in units, split by the weights of the assets
+	initial, err := NewStableSwapMarketMaker(newPool(PoolStatus_INITIALIZED), 100).DepositMultiAsset(types.NewCoins(
+		types.NewCoin("aside", types.NewInt(1_000_000_000_000)), types.NewCoin("bside", types.NewInt(1_000_000_000_000)),
+	))
+	require.NoError(t, err)
+	require.Len(t, initial, 2)
+	for _, poolToken := range initial {
+		require.Equal(t, types.NewCoin("pool", types.NewInt(1_000_000)), *poolToken)
+	}
+}
+
+func TestStableSwapAmplification(t *testing.T) {
+	denoms := []string{"aside", "bside", "cside"}
+	pool := InterchainLiquidityPool{
+		Id:       "pool",
+		Supply:   &types.Coin{Denom: "pool", Amount: types.NewInt(3_000_000)},
+		Status:   PoolStatus_ACTIVE,
+		PoolType: PoolType_STABLE,
+	}
+	for _, denom := range denoms {
+		pool.Assets = append(pool.Assets, &PoolAsset{Balance: &types.Coin{Denom: denom, Amount: types.NewInt(1_000_000)}, Weight: 33, Decimal: 6})
+	}
+
+	// the output of a swap increases with the amplification, up to the constant sum
+	previous := types.ZeroInt()
+	for _, a := range []uint64{1, 10, 100, 1000} {
+		out, err := NewStableSwapMarketMaker(&pool, a).LeftSwap(types.NewCoin("aside", types.NewInt(100_000)), "bside")
+		require.NoError(t, err)
+		require.True(t, out.Amount.GT(previous), out.String())
+		require.True(t, out.Amount.LT(types.NewInt(100_000)), out.String())
+		previous = out.Amount
+	}
+
+	_, err := NewStableSwapMarketMaker(&pool, 0).LeftSwap(types.NewCoin("aside", types.NewInt(100_000)), "bside")
+	require.ErrorIs(t, err, ErrInvalidAmplification)
+
+	// the amplification ramps linearly
+	pool.Amplification = &Amplification{InitialA: 100, FutureA: 200, InitialTime: 1000, FutureTime: 2000}
+	require.Equal(t, uint64(100), pool.Amplification.At(500))
+	require.Equal(t, uint64(150), pool.Amplification.At(1500))
+	require.Equal(t, uint64(200), pool.Amplification.At(3000))
+	pool.Amplification = &Amplification{InitialA: 200, FutureA: 100, InitialTime: 1000, FutureTime: 2000}
+	require.Equal(t, uint64(175), pool.Amplification.At(1250))
+	require.Equal(t, uint64(175), NewMarketMaker(&pool, 1250).(*StableSwapMarketMaker).Amplification)
+
+	require.NoError(t, ValidateAmplificationRamp(100, 1000, 0, MinAmplificationRampDuration))
+	require.ErrorIs(t, ValidateAmplificationRamp(100, 1001, 0, MinAmplificationRampDuration), ErrInvalidAmplificationRamp)
+	require.ErrorIs(t, ValidateAmplificationRamp(100, 9, 0, MinAmplificationRampDuration), ErrInvalidAmplificationRamp)
+	require.ErrorIs(t, ValidateAmplificationRamp(100, 200, 0, MinAmplificationRampDuration-1), ErrInvalidAmplificationRamp)
+	require.ErrorIs(t, ValidateAmplificationRamp(100, 0, 0, MinAmplificationRampDuration), ErrInvalidAmplification)
+}
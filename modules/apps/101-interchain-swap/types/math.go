@@ -0,0 +1,185 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// maxPowSeriesTerms bounds the number of terms of the power series. The base of the series is
+	// range reduced into [powSeriesMin, powSeriesMax], where the terms decrease by a factor 2 at least.
+	maxPowSeriesTerms = 128
+	// maxLnSeriesTerms bounds the number of terms of the logarithm series, their ratio is below 0.03.
+	maxLnSeriesTerms = 64
+	// maxExpSeriesTerms bounds the number of terms of the exponential series, the argument of the
+	// series is below ln(2)/2.
+	maxExpSeriesTerms = 64
+)
+
+var (
+	powSeriesMin = types.NewDecWithPrec(5, 1)
+	powSeriesMax = types.NewDecWithPrec(15, 1)
+
+	// ln2 is ln(2) with 36 decimals, the multiples of ln(2) are rounded to 18 decimals once.
+	ln2, _ = new(big.Int).SetString("693147180559945309417232121458176568", 10)
+	// the range of the mantissa of the logarithm, [sqrt(2)/2, sqrt(2))
+	sqrt2     = types.MustNewDecFromStr("1.414213562373095049")
+	halfSqrt2 = types.MustNewDecFromStr("0.707106781186547524")
+
+	// e^x overflows sdk.Dec above maxExp and rounds to zero below minExp
+	maxExp = types.NewDec(176)
+	minExp = types.NewDec(-45)
+)
+
+// Ln returns the natural logarithm of x. It panics if x is not positive.
+//
+// x is range reduced to x = m * 2^k with m in [sqrt(2)/2, sqrt(2)), and ln(m) is computed with the
+// series ln(m) = 2 * sum z^(2n+1) / (2n+1), z = (m - 1) / (m + 1), which converges as 0.03^n.
+// The result is within 2e-17 of ln(x) besides the resolution of x: the logarithm amplifies the
+// 1e-18 resolution of sdk.Dec by 1/x for the small values.
+func Ln(x types.Dec) types.Dec {
+	if !x.IsPositive() {
+		panic(fmt.Errorf("ln: non positive argument %s", x))
+	}
+
+	k := int64(x.BigInt().BitLen() - types.OneDec().BigInt().BitLen())
+	m := mulPow2(x, -k)
+	for m.GTE(sqrt2) {
+		m = m.QuoInt64(2)
+		k++
+	}
+	for m.LT(halfSqrt2) {
+		m = m.MulInt64(2)
+		k--
+	}
+
+	z := m.Sub(types.OneDec()).Quo(m.Add(types.OneDec()))
+	z2 := z.Mul(z)
+	power := z
+	sum := z
+	for n := int64(1); n <= maxLnSeriesTerms; n++ {
+		power = power.Mul(z2)
+		term := power.QuoInt64(2*n + 1)
+		if term.IsZero() {
+			break
+		}
+		sum = sum.Add(term)
+	}
+	return sum.MulInt64(2).Add(mulLn2(k))
+}
+
+// Exp returns e^x. It panics if the result overflows sdk.Dec, above e^176.
+//
+// x is range reduced to x = k * ln(2) + r with |r| <= ln(2)/2, e^x = 2^k * e^r, and e^r is computed
+// with the Taylor series. The relative error of the result is below 1e-17 besides the 1e-18
+// resolution of sdk.Dec.
+func Exp(x types.Dec) types.Dec {
+	if x.GT(maxExp) {
+		panic(fmt.Errorf("exp: overflow of e^%s", x))
+	}
+	if x.LT(minExp) {
+		return types.ZeroDec()
+	}
+
+	k := x.Quo(mulLn2(1)).RoundInt64()
+	r := x.Sub(mulLn2(k))
+
+	term := types.OneDec()
+	sum := types.OneDec()
+	for i := int64(1); i <= maxExpSeriesTerms; i++ {
+		term = term.Mul(r).QuoInt64(i)
+		if term.IsZero() {
+			break
+		}
+		sum = sum.Add(term)
+	}
+	return mulPow2(sum, k)
+}
+
+// mulLn2 returns k * ln(2) rounded to 18 decimals.
+func mulLn2(k int64) types.Dec {
+	product := new(big.Int).Mul(big.NewInt(k), ln2)
+	// round half away from zero the 18 extra decimals
+	half := new(big.Int).Div(types.OneDec().BigInt(), big.NewInt(2))
+	if product.Sign() < 0 {
+		half.Neg(half)
+	}
+	product.Add(product, half)
+	product.Quo(product, types.OneDec().BigInt())
+	return types.NewDecFromBigIntWithPrec(product, types.Precision)
+}
+
+// mulPow2 returns x * 2^k.
+func mulPow2(x types.Dec, k int64) types.Dec {
+	if k >= 0 {
+		return x.MulInt(types.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), uint(k))))
+	}
+	return x.Quo(types.NewDecFromBigInt(new(big.Int).Lsh(big.NewInt(1), uint(-k))))
+}
+
+// Pow returns base^exp computed with the fixed-point arithmetic of sdk.Dec, it is deterministic across
+// architectures. It panics if the base is negative.
+//
+// The integer part of the exponent is computed by repeated squaring, the fractional part f with the
+// binomial series (1 + x)^f = sum C(f, k) x^k. The base of the series is range reduced into [0.5, 1.5]
+// with square roots: b^f = sqrt(b)^2f. The series is truncated once a term rounds to zero.
+//
+// Besides the 1e-18 resolution of sdk.Dec, the relative error of the result is below 1e-16 for a base
+// in [0.1, 1e18] and below 1e-14 for a base in [1e-9, 0.1]: |Pow(b, e) - b^e| <= 1e-18 + eps * b^e.
+// The integer power rounds to 18 decimals at each multiplication, a large integer exponent amplifies
+// the relative error of the base accordingly.
+func Pow(base, exp types.Dec) types.Dec {
+	if base.IsNegative() {
+		panic(fmt.Errorf("pow: negative base %s", base))
+	}
+	if exp.IsNegative() {
+		return types.OneDec().Quo(Pow(base, exp.Neg()))
+	}
+	if base.IsZero() {
+		if exp.IsZero() {
+			return types.OneDec()
+		}
+		return types.ZeroDec()
+	}
+
+	integer := exp.TruncateInt()
+	result := base.Power(integer.Uint64())
+	fraction := exp.Sub(types.NewDecFromInt(integer))
+	if fraction.IsZero() {
+		return result
+	}
+
+	// b^f = sqrt(b)^2f, the integer part of 2f is moved to the result
+	for base.LT(powSeriesMin) || base.GT(powSeriesMax) {
+		root, err := base.ApproxSqrt()
+		if err != nil {
+			panic(fmt.Errorf("pow: %w", err))
+		}
+		base = root
+		fraction = fraction.MulInt64(2)
+		if fraction.GTE(types.OneDec()) {
+			result = result.Mul(base)
+			fraction = fraction.Sub(types.OneDec())
+		}
+	}
+	return result.Mul(powSeries(base, fraction))
+}
+
+// powSeries returns base^fraction for a base in [0.5, 1.5] and a fraction in [0, 1) with the
+// binomial series.
+func powSeries(base, fraction types.Dec) types.Dec {
+	x := base.Sub(types.OneDec())
+	term := types.OneDec()
+	sum := types.OneDec()
+	for k := int64(1); k <= maxPowSeriesTerms; k++ {
+		// C(f, k) x^k = C(f, k-1) x^(k-1) * (f - k + 1) x / k
+		term = term.Mul(fraction.Sub(types.NewDec(k - 1))).Mul(x).QuoInt64(k)
+		if term.IsZero() {
+			break
+		}
+		sum = sum.Add(term)
+	}
+	return sum
+}
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPowGoldenVectors checks Pow against reference values computed with 80 digits of precision and
+// rounded to 18 decimals, within the documented error bound.
+func TestPowGoldenVectors(t *testing.T) {
+	testCases := []struct {
+		base     string
+		exp      string
+		expected string
+	}{
+		{"0", "0", "1.000000000000000000"},
+		{"0", "0.5", "0.000000000000000000"},
+		{"1", "0.123", "1.000000000000000000"},
+		{"2", "0", "1.000000000000000000"},
+		{"2", "10", "1024.000000000000000000"},
+		{"2", "0.5", "1.414213562373095049"},
+		{"0.5", "0.5", "0.707106781186547524"},
+		{"0.25", "0.333333333333333333", "0.629960524947436583"},
+		{"0.99", "0.01", "0.999899501691758332"},
+		{"0.1", "0.75", "0.177827941003892280"},
+		{"0.001", "0.2", "0.251188643150958011"},
+		{"0.000000001", "0.5", "0.000031622776601684"},
+		{"1.0001", "0.9999", "1.000099989999500067"},
+		{"1.5", "1.5", "1.837117307087383574"},
+		{"1.7", "0.3", "1.172558924272541978"},
+		{"3.14159", "2.71828", "22.459059142513843072"},
+		{"10", "0.25", "1.778279410038922801"},
+		{"1000", "0.8", "251.188643150958011109"},
+		{"123456.789", "0.5", "351.364182864446216167"},
+		{"1000000000", "0.333333333333333333", "999.999999999999993092"},
+		{"1000000000000000000", "0.1", "63.095734448019324943"},
+		{"4", "-0.5", "0.500000000000000000"},
+		{"0.8", "-2.5", "1.746928107421710700"},
+	}
+
+	for _, tc := range testCases {
+		base := types.MustNewDecFromStr(tc.base)
+		expected := types.MustNewDecFromStr(tc.expected)
+		eps := types.NewDecWithPrec(1, 16)
+		if base.LT(types.NewDecWithPrec(1, 1)) {
+			eps = types.NewDecWithPrec(1, 14)
+		}
+		// one more ulp for the rounding of the reference value
+		tolerance := types.NewDecWithPrec(2, 18).Add(expected.Mul(eps))
+
+		result := Pow(base, types.MustNewDecFromStr(tc.exp))
+		require.True(t, result.Sub(expected).Abs().LTE(tolerance), "%s^%s = %s, expected %s", tc.base, tc.exp, result, tc.expected)
+	}
+}
+
+func TestPowNegativeBase(t *testing.T) {
+	require.Panics(t, func() {
+		Pow(types.NewDec(-2), types.NewDecWithPrec(5, 1))
+	})
+}
+
+// refPrec is the precision of the math/big reference values of the property tests.
+const refPrec = 256
+
+func decToBigFloat(d types.Dec) *big.Float {
+	f := new(big.Float).SetPrec(refPrec).SetInt(d.BigInt())
+	return f.Quo(f, new(big.Float).SetPrec(refPrec).SetInt(types.OneDec().BigInt()))
+}
+
+func bigFloatToDec(f *big.Float) types.Dec {
+	scaled := new(big.Float).SetPrec(refPrec).Mul(f, new(big.Float).SetInt(types.OneDec().BigInt()))
+	i, _ := scaled.Int(nil)
+	return types.NewDecFromBigIntWithPrec(i, types.Precision)
+}
+
+// bigExp returns e^x: e^x = (e^(x/2^20))^(2^20), the reduced argument is below 2^-12.
+func bigExp(x *big.Float) *big.Float {
+	const halvings = 20
+	y := new(big.Float).SetPrec(refPrec).SetMantExp(x, -halvings)
+	sum := new(big.Float).SetPrec(refPrec).SetInt64(1)
+	term := new(big.Float).SetPrec(refPrec).SetInt64(1)
+	for i := int64(1); i < 40; i++ {
+		term.Mul(term, y)
+		term.Quo(term, new(big.Float).SetInt64(i))
+		sum.Add(sum, term)
+	}
+	for i := 0; i < halvings; i++ {
+		sum.Mul(sum, sum)
+	}
+	return sum
+}
+
+// bigLn returns ln(x) with the Halley iteration y = y + 2 (x - e^y) / (x + e^y).
+func bigLn(x *big.Float) *big.Float {
+	f, _ := x.Float64()
+	y := new(big.Float).SetPrec(refPrec).SetFloat64(math.Log(f))
+	for i := 0; i < 5; i++ {
+		ey := bigExp(y)
+		num := new(big.Float).SetPrec(refPrec).Sub(x, ey)
+		den := new(big.Float).SetPrec(refPrec).Add(x, ey)
+		num.Quo(num, den)
+		y.Add(y, num.Mul(num, big.NewFloat(2)))
+	}
+	return y
+}
+
+// randomDec returns a positive decimal with a random number of bits, from 1e-18 to 1e57.
+func randomDec(r *rand.Rand) types.Dec {
+	i := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), uint(1+r.Intn(250))))
+	return types.NewDecFromBigIntWithPrec(i.Add(i, big.NewInt(1)), types.Precision)
+}
+
+func TestLnProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tolerance := types.NewDecWithPrec(2, 17)
+	for i := 0; i < 300; i++ {
+		x := randomDec(r)
+		expected := bigFloatToDec(bigLn(decToBigFloat(x)))
+		result := Ln(x)
+		require.True(t, result.Sub(expected).Abs().LTE(tolerance), "ln(%s) = %s, expected %s", x, result, expected)
+	}
+
+	// ln(ab) = ln(a) + ln(b), ln(2^k) = k ln(2)
+	a, b := types.MustNewDecFromStr("123.456"), types.MustNewDecFromStr("0.0789")
+	require.True(t, Ln(a.Mul(b)).Sub(Ln(a).Add(Ln(b))).Abs().LTE(tolerance.MulInt64(3)))
+	require.Equal(t, mulLn2(64), Ln(types.NewDecFromBigInt(new(big.Int).Lsh(big.NewInt(1), 64))))
+	require.True(t, Ln(types.OneDec()).IsZero())
+
+	require.Panics(t, func() { Ln(types.ZeroDec()) })
+	require.Panics(t, func() { Ln(types.NewDec(-1)) })
+}
+
+func TestExpProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	eps := types.NewDecWithPrec(1, 17)
+	for i := 0; i < 300; i++ {
+		// x in [-45, 176]
+		x := types.NewDecFromBigIntWithPrec(new(big.Int).Rand(r, new(big.Int).Mul(big.NewInt(221), types.OneDec().BigInt())), types.Precision).Sub(types.NewDec(45))
+		expected := bigFloatToDec(bigExp(decToBigFloat(x)))
+		result := Exp(x)
+		tolerance := types.NewDecWithPrec(1, 18).Add(expected.Mul(eps))
+		require.True(t, result.Sub(expected).Abs().LTE(tolerance), "exp(%s) = %s, expected %s", x, result, expected)
+	}
+
+	// e^ln(x) = x
+	for _, x := range []string{"0.5", "1", "2", "1000", "123456.789"} {
+		d := types.MustNewDecFromStr(x)
+		require.True(t, Exp(Ln(d)).Sub(d).Abs().LTE(d.Mul(types.NewDecWithPrec(1, 16))), x)
+	}
+	require.Equal(t, types.OneDec(), Exp(types.ZeroDec()))
+	require.True(t, Exp(types.NewDec(-100)).IsZero())
+	require.Panics(t, func() { Exp(types.NewDec(177)) })
+}
@@ -9,21 +9,21 @@ const TypeMsgMakeMultiAssetDeposit = "make_multi_asset_deposit"
 
 var _ sdk.Msg = &MsgMakeMultiAssetDepositRequest{}
 
+// NewMsgMakeMultiAssetDeposit creates a deposit of each token by the sender of the same index, the
+// first sender is the maker of the order.
 func NewMsgMakeMultiAssetDeposit(poolId string, senders []string, tokens sdk.Coins, port, channel string) *MsgMakeMultiAssetDepositRequest {
+	deposits := []*DepositAsset{}
+	for index := range tokens {
+		deposits = append(deposits, &DepositAsset{
+			Sender:  senders[index],
+			Balance: &tokens[index],
+		})
+	}
 	return &MsgMakeMultiAssetDepositRequest{
-		PoolId: poolId,
-		Deposits: []*DepositAsset{
-			{
-				Sender:  senders[0],
-				Balance: &tokens[0],
-			},
-			{
-				Sender:  senders[1],
-				Balance: &tokens[1],
-			},
-		},
-		Port:    port,
-		Channel: channel,
+		PoolId:   poolId,
+		Deposits: deposits,
+		Port:     port,
+		Channel:  channel,
 	}
 }
 
@@ -52,7 +52,7 @@ func (msg *MsgMakeMultiAssetDepositRequest) GetSignBytes() []byte {
 }
 
 func (msg *MsgMakeMultiAssetDepositRequest) ValidateBasic() error {
-	if len(msg.Deposits) != 2 {
+	if len(msg.Deposits) < MinPoolAssets || len(msg.Deposits) > MaxPoolAssets {
 		return ErrInvalidLiquidityPair
 	}
 	_, err := sdk.AccAddressFromBech32(msg.Deposits[0].Sender)
@@ -60,6 +60,18 @@ func (msg *MsgMakePoolRequest) GetLiquidityDenoms() []string {
 	return denoms
 }
 
+// GetLiquidityBySide returns the liquidity contributed by the chain of a side, the maker chain
+// contributes the source assets and the counterparty chain the destination assets.
+func (msg *MsgMakePoolRequest) GetLiquidityBySide(side PoolAssetSide) sdk.Coins {
+	coins := []sdk.Coin{}
+	for _, asset := range msg.Liquidity {
+		if asset.Side == side {
+			coins = append(coins, *asset.Balance)
+		}
+	}
+	return sdk.NewCoins(coins...)
+}
+
 func (msg *MsgMakePoolRequest) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Creator)
 	if err != nil {
@@ -68,6 +80,9 @@ func (msg *MsgMakePoolRequest) ValidateBasic() error {
 	if err := ValidateLiquidityBasic(msg.Liquidity); err != nil {
 		return err
 	}
+	if err := ValidatePoolType(msg.PoolType, msg.Amplification); err != nil {
+		return err
+	}
 	if msg.SwapFee < 0 || msg.SwapFee > 10000 {
 		return ErrInvalidSwapFee
 	}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/sideprotocol/ibcswap/v6/testing/testutil/sample"
@@ -163,6 +164,97 @@ func TestMsgCreatePool_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidWeightPair,
 		},
+		{
+			name: "valid multi asset pool",
+			msg: MsgMakePoolRequest{
+				Creator:             sample.AccAddress(),
+				CounterPartyCreator: sample.AccAddress(),
+				SourcePort:          "interchainswap",
+				SourceChannel:       "interchainswap-1",
+				Liquidity:           multiAssetLiquidity(20, 30, 50),
+			},
+		},
+		{
+			name: "too many assets",
+			msg: MsgMakePoolRequest{
+				Creator:             sample.AccAddress(),
+				CounterPartyCreator: sample.AccAddress(),
+				SourcePort:          "interchainswap",
+				SourceChannel:       "interchainswap-1",
+				Liquidity:           multiAssetLiquidity(10, 10, 10, 10, 10, 10, 10, 10, 20),
+			},
+			err: ErrInvalidDenomPair,
+		},
+		{
+			name: "zero weight",
+			msg: MsgMakePoolRequest{
+				Creator:             sample.AccAddress(),
+				CounterPartyCreator: sample.AccAddress(),
+				SourcePort:          "interchainswap",
+				SourceChannel:       "interchainswap-1",
+				Liquidity:           multiAssetLiquidity(50, 0, 50),
+			},
+			err: ErrInvalidWeight,
+		},
+		{
+			name: "duplicated denom",
+			msg: MsgMakePoolRequest{
+				Creator:             sample.AccAddress(),
+				CounterPartyCreator: sample.AccAddress(),
+				SourcePort:          "interchainswap",
+				SourceChannel:       "interchainswap-1",
+				Liquidity:           append(multiAssetLiquidity(20, 30), multiAssetLiquidity(50)...),
+			},
+			err: ErrInvalidDenomPair,
+		},
+		{
+			name: "valid stable pool",
+			msg: MsgMakePoolRequest{
+				Creator:             sample.AccAddress(),
+				CounterPartyCreator: sample.AccAddress(),
+				SourcePort:          "interchainswap",
+				SourceChannel:       "interchainswap-1",
+				Liquidity:           multiAssetLiquidity(30, 30, 40),
+				PoolType:            PoolType_STABLE,
+				Amplification:       100,
+			},
+		},
+		{
+			name: "stable pool without amplification",
+			msg: MsgMakePoolRequest{
+				Creator:             sample.AccAddress(),
+				CounterPartyCreator: sample.AccAddress(),
+				SourcePort:          "interchainswap",
+				SourceChannel:       "interchainswap-1",
+				Liquidity:           multiAssetLiquidity(50, 50),
+				PoolType:            PoolType_STABLE,
+			},
+			err: ErrInvalidAmplification,
+		},
+		{
+			name: "amplified weighted pool",
+			msg: MsgMakePoolRequest{
+				Creator:             sample.AccAddress(),
+				CounterPartyCreator: sample.AccAddress(),
+				SourcePort:          "interchainswap",
+				SourceChannel:       "interchainswap-1",
+				Liquidity:           multiAssetLiquidity(50, 50),
+				Amplification:       100,
+			},
+			err: ErrInvalidAmplification,
+		},
+		{
+			name: "unknown pool type",
+			msg: MsgMakePoolRequest{
+				Creator:             sample.AccAddress(),
+				CounterPartyCreator: sample.AccAddress(),
+				SourcePort:          "interchainswap",
+				SourceChannel:       "interchainswap-1",
+				Liquidity:           multiAssetLiquidity(50, 50),
+				PoolType:            PoolType(2),
+			},
+			err: ErrInvalidPoolType,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -175,3 +267,19 @@ func TestMsgCreatePool_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+// multiAssetLiquidity returns the liquidity of a pool with an asset of each weight.
+func multiAssetLiquidity(weights ...uint32) []*PoolAsset {
+	liquidity := []*PoolAsset{}
+	for index, weight := range weights {
+		liquidity = append(liquidity, &PoolAsset{
+			Balance: &sdk.Coin{
+				Denom:  fmt.Sprintf("side%d", index),
+				Amount: sdk.NewInt(1000),
+			},
+			Weight:  weight,
+			Decimal: 6,
+		})
+	}
+	return liquidity
+}
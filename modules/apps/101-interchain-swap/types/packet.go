@@ -1,7 +1,13 @@
 package types
 
 import (
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsmod "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	atomicswaptypes "github.com/sideprotocol/ibcswap/v6/modules/apps/100-atomic-swap/types"
 )
 
 func NewInterchainSwapPacketData(
@@ -27,6 +33,98 @@ func (pd IBCSwapPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&pd))
 }
 
+// PacketCodec encodes the packet data of a channel, the messages and state changes it carries and the
+// acknowledgements with the encoding of the negotiated channel version: JSON on the v1 channels and
+// deterministic protobuf on the v2 channels.
+type PacketCodec struct {
+	proto bool
+}
+
+// NewPacketCodec returns the packet codec of a channel with the given version.
+func NewPacketCodec(version string) PacketCodec {
+	return PacketCodec{proto: IsProtoVersion(version)}
+}
+
+// MarshalMsg encodes a message or state change carried by the packet data, or the result of an acknowledgement.
+func (c PacketCodec) MarshalMsg(msg codec.ProtoMarshaler) ([]byte, error) {
+	if c.proto {
+		return ModuleCdc.Marshal(msg)
+	}
+	return ModuleCdc.MarshalJSON(msg)
+}
+
+// MustMarshalMsg encodes a message or state change carried by the packet data. It panics on error.
+func (c PacketCodec) MustMarshalMsg(msg codec.ProtoMarshaler) []byte {
+	bz, err := c.MarshalMsg(msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// UnmarshalMsg decodes a message or state change carried by the packet data, or the result of an acknowledgement.
+func (c PacketCodec) UnmarshalMsg(bz []byte, msg codec.ProtoMarshaler) error {
+	if c.proto {
+		return ModuleCdc.Unmarshal(bz, msg)
+	}
+	return ModuleCdc.UnmarshalJSON(bz, msg)
+}
+
+// MarshalPacketData encodes the packet data sent on the channel.
+func (c PacketCodec) MarshalPacketData(pd IBCSwapPacketData) []byte {
+	if c.proto {
+		return ModuleCdc.MustMarshal(&pd)
+	}
+	return pd.GetBytes()
+}
+
+// UnmarshalPacketData decodes the packet data received on the channel.
+func (c PacketCodec) UnmarshalPacketData(bz []byte) (IBCSwapPacketData, error) {
+	var pd IBCSwapPacketData
+	if err := c.UnmarshalMsg(bz, &pd); err != nil {
+		return IBCSwapPacketData{}, err
+	}
+	return pd, nil
+}
+
+// NewAcknowledgement returns the acknowledgement written for a packet received on the channel.
+func (c PacketCodec) NewAcknowledgement(ack channeltypes.Acknowledgement) ibcexported.Acknowledgement {
+	if c.proto {
+		return protoAcknowledgement{ack: ack}
+	}
+	return ack
+}
+
+// UnmarshalAcknowledgement decodes the acknowledgement of a packet sent on the channel.
+func (c PacketCodec) UnmarshalAcknowledgement(bz []byte) (channeltypes.Acknowledgement, error) {
+	var ack channeltypes.Acknowledgement
+	if err := c.UnmarshalMsg(bz, &ack); err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+	// an empty message is a valid protobuf encoding
+	if c.proto && ack.Response == nil {
+		return channeltypes.Acknowledgement{}, errorsmod.Wrap(ErrInvalidRequest, "acknowledgement response cannot be empty")
+	}
+	return ack, nil
+}
+
+// protoAcknowledgement is an acknowledgement written with the protobuf encoding.
+type protoAcknowledgement struct {
+	ack channeltypes.Acknowledgement
+}
+
+var _ ibcexported.Acknowledgement = protoAcknowledgement{}
+
+// Success implements the Acknowledgement interface.
+func (a protoAcknowledgement) Success() bool {
+	return a.ack.Success()
+}
+
+// Acknowledgement implements the Acknowledgement interface.
+func (a protoAcknowledgement) Acknowledgement() []byte {
+	return ModuleCdc.MustMarshal(&a.ack)
+}
+
 type AckData[T any, U any] struct {
 	Req T
 	Res U
@@ -40,3 +138,19 @@ func (s *StateChange) FindOutByDenom(denom string) (*sdk.Coin, error) {
 	}
 	return nil, ErrInvalidDenom
 }
+
+// NewErrorAcknowledgement encodes the registered error of a failed pool packet as the error of the
+// acknowledgement, in the same format as the atomic swap module.
+func NewErrorAcknowledgement(err error) channeltypes.Acknowledgement {
+	return atomicswaptypes.NewErrorAcknowledgement(err)
+}
+
+// ParseErrorAcknowledgement returns the error of a pool packet acknowledgement and whether it is an error,
+// plain error strings are kept as the reason.
+func ParseErrorAcknowledgement(ack channeltypes.Acknowledgement) (*ErrorAcknowledgement, bool) {
+	payload, ok := atomicswaptypes.ParseErrorAcknowledgement(ack)
+	if !ok {
+		return nil, false
+	}
+	return &ErrorAcknowledgement{Codespace: payload.Codespace, Code: payload.Code, Reason: payload.Reason}, true
+}
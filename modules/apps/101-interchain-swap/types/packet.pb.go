@@ -41,6 +41,7 @@ const (
 	MULTI_WITHDRAW       SwapMessageType = 8
 	LEFT_SWAP            SwapMessageType = 9
 	RIGHT_SWAP           SwapMessageType = 10
+	AMPLIFICATION_RAMP   SwapMessageType = 11
 )
 
 var SwapMessageType_name = map[int32]string{
@@ -55,6 +56,7 @@ var SwapMessageType_name = map[int32]string{
 	8:  "TYPE_MULTI_WITHDRAW",
 	9:  "TYPE_LEFT_SWAP",
 	10: "TYPE_RIGHT_SWAP",
+	11: "TYPE_AMPLIFICATION_RAMP",
 }
 
 var SwapMessageType_value = map[string]int32{
@@ -69,6 +71,7 @@ var SwapMessageType_value = map[string]int32{
 	"TYPE_MULTI_WITHDRAW":       8,
 	"TYPE_LEFT_SWAP":            9,
 	"TYPE_RIGHT_SWAP":           10,
+	"TYPE_AMPLIFICATION_RAMP":   11,
 }
 
 func (x SwapMessageType) String() string {
@@ -234,10 +237,74 @@ func (m *IBCSwapPacketData) GetMemo() string {
 	return ""
 }
 
+// ErrorAcknowledgement is the payload of the error acknowledgement written when a swap packet
+// fails on the receiving chain. The reason is the description of the registered error, it does
+// not depend on the error context so the acknowledgement is deterministic.
+type ErrorAcknowledgement struct {
+	Codespace string `protobuf:"bytes,1,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code      uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ErrorAcknowledgement) Reset()         { *m = ErrorAcknowledgement{} }
+func (m *ErrorAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*ErrorAcknowledgement) ProtoMessage()    {}
+func (*ErrorAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23c8ddc04cfb119f, []int{2}
+}
+func (m *ErrorAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ErrorAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorAcknowledgement.Merge(m, src)
+}
+func (m *ErrorAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorAcknowledgement proto.InternalMessageInfo
+
+func (m *ErrorAcknowledgement) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *ErrorAcknowledgement) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ErrorAcknowledgement) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("ibc.applications.interchain_swap.v1.SwapMessageType", SwapMessageType_name, SwapMessageType_value)
 	proto.RegisterType((*StateChange)(nil), "ibc.applications.interchain_swap.v1.StateChange")
 	proto.RegisterType((*IBCSwapPacketData)(nil), "ibc.applications.interchain_swap.v1.IBCSwapPacketData")
+	proto.RegisterType((*ErrorAcknowledgement)(nil), "ibc.applications.interchain_swap.v1.ErrorAcknowledgement")
 }
 
 func init() {
@@ -245,49 +312,54 @@ func init() {
 }
 
 var fileDescriptor_23c8ddc04cfb119f = []byte{
-	// 668 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
-	0x18, 0xc5, 0xe3, 0x24, 0xe4, 0x5e, 0x26, 0x97, 0x90, 0x3b, 0x20, 0xae, 0xf1, 0xc2, 0xf2, 0x85,
-	0x56, 0x4a, 0x8b, 0xf0, 0x10, 0xa8, 0x5a, 0x75, 0x19, 0x12, 0x03, 0x56, 0x03, 0x89, 0x12, 0x23,
-	0xd4, 0x6e, 0xa2, 0xb1, 0x33, 0x35, 0x23, 0x12, 0x8f, 0x95, 0x99, 0x80, 0x78, 0x83, 0x2a, 0x2b,
-	0x5e, 0x20, 0xab, 0x2e, 0xfa, 0x2a, 0x5d, 0xb2, 0xec, 0xb2, 0x82, 0x17, 0xe8, 0x23, 0x54, 0x1e,
-	0x47, 0x4e, 0x02, 0x48, 0x74, 0xf7, 0xf9, 0x7c, 0xbf, 0x33, 0x67, 0xfe, 0x79, 0xc0, 0x0e, 0x75,
-	0x3d, 0x84, 0xc3, 0xb0, 0x47, 0x3d, 0x2c, 0x28, 0x0b, 0x38, 0xa2, 0x81, 0x20, 0x03, 0xef, 0x1c,
-	0xd3, 0xa0, 0xc3, 0xaf, 0x70, 0x88, 0x2e, 0xcb, 0x28, 0xc4, 0xde, 0x05, 0x11, 0x66, 0x38, 0x60,
-	0x82, 0xc1, 0x4d, 0xea, 0x7a, 0xe6, 0xac, 0xc3, 0x7c, 0xe0, 0x30, 0x2f, 0xcb, 0xda, 0xba, 0xcf,
-	0x98, 0xdf, 0x23, 0x48, 0x5a, 0xdc, 0xe1, 0x67, 0x84, 0x83, 0xeb, 0xd8, 0xaf, 0xad, 0xfa, 0xcc,
-	0x67, 0xb2, 0x44, 0x51, 0x35, 0x51, 0x75, 0x8f, 0xf1, 0x3e, 0xe3, 0xc8, 0xc5, 0x9c, 0xa0, 0xcb,
-	0xb2, 0x4b, 0x04, 0x2e, 0x23, 0x8f, 0xd1, 0x20, 0xee, 0x6f, 0xdc, 0xa4, 0x41, 0xbe, 0x2d, 0xb0,
-	0x20, 0xd5, 0x73, 0x1c, 0xf8, 0x04, 0xbe, 0x02, 0x69, 0x1a, 0xa8, 0x8a, 0x91, 0x29, 0xe5, 0x77,
-	0xd7, 0xcd, 0xd8, 0x6c, 0x46, 0x66, 0x73, 0x62, 0x36, 0xab, 0x8c, 0x06, 0xad, 0x34, 0x0d, 0xe0,
-	0x16, 0xc8, 0xb0, 0xa1, 0x50, 0xd3, 0xcf, 0xb1, 0x11, 0x05, 0xdf, 0x03, 0x10, 0x32, 0xd6, 0x73,
-	0xd8, 0x05, 0x09, 0xb8, 0x9a, 0x79, 0xce, 0x33, 0x03, 0xc3, 0x35, 0x90, 0x8b, 0xbe, 0xec, 0xae,
-	0x9a, 0x35, 0x94, 0xd2, 0x62, 0x6b, 0xf2, 0x05, 0x77, 0xc0, 0x4a, 0x7f, 0xd8, 0x13, 0xb4, 0x46,
-	0x42, 0xc6, 0xa9, 0x68, 0x0c, 0xba, 0x64, 0x60, 0x77, 0xd5, 0x05, 0x09, 0x3d, 0xd5, 0x82, 0x2f,
-	0xc0, 0x12, 0x67, 0xc3, 0x81, 0x17, 0x2d, 0x96, 0x06, 0x76, 0x57, 0xcd, 0x49, 0x76, 0x5e, 0xdc,
-	0xf8, 0xa6, 0x80, 0x7f, 0xed, 0xfd, 0x6a, 0xfb, 0x0a, 0x87, 0x4d, 0x79, 0x40, 0x35, 0x2c, 0x30,
-	0x3c, 0x02, 0x59, 0x71, 0x1d, 0x12, 0x55, 0x31, 0x94, 0x52, 0x61, 0xf7, 0x8d, 0xf9, 0x07, 0xa7,
-	0x65, 0x46, 0x43, 0x1c, 0x13, 0xce, 0xb1, 0x4f, 0x9c, 0xeb, 0x90, 0xb4, 0xe4, 0x08, 0x10, 0x82,
-	0x6c, 0x17, 0x0b, 0xac, 0xa6, 0x0d, 0xa5, 0xf4, 0x4f, 0x4b, 0xd6, 0xd0, 0x00, 0x79, 0x3e, 0x3d,
-	0x05, 0x35, 0x23, 0x5b, 0xb3, 0x52, 0xe4, 0xea, 0x93, 0x3e, 0x9b, 0xec, 0x81, 0xac, 0x5f, 0xff,
-	0xca, 0x80, 0xe5, 0x07, 0x19, 0xf0, 0x25, 0x28, 0x3a, 0x1f, 0x9b, 0x56, 0xe7, 0xf4, 0xa4, 0xdd,
-	0xb4, 0xaa, 0xf6, 0x81, 0x6d, 0xd5, 0x8a, 0x29, 0x6d, 0x79, 0x34, 0x36, 0xf2, 0x33, 0x12, 0xfc,
-	0x1f, 0x14, 0x24, 0x76, 0x5c, 0xf9, 0x60, 0x75, 0x9a, 0x8d, 0x46, 0xbd, 0xa8, 0x68, 0x4b, 0xa3,
-	0xb1, 0xb1, 0x98, 0x08, 0x09, 0xe2, 0x24, 0x48, 0x3a, 0x46, 0x12, 0x21, 0x09, 0xab, 0x56, 0x4e,
-	0xaa, 0x56, 0x3d, 0x86, 0x32, 0x71, 0xd8, 0x8c, 0x04, 0xb7, 0xc0, 0x8a, 0xc4, 0xda, 0xf6, 0xc9,
-	0x61, 0xdd, 0xea, 0xd4, 0xac, 0x66, 0xa3, 0x6d, 0x3b, 0xc5, 0xac, 0x06, 0x47, 0x63, 0xa3, 0x30,
-	0xaf, 0xc2, 0x3d, 0xf0, 0xdf, 0x74, 0x66, 0xc7, 0xa7, 0x75, 0xc7, 0x4e, 0x0c, 0x0b, 0xda, 0xda,
-	0x68, 0x6c, 0xc0, 0xc7, 0x1d, 0xf8, 0x0e, 0xac, 0xcf, 0x4e, 0x64, 0xde, 0x96, 0xd3, 0xd4, 0xd1,
-	0xd8, 0x58, 0x7d, 0xaa, 0x97, 0xa4, 0x39, 0x8f, 0xd3, 0xfe, 0x8a, 0xd3, 0x1e, 0x77, 0x92, 0xf5,
-	0xc4, 0xea, 0x99, 0xed, 0x1c, 0xd5, 0x5a, 0x95, 0xb3, 0xe2, 0xdf, 0xf1, 0x7a, 0xe6, 0xd5, 0x64,
-	0x1b, 0xeb, 0xd6, 0x81, 0xd3, 0x69, 0x9f, 0x55, 0x9a, 0xc5, 0xc5, 0x78, 0x1b, 0x13, 0x01, 0x6e,
-	0x82, 0x65, 0x89, 0xb4, 0xec, 0xc3, 0xa3, 0x09, 0x03, 0xb4, 0xc2, 0x68, 0x6c, 0x80, 0xa9, 0xa2,
-	0x65, 0xbf, 0x7c, 0xd5, 0x53, 0xfb, 0xde, 0xf7, 0x3b, 0x5d, 0xb9, 0xbd, 0xd3, 0x95, 0x9f, 0x77,
-	0xba, 0x72, 0x73, 0xaf, 0xa7, 0x6e, 0xef, 0xf5, 0xd4, 0x8f, 0x7b, 0x3d, 0xf5, 0xc9, 0xf6, 0xa9,
-	0x38, 0x1f, 0xba, 0xa6, 0xc7, 0xfa, 0x88, 0xd3, 0x2e, 0x91, 0xff, 0xb7, 0xc7, 0x7a, 0x88, 0xba,
-	0x5e, 0xfc, 0xe0, 0xbc, 0x45, 0x7d, 0xd6, 0x1d, 0xf6, 0x08, 0x8f, 0x1e, 0x26, 0x8e, 0xca, 0x3b,
-	0xe5, 0xed, 0xe9, 0xa5, 0xdd, 0x96, 0x4c, 0x74, 0x41, 0xb9, 0x9b, 0x93, 0xde, 0xbd, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x11, 0x6b, 0xe9, 0xf5, 0xc5, 0x04, 0x00, 0x00,
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0xeb, 0x46,
+	0x14, 0x8e, 0x93, 0xdc, 0xb4, 0x99, 0x94, 0x90, 0xce, 0x45, 0xb7, 0xc6, 0xaa, 0x2c, 0x17, 0x5a,
+	0x29, 0x2d, 0xc2, 0x26, 0x50, 0xb5, 0xea, 0xd2, 0x24, 0x06, 0xac, 0xe6, 0xc7, 0x72, 0x8c, 0x50,
+	0xbb, 0x49, 0xc7, 0xf6, 0xd4, 0x8c, 0x48, 0x3c, 0x96, 0xc7, 0x01, 0xf1, 0x06, 0x55, 0x56, 0xbc,
+	0x40, 0x56, 0x55, 0xd5, 0x57, 0xe9, 0x92, 0x65, 0x97, 0x15, 0xbc, 0x48, 0xe5, 0x71, 0xe4, 0x24,
+	0x04, 0x89, 0xbb, 0x3b, 0xe7, 0x3b, 0xdf, 0x37, 0xdf, 0x39, 0x67, 0x34, 0x03, 0x8e, 0x88, 0xeb,
+	0x69, 0x28, 0x8a, 0xc6, 0xc4, 0x43, 0x09, 0xa1, 0x21, 0xd3, 0x48, 0x98, 0xe0, 0xd8, 0xbb, 0x46,
+	0x24, 0x1c, 0xb1, 0x3b, 0x14, 0x69, 0xb7, 0x2d, 0x2d, 0x42, 0xde, 0x0d, 0x4e, 0xd4, 0x28, 0xa6,
+	0x09, 0x85, 0xfb, 0xc4, 0xf5, 0xd4, 0x55, 0x85, 0xfa, 0x42, 0xa1, 0xde, 0xb6, 0xa4, 0xdd, 0x80,
+	0xd2, 0x60, 0x8c, 0x35, 0x2e, 0x71, 0xa7, 0xbf, 0x6b, 0x28, 0xbc, 0xcf, 0xf4, 0xd2, 0x4e, 0x40,
+	0x03, 0xca, 0x43, 0x2d, 0x8d, 0x16, 0xa8, 0xec, 0x51, 0x36, 0xa1, 0x4c, 0x73, 0x11, 0xc3, 0xda,
+	0x6d, 0xcb, 0xc5, 0x09, 0x6a, 0x69, 0x1e, 0x25, 0x61, 0x56, 0xdf, 0x7b, 0x28, 0x82, 0xda, 0x30,
+	0x41, 0x09, 0x6e, 0x5f, 0xa3, 0x30, 0xc0, 0xf0, 0x5b, 0x50, 0x24, 0xa1, 0x28, 0x28, 0xa5, 0x66,
+	0xed, 0x78, 0x57, 0xcd, 0xc4, 0x6a, 0x2a, 0x56, 0x17, 0x62, 0xb5, 0x4d, 0x49, 0x68, 0x17, 0x49,
+	0x08, 0x0f, 0x40, 0x89, 0x4e, 0x13, 0xb1, 0xf8, 0x16, 0x37, 0x65, 0xc1, 0x9f, 0x00, 0x88, 0x28,
+	0x1d, 0x3b, 0xf4, 0x06, 0x87, 0x4c, 0x2c, 0xbd, 0xa5, 0x59, 0x21, 0xc3, 0x0f, 0xa0, 0x92, 0x66,
+	0xa6, 0x2f, 0x96, 0x15, 0xa1, 0x59, 0xb5, 0x17, 0x19, 0x3c, 0x02, 0xef, 0x27, 0xd3, 0x71, 0x42,
+	0x3a, 0x38, 0xa2, 0x8c, 0x24, 0x83, 0xd8, 0xc7, 0xb1, 0xe9, 0x8b, 0xef, 0x38, 0xe9, 0xb5, 0x12,
+	0xfc, 0x1a, 0x6c, 0x31, 0x3a, 0x8d, 0xbd, 0x74, 0x58, 0x12, 0x9a, 0xbe, 0x58, 0xe1, 0xdc, 0x75,
+	0x70, 0xef, 0x6f, 0x01, 0x7c, 0x6e, 0x9e, 0xb6, 0x87, 0x77, 0x28, 0xb2, 0xf8, 0x05, 0x75, 0x50,
+	0x82, 0xe0, 0x05, 0x28, 0x27, 0xf7, 0x11, 0x16, 0x05, 0x45, 0x68, 0xd6, 0x8f, 0xbf, 0x57, 0x3f,
+	0xe2, 0xb6, 0xd4, 0xf4, 0x88, 0x1e, 0x66, 0x0c, 0x05, 0xd8, 0xb9, 0x8f, 0xb0, 0xcd, 0x4f, 0x80,
+	0x10, 0x94, 0x7d, 0x94, 0x20, 0xb1, 0xa8, 0x08, 0xcd, 0xcf, 0x6c, 0x1e, 0x43, 0x05, 0xd4, 0xd8,
+	0xf2, 0x16, 0xc4, 0x12, 0x2f, 0xad, 0x42, 0xa9, 0x6a, 0x82, 0x27, 0x74, 0xb1, 0x03, 0x1e, 0xef,
+	0xfd, 0x06, 0x76, 0x8c, 0x38, 0xa6, 0xb1, 0xee, 0xdd, 0x84, 0xf4, 0x6e, 0x8c, 0xfd, 0x00, 0x4f,
+	0x70, 0x98, 0xc0, 0x2f, 0x41, 0xd5, 0xa3, 0x3e, 0x66, 0x11, 0xf2, 0xb2, 0x86, 0xab, 0xf6, 0x12,
+	0x48, 0x4f, 0x4a, 0x13, 0xee, 0xbf, 0x65, 0xf3, 0x38, 0xdd, 0x71, 0x8c, 0x11, 0xa3, 0x21, 0xb7,
+	0xae, 0xda, 0x8b, 0xec, 0xbb, 0xbf, 0xca, 0x60, 0xfb, 0xc5, 0x14, 0xf0, 0x1b, 0xd0, 0x70, 0x7e,
+	0xb1, 0x8c, 0xd1, 0x65, 0x7f, 0x68, 0x19, 0x6d, 0xf3, 0xcc, 0x34, 0x3a, 0x8d, 0x82, 0xb4, 0x3d,
+	0x9b, 0x2b, 0xb5, 0x15, 0x08, 0x7e, 0x05, 0xea, 0x9c, 0xd6, 0xd3, 0x7f, 0x36, 0x46, 0xd6, 0x60,
+	0xd0, 0x6d, 0x08, 0xd2, 0xd6, 0x6c, 0xae, 0x54, 0x73, 0x20, 0xa7, 0x38, 0x39, 0xa5, 0x98, 0x51,
+	0x72, 0x20, 0x37, 0x6b, 0xeb, 0xfd, 0xb6, 0xd1, 0xcd, 0x48, 0xa5, 0xcc, 0x6c, 0x05, 0x82, 0x07,
+	0xe0, 0x3d, 0xa7, 0x0d, 0xcd, 0xfe, 0x79, 0xd7, 0x18, 0x75, 0x0c, 0x6b, 0x30, 0x34, 0x9d, 0x46,
+	0x59, 0x82, 0xb3, 0xb9, 0x52, 0x5f, 0x47, 0xe1, 0x09, 0xf8, 0x62, 0xd9, 0x59, 0xef, 0xb2, 0xeb,
+	0x98, 0xb9, 0xe0, 0x9d, 0xf4, 0x61, 0x36, 0x57, 0xe0, 0x66, 0x05, 0xfe, 0x08, 0x76, 0x57, 0x1b,
+	0x59, 0x97, 0x55, 0x24, 0x71, 0x36, 0x57, 0x76, 0x5e, 0xab, 0xe5, 0x6e, 0xce, 0xa6, 0xdb, 0x27,
+	0x99, 0xdb, 0x66, 0x25, 0x9f, 0x27, 0x43, 0xaf, 0x4c, 0xe7, 0xa2, 0x63, 0xeb, 0x57, 0x8d, 0x4f,
+	0xb3, 0x79, 0xd6, 0xd1, 0x7c, 0x8d, 0x5d, 0xe3, 0xcc, 0x19, 0x0d, 0xaf, 0x74, 0xab, 0x51, 0xcd,
+	0xd6, 0x98, 0x03, 0x70, 0x1f, 0x6c, 0x73, 0x8a, 0x6d, 0x9e, 0x5f, 0x2c, 0x38, 0x40, 0xaa, 0xcf,
+	0xe6, 0x0a, 0x58, 0x22, 0x79, 0xa7, 0x7a, 0xcf, 0xea, 0x9a, 0x67, 0x66, 0x5b, 0x77, 0xcc, 0x41,
+	0x7f, 0x64, 0xeb, 0x3d, 0xab, 0x51, 0xcb, 0x3a, 0xdd, 0xac, 0x48, 0xe5, 0x3f, 0xfe, 0x94, 0x0b,
+	0xa7, 0xde, 0x3f, 0x4f, 0xb2, 0xf0, 0xf8, 0x24, 0x0b, 0xff, 0x3d, 0xc9, 0xc2, 0xc3, 0xb3, 0x5c,
+	0x78, 0x7c, 0x96, 0x0b, 0xff, 0x3e, 0xcb, 0x85, 0x5f, 0xcd, 0x80, 0x24, 0xd7, 0x53, 0x57, 0xf5,
+	0xe8, 0x44, 0x63, 0xc4, 0xc7, 0xfc, 0xdb, 0xf1, 0xe8, 0x58, 0x23, 0xae, 0x97, 0xfd, 0x83, 0x3f,
+	0x68, 0x13, 0xea, 0x4f, 0xc7, 0x98, 0xa5, 0xff, 0x25, 0xd3, 0x5a, 0x47, 0xad, 0xc3, 0xe5, 0x5b,
+	0x3a, 0xe4, 0x9c, 0xf4, 0xdd, 0x30, 0xb7, 0xc2, 0xb5, 0x27, 0xff, 0x0f, 0x00, 0x32, 0xa4, 0xc0,
+	0x01, 0x5c, 0x05, 0x00, 0x00,
 }
 
 func (m *StateChange) Marshal() (dAtA []byte, err error) {
@@ -425,6 +497,48 @@ func (m *IBCSwapPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ErrorAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Code != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -499,6 +613,26 @@ func (m *IBCSwapPacketData) Size() (n int) {
 	return n
 }
 
+func (m *ErrorAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovPacket(uint64(m.Code))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
 func sovPacket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -922,6 +1056,139 @@ func (m *IBCSwapPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ErrorAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPacket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
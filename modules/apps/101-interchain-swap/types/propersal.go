@@ -7,13 +7,19 @@ import (
 const (
 	// ProposalTypeMarketFeeUpdate defines the type for a MarketFeeUpdateProposal
 	ProposalTypeMarketFeeUpdate = "MarketFeeUpdate"
+	// ProposalTypeAmplificationRamp defines the type for a AmplificationRampProposal
+	ProposalTypeAmplificationRamp = "AmplificationRamp"
 )
 
 // Assert MarketFeeUpdateProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = &MarketFeeUpdateProposal{}
+var (
+	_ govtypes.Content = &MarketFeeUpdateProposal{}
+	_ govtypes.Content = &AmplificationRampProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeMarketFeeUpdate)
+	govtypes.RegisterProposalType(ProposalTypeAmplificationRamp)
 }
 
 // NewMarketFeeUpdateProposal creates a new community pool spend proposal.
@@ -50,3 +56,37 @@ func (csp *MarketFeeUpdateProposal) ValidateBasic() error {
 
 	return nil
 }
+
+// NewAmplificationRampProposal creates a new amplification ramp proposal.
+//
+//nolint:interfacer
+func NewAmplificationRampProposal(title, description string, poolId string, futureA uint64, futureTime int64) *AmplificationRampProposal {
+	return &AmplificationRampProposal{title, description, poolId, futureA, futureTime}
+}
+
+// GetTitle returns the title of an amplification ramp proposal.
+func (arp *AmplificationRampProposal) GetTitle() string { return arp.Title }
+
+// GetDescription returns the description of an amplification ramp proposal.
+func (arp *AmplificationRampProposal) GetDescription() string { return arp.Description }
+
+// ProposalRoute returns the routing key of an amplification ramp proposal.
+func (arp *AmplificationRampProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an amplification ramp proposal.
+func (arp *AmplificationRampProposal) ProposalType() string { return ProposalTypeAmplificationRamp }
+
+// ValidateBasic runs basic stateless validity checks, the ramp is checked against the pool when the
+// proposal is executed.
+func (arp *AmplificationRampProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(arp); err != nil {
+		return err
+	}
+	if arp.PoolId == "" {
+		return ErrInvalidPoolId
+	}
+	if arp.FutureA == 0 || arp.FutureA > MaxAmplification {
+		return ErrInvalidAmplification
+	}
+	return nil
+}
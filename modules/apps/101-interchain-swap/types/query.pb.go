@@ -5,7 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -924,6 +925,440 @@ func (m *QueryAllInterchainMarketMakerResponse) GetPagination() *query.PageRespo
 	return nil
 }
 
+// QueryEstimateSwapRequest is the request type for the Query/EstimateSwap RPC method.
+type QueryEstimateSwapRequest struct {
+	PoolId   string     `protobuf:"bytes,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	TokenIn  types.Coin `protobuf:"bytes,2,opt,name=tokenIn,proto3" json:"tokenIn"`
+	DenomOut string     `protobuf:"bytes,3,opt,name=denomOut,proto3" json:"denomOut,omitempty"`
+}
+
+func (m *QueryEstimateSwapRequest) Reset()         { *m = QueryEstimateSwapRequest{} }
+func (m *QueryEstimateSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateSwapRequest) ProtoMessage()    {}
+func (*QueryEstimateSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef062c56032354e0, []int{18}
+}
+func (m *QueryEstimateSwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateSwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateSwapRequest.Merge(m, src)
+}
+func (m *QueryEstimateSwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateSwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateSwapRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateSwapRequest) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *QueryEstimateSwapRequest) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *QueryEstimateSwapRequest) GetDenomOut() string {
+	if m != nil {
+		return m.DenomOut
+	}
+	return ""
+}
+
+// QueryEstimateSwapResponse is the response type for the Query/EstimateSwap RPC method. The prices are
+// amounts of the token in per token out.
+type QueryEstimateSwapResponse struct {
+	TokenOut types.Coin `protobuf:"bytes,1,opt,name=tokenOut,proto3" json:"tokenOut"`
+	// the fee taken from the token in
+	Fee types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+	// the relative difference of the execution price without the fee and the spot price before the swap
+	PriceImpact    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=priceImpact,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"priceImpact"`
+	SpotPriceAfter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=spotPriceAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spotPriceAfter"`
+}
+
+func (m *QueryEstimateSwapResponse) Reset()         { *m = QueryEstimateSwapResponse{} }
+func (m *QueryEstimateSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateSwapResponse) ProtoMessage()    {}
+func (*QueryEstimateSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef062c56032354e0, []int{19}
+}
+func (m *QueryEstimateSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateSwapResponse.Merge(m, src)
+}
+func (m *QueryEstimateSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateSwapResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateSwapResponse) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+func (m *QueryEstimateSwapResponse) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+// QueryEstimateSwapExactOutRequest is the request type for the Query/EstimateSwapExactOut RPC method.
+type QueryEstimateSwapExactOutRequest struct {
+	PoolId   string     `protobuf:"bytes,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	TokenOut types.Coin `protobuf:"bytes,2,opt,name=tokenOut,proto3" json:"tokenOut"`
+	DenomIn  string     `protobuf:"bytes,3,opt,name=denomIn,proto3" json:"denomIn,omitempty"`
+}
+
+func (m *QueryEstimateSwapExactOutRequest) Reset()         { *m = QueryEstimateSwapExactOutRequest{} }
+func (m *QueryEstimateSwapExactOutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateSwapExactOutRequest) ProtoMessage()    {}
+func (*QueryEstimateSwapExactOutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef062c56032354e0, []int{20}
+}
+func (m *QueryEstimateSwapExactOutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateSwapExactOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateSwapExactOutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateSwapExactOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateSwapExactOutRequest.Merge(m, src)
+}
+func (m *QueryEstimateSwapExactOutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateSwapExactOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateSwapExactOutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateSwapExactOutRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateSwapExactOutRequest) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *QueryEstimateSwapExactOutRequest) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+func (m *QueryEstimateSwapExactOutRequest) GetDenomIn() string {
+	if m != nil {
+		return m.DenomIn
+	}
+	return ""
+}
+
+// QueryEstimateSwapExactOutResponse is the response type for the Query/EstimateSwapExactOut RPC method.
+// The prices are amounts of the token in per token out.
+type QueryEstimateSwapExactOutResponse struct {
+	TokenIn types.Coin `protobuf:"bytes,1,opt,name=tokenIn,proto3" json:"tokenIn"`
+	// the fee included in the token in
+	Fee types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+	// the relative difference of the execution price without the fee and the spot price before the swap
+	PriceImpact    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=priceImpact,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"priceImpact"`
+	SpotPriceAfter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=spotPriceAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spotPriceAfter"`
+}
+
+func (m *QueryEstimateSwapExactOutResponse) Reset()         { *m = QueryEstimateSwapExactOutResponse{} }
+func (m *QueryEstimateSwapExactOutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateSwapExactOutResponse) ProtoMessage()    {}
+func (*QueryEstimateSwapExactOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef062c56032354e0, []int{21}
+}
+func (m *QueryEstimateSwapExactOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateSwapExactOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateSwapExactOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateSwapExactOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateSwapExactOutResponse.Merge(m, src)
+}
+func (m *QueryEstimateSwapExactOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateSwapExactOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateSwapExactOutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateSwapExactOutResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateSwapExactOutResponse) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *QueryEstimateSwapExactOutResponse) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+// QueryEstimateSingleDepositRequest is the request type for the Query/EstimateSingleDeposit RPC method.
+type QueryEstimateSingleDepositRequest struct {
+	PoolId string     `protobuf:"bytes,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	Token  types.Coin `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+}
+
+func (m *QueryEstimateSingleDepositRequest) Reset()         { *m = QueryEstimateSingleDepositRequest{} }
+func (m *QueryEstimateSingleDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateSingleDepositRequest) ProtoMessage()    {}
+func (*QueryEstimateSingleDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef062c56032354e0, []int{22}
+}
+func (m *QueryEstimateSingleDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateSingleDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateSingleDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateSingleDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateSingleDepositRequest.Merge(m, src)
+}
+func (m *QueryEstimateSingleDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateSingleDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateSingleDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateSingleDepositRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateSingleDepositRequest) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *QueryEstimateSingleDepositRequest) GetToken() types.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return types.Coin{}
+}
+
+// QueryEstimateSingleDepositResponse is the response type for the Query/EstimateSingleDeposit RPC method.
+type QueryEstimateSingleDepositResponse struct {
+	PoolToken types.Coin `protobuf:"bytes,1,opt,name=poolToken,proto3" json:"poolToken"`
+}
+
+func (m *QueryEstimateSingleDepositResponse) Reset()         { *m = QueryEstimateSingleDepositResponse{} }
+func (m *QueryEstimateSingleDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateSingleDepositResponse) ProtoMessage()    {}
+func (*QueryEstimateSingleDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef062c56032354e0, []int{23}
+}
+func (m *QueryEstimateSingleDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateSingleDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateSingleDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateSingleDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateSingleDepositResponse.Merge(m, src)
+}
+func (m *QueryEstimateSingleDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateSingleDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateSingleDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateSingleDepositResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateSingleDepositResponse) GetPoolToken() types.Coin {
+	if m != nil {
+		return m.PoolToken
+	}
+	return types.Coin{}
+}
+
+// QueryEstimateWithdrawRequest is the request type for the Query/EstimateWithdraw RPC method.
+type QueryEstimateWithdrawRequest struct {
+	PoolId    string     `protobuf:"bytes,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	PoolToken types.Coin `protobuf:"bytes,2,opt,name=poolToken,proto3" json:"poolToken"`
+}
+
+func (m *QueryEstimateWithdrawRequest) Reset()         { *m = QueryEstimateWithdrawRequest{} }
+func (m *QueryEstimateWithdrawRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateWithdrawRequest) ProtoMessage()    {}
+func (*QueryEstimateWithdrawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef062c56032354e0, []int{24}
+}
+func (m *QueryEstimateWithdrawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateWithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateWithdrawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateWithdrawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateWithdrawRequest.Merge(m, src)
+}
+func (m *QueryEstimateWithdrawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateWithdrawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateWithdrawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateWithdrawRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateWithdrawRequest) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *QueryEstimateWithdrawRequest) GetPoolToken() types.Coin {
+	if m != nil {
+		return m.PoolToken
+	}
+	return types.Coin{}
+}
+
+// QueryEstimateWithdrawResponse is the response type for the Query/EstimateWithdraw RPC method.
+type QueryEstimateWithdrawResponse struct {
+	Tokens []types.Coin `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens"`
+}
+
+func (m *QueryEstimateWithdrawResponse) Reset()         { *m = QueryEstimateWithdrawResponse{} }
+func (m *QueryEstimateWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateWithdrawResponse) ProtoMessage()    {}
+func (*QueryEstimateWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef062c56032354e0, []int{25}
+}
+func (m *QueryEstimateWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateWithdrawResponse.Merge(m, src)
+}
+func (m *QueryEstimateWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateWithdrawResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateWithdrawResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateWithdrawResponse) GetTokens() []types.Coin {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGetInterchainMultiDepositOrderRequest)(nil), "ibc.applications.interchain_swap.v1.QueryGetInterchainMultiDepositOrderRequest")
 	proto.RegisterType((*QueryGetInterchainMultiDepositOrderResponse)(nil), "ibc.applications.interchain_swap.v1.QueryGetInterchainMultiDepositOrderResponse")
@@ -943,6 +1378,14 @@ func init() {
 	proto.RegisterType((*QueryGetInterchainMarketMakerResponse)(nil), "ibc.applications.interchain_swap.v1.QueryGetInterchainMarketMakerResponse")
 	proto.RegisterType((*QueryAllInterchainMarketMakerRequest)(nil), "ibc.applications.interchain_swap.v1.QueryAllInterchainMarketMakerRequest")
 	proto.RegisterType((*QueryAllInterchainMarketMakerResponse)(nil), "ibc.applications.interchain_swap.v1.QueryAllInterchainMarketMakerResponse")
+	proto.RegisterType((*QueryEstimateSwapRequest)(nil), "ibc.applications.interchain_swap.v1.QueryEstimateSwapRequest")
+	proto.RegisterType((*QueryEstimateSwapResponse)(nil), "ibc.applications.interchain_swap.v1.QueryEstimateSwapResponse")
+	proto.RegisterType((*QueryEstimateSwapExactOutRequest)(nil), "ibc.applications.interchain_swap.v1.QueryEstimateSwapExactOutRequest")
+	proto.RegisterType((*QueryEstimateSwapExactOutResponse)(nil), "ibc.applications.interchain_swap.v1.QueryEstimateSwapExactOutResponse")
+	proto.RegisterType((*QueryEstimateSingleDepositRequest)(nil), "ibc.applications.interchain_swap.v1.QueryEstimateSingleDepositRequest")
+	proto.RegisterType((*QueryEstimateSingleDepositResponse)(nil), "ibc.applications.interchain_swap.v1.QueryEstimateSingleDepositResponse")
+	proto.RegisterType((*QueryEstimateWithdrawRequest)(nil), "ibc.applications.interchain_swap.v1.QueryEstimateWithdrawRequest")
+	proto.RegisterType((*QueryEstimateWithdrawResponse)(nil), "ibc.applications.interchain_swap.v1.QueryEstimateWithdrawResponse")
 }
 
 func init() {
@@ -950,80 +1393,105 @@ func init() {
 }
 
 var fileDescriptor_ef062c56032354e0 = []byte{
-	// 1157 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x3a, 0xd4, 0x51, 0x5f, 0x54, 0x0e, 0xd3, 0x96, 0x98, 0xa5, 0xb8, 0xd1, 0xd2, 0x5f,
-	0x4a, 0xdb, 0xdd, 0x3a, 0x55, 0x29, 0x6a, 0xda, 0xb4, 0x4e, 0x4a, 0x23, 0x43, 0x4c, 0x1d, 0x47,
-	0xe2, 0x47, 0x0f, 0x58, 0xeb, 0xdd, 0x91, 0xb3, 0xea, 0x7a, 0x67, 0xb3, 0x33, 0x76, 0x89, 0x82,
-	0x25, 0x84, 0xb8, 0x01, 0x12, 0x12, 0x17, 0x6e, 0x1c, 0xfb, 0x6f, 0x70, 0xec, 0x81, 0x43, 0x25,
-	0x2e, 0x9c, 0x10, 0x4a, 0x38, 0x20, 0x0e, 0x08, 0x24, 0xc4, 0x89, 0x03, 0xda, 0xd9, 0x71, 0xbc,
-	0xdb, 0xac, 0xbd, 0x9b, 0x8d, 0x73, 0xb3, 0x77, 0xe7, 0x7d, 0xef, 0x7d, 0xdf, 0x7b, 0x6f, 0xde,
-	0xcc, 0x82, 0x66, 0x35, 0x0d, 0x4d, 0x77, 0x5d, 0xdb, 0x32, 0x74, 0x66, 0x11, 0x87, 0x6a, 0x96,
-	0xc3, 0xb0, 0x67, 0x6c, 0xe8, 0x96, 0xd3, 0xa0, 0x4f, 0x74, 0x57, 0xeb, 0x96, 0xb4, 0xcd, 0x0e,
-	0xf6, 0xb6, 0x54, 0xd7, 0x23, 0x8c, 0xa0, 0x37, 0xac, 0xa6, 0xa1, 0x86, 0x0d, 0xd4, 0x17, 0x0c,
-	0xd4, 0x6e, 0x49, 0x3e, 0xd5, 0x22, 0x2d, 0xc2, 0xd7, 0x6b, 0xfe, 0xaf, 0xc0, 0x54, 0x9e, 0x33,
-	0x08, 0x6d, 0x13, 0xaa, 0x35, 0x75, 0x8a, 0x03, 0x4c, 0xad, 0x5b, 0x6a, 0x62, 0xa6, 0x97, 0x34,
-	0x57, 0x6f, 0x59, 0x0e, 0xc7, 0x13, 0x6b, 0x53, 0xc5, 0xe5, 0xea, 0x9e, 0xde, 0x16, 0x06, 0xd7,
-	0xd2, 0x18, 0xb4, 0x75, 0xef, 0x31, 0x66, 0xc2, 0xe2, 0x4a, 0x1a, 0x0b, 0xf6, 0x89, 0x58, 0x7d,
-	0xa6, 0x45, 0x48, 0xcb, 0xc6, 0x9a, 0xee, 0x5a, 0x9a, 0xee, 0x38, 0x84, 0x09, 0xf6, 0xc1, 0xdb,
-	0x62, 0x98, 0x5a, 0x9f, 0x94, 0x41, 0x2c, 0x41, 0x47, 0xf9, 0x18, 0xe6, 0xd6, 0x7c, 0xc2, 0x2b,
-	0x98, 0x55, 0xf6, 0x9c, 0x54, 0x3b, 0x36, 0xb3, 0xee, 0x63, 0x97, 0x50, 0x8b, 0x3d, 0xf4, 0x4c,
-	0xec, 0xd5, 0xf1, 0x66, 0x07, 0x53, 0x86, 0x5e, 0x81, 0xbc, 0x4b, 0x88, 0x5d, 0x31, 0x0b, 0xd2,
-	0xac, 0x74, 0xe9, 0x78, 0x5d, 0xfc, 0x43, 0x05, 0x98, 0x22, 0xfe, 0xba, 0x8a, 0x59, 0xc8, 0xf1,
-	0x17, 0xfd, 0xbf, 0xca, 0x67, 0x12, 0x5c, 0x4e, 0xe5, 0x80, 0xba, 0xc4, 0xa1, 0x18, 0xad, 0xc1,
-	0x31, 0x6e, 0xca, 0x1d, 0x4c, 0xcf, 0x2f, 0xa8, 0x29, 0xb2, 0xaa, 0x72, 0xb8, 0x32, 0xa5, 0x98,
-	0x45, 0x30, 0x03, 0x24, 0xe5, 0xeb, 0x7e, 0x08, 0x65, 0xdb, 0x1e, 0x11, 0x02, 0x4d, 0x22, 0xf9,
-	0x00, 0x60, 0x50, 0x0d, 0x9c, 0xe7, 0xf4, 0xfc, 0x05, 0x35, 0xd0, 0x57, 0xf5, 0xf5, 0x55, 0x83,
-	0x72, 0x14, 0x2a, 0xab, 0x35, 0xbd, 0x85, 0x05, 0x66, 0x3d, 0x64, 0xa9, 0xfc, 0x28, 0xc1, 0x95,
-	0x74, 0xf1, 0x08, 0x4d, 0xd6, 0x21, 0xcf, 0x99, 0xd0, 0x82, 0x34, 0x3b, 0x79, 0x58, 0x51, 0x04,
-	0x14, 0x5a, 0x89, 0x61, 0x73, 0x31, 0x91, 0x4d, 0x10, 0x51, 0x84, 0x4e, 0x17, 0x6e, 0x71, 0x36,
-	0xab, 0x3a, 0xc3, 0x74, 0x54, 0x8e, 0x97, 0xb6, 0xd6, 0x49, 0xc7, 0x33, 0x70, 0x55, 0x7f, 0x9c,
-	0x5c, 0x51, 0xb3, 0x30, 0x4d, 0x07, 0xab, 0x45, 0x55, 0x85, 0x1f, 0x29, 0xa7, 0x00, 0x71, 0xbf,
-	0x35, 0xbf, 0xd7, 0xfa, 0xc9, 0x53, 0x1e, 0xc1, 0xc9, 0xc8, 0x53, 0x21, 0xe1, 0x32, 0xe4, 0x79,
-	0x4f, 0x52, 0x51, 0x57, 0x97, 0x53, 0x49, 0x28, 0x40, 0x84, 0xa9, 0xb2, 0x0e, 0xaf, 0x72, 0xec,
-	0xb7, 0xa9, 0xe1, 0x91, 0x27, 0x65, 0xd3, 0xf4, 0x30, 0xdd, 0xab, 0x9a, 0x19, 0x98, 0x72, 0x89,
-	0xc7, 0x1a, 0x56, 0x88, 0x89, 0xc7, 0x2a, 0x26, 0x7a, 0x1d, 0xc0, 0xd8, 0xd0, 0x1d, 0x07, 0xdb,
-	0xfe, 0xbb, 0x80, 0xc8, 0x71, 0xf1, 0xa4, 0x62, 0x2a, 0xcb, 0x20, 0xc7, 0x81, 0x8a, 0xb8, 0xcf,
-	0xc3, 0xcb, 0x98, 0xbf, 0x68, 0xe8, 0xc1, 0x1b, 0x01, 0x7e, 0x02, 0x87, 0x97, 0x2b, 0xf7, 0xe0,
-	0xc2, 0xfe, 0x26, 0x5b, 0xb5, 0x36, 0x3b, 0x96, 0x69, 0xb1, 0xad, 0x1a, 0x21, 0x76, 0x82, 0xde,
-	0xca, 0x53, 0x09, 0x2e, 0x26, 0x42, 0x88, 0xa0, 0x3e, 0x85, 0x19, 0x2b, 0x7e, 0x89, 0x50, 0xf7,
-	0x76, 0x2a, 0x75, 0x87, 0xb8, 0x59, 0x7a, 0xe9, 0xd9, 0x2f, 0x67, 0x27, 0xea, 0xc3, 0x5c, 0x28,
-	0xae, 0xe0, 0x1a, 0xe9, 0x9e, 0x58, 0xae, 0xd1, 0x86, 0x95, 0x32, 0x37, 0xec, 0x57, 0x12, 0x5c,
-	0x1a, 0xe1, 0xb2, 0x1a, 0x71, 0x5a, 0x80, 0x29, 0xc3, 0xc3, 0x3a, 0x23, 0x9e, 0x50, 0xb8, 0xff,
-	0x77, 0x6c, 0xfb, 0xc7, 0x5f, 0xfd, 0x54, 0x8d, 0x52, 0x20, 0x4d, 0xaa, 0x26, 0x8f, 0x38, 0x55,
-	0xe3, 0xdb, 0x63, 0x16, 0xe1, 0x5c, 0xcc, 0x10, 0xe1, 0x33, 0x33, 0xcd, 0x6e, 0xa2, 0x7c, 0x2f,
-	0xc1, 0xf9, 0x04, 0x00, 0x21, 0x58, 0x17, 0x4e, 0x5b, 0x71, 0x0b, 0x44, 0xf9, 0xdc, 0x3a, 0xa0,
-	0x5c, 0x21, 0x04, 0x21, 0x56, 0x3c, 0xbc, 0xe2, 0x08, 0x86, 0xd1, 0x99, 0xb0, 0x9f, 0xe1, 0xb8,
-	0x6a, 0xfa, 0xf7, 0xbe, 0x22, 0xc3, 0x1d, 0x26, 0x2b, 0x32, 0x79, 0x84, 0x8a, 0x8c, 0xad, 0x78,
-	0xe6, 0xff, 0x3e, 0x09, 0xc7, 0x38, 0x55, 0xf4, 0x54, 0x82, 0x7c, 0xb0, 0xa7, 0xa3, 0x9b, 0xa9,
-	0xc2, 0xde, 0x3f, 0x60, 0xe4, 0xb7, 0x0e, 0x6e, 0x18, 0xc4, 0xa4, 0xcc, 0x7d, 0xfe, 0xd3, 0x6f,
-	0xdf, 0xe6, 0xce, 0x21, 0xa5, 0x7f, 0x84, 0x0c, 0x9f, 0xeb, 0x22, 0x27, 0x47, 0x8a, 0xfe, 0x90,
-	0xe0, 0x44, 0x64, 0x22, 0xa0, 0xc5, 0xf4, 0x7e, 0xe3, 0xe6, 0x93, 0x7c, 0x37, 0xb3, 0xbd, 0x08,
-	0xff, 0x43, 0x1e, 0x7e, 0x1d, 0xd5, 0x46, 0x85, 0x2f, 0xe6, 0x1a, 0xd5, 0xb6, 0x07, 0x33, 0xaf,
-	0xa7, 0xf9, 0x93, 0x90, 0x6a, 0xdb, 0x62, 0x3e, 0xf6, 0xb4, 0xe8, 0x48, 0x43, 0xff, 0x49, 0x30,
-	0x33, 0x64, 0x87, 0x41, 0xef, 0xa6, 0x0f, 0x3b, 0x71, 0xf8, 0xc9, 0xab, 0xe3, 0x01, 0x13, 0x82,
-	0x3c, 0xe0, 0x82, 0xdc, 0x43, 0x8b, 0xa3, 0x04, 0x09, 0xe1, 0xdb, 0x7d, 0x94, 0x86, 0xbf, 0x2b,
-	0xf9, 0x72, 0xf8, 0x7b, 0x53, 0x0f, 0xfd, 0x2b, 0x81, 0x3c, 0xc4, 0x57, 0xd9, 0x3e, 0x90, 0x02,
-	0x89, 0x23, 0xf1, 0x20, 0x0a, 0x24, 0x4f, 0x17, 0xe5, 0x0e, 0x57, 0xe0, 0x26, 0xba, 0x91, 0x49,
-	0x01, 0xf4, 0x45, 0x0e, 0xce, 0x0c, 0x9d, 0xa7, 0x3e, 0xf5, 0xea, 0x61, 0xa3, 0xad, 0x1e, 0x21,
-	0xf9, 0x15, 0x4e, 0xbe, 0x8c, 0xee, 0x66, 0x4c, 0xbf, 0x38, 0x16, 0xf4, 0xd0, 0x3f, 0x12, 0x9c,
-	0x8e, 0xdd, 0x1f, 0x51, 0x25, 0x63, 0xbd, 0xee, 0x9f, 0x1b, 0xf2, 0x3b, 0xe3, 0x80, 0x12, 0xcc,
-	0xef, 0x73, 0xe6, 0x8b, 0xe8, 0x76, 0x4a, 0xe6, 0xc1, 0xe5, 0xb6, 0xd1, 0xf6, 0x41, 0x06, 0x65,
-	0xff, 0xa7, 0x04, 0x85, 0x58, 0x3f, 0x7e, 0xe6, 0x2b, 0x19, 0x53, 0x75, 0x38, 0xe6, 0x49, 0xb3,
-	0x50, 0x59, 0xe0, 0xcc, 0x6f, 0xa0, 0xeb, 0x19, 0x98, 0xa3, 0xef, 0x72, 0xf0, 0xda, 0x88, 0xeb,
-	0x11, 0x7a, 0x98, 0x35, 0x45, 0x43, 0x6e, 0xeb, 0x72, 0x6d, 0x7c, 0x80, 0x82, 0xff, 0xfb, 0x9c,
-	0x7f, 0x0d, 0xbd, 0x97, 0x96, 0xbf, 0x8f, 0xd4, 0x30, 0x03, 0xa8, 0x46, 0x70, 0xf3, 0xdc, 0xab,
-	0x00, 0x6d, 0x5b, 0x7c, 0x24, 0xe8, 0xa1, 0x1f, 0x72, 0x30, 0x17, 0xea, 0x37, 0x7e, 0x93, 0x8c,
-	0xb9, 0x3f, 0x2e, 0x8b, 0x93, 0x74, 0x23, 0x3d, 0xb1, 0x4c, 0xb7, 0xd2, 0x23, 0x50, 0xae, 0xc1,
-	0x95, 0xfb, 0x08, 0x7d, 0x30, 0x1e, 0xe5, 0x42, 0x17, 0xe1, 0x9e, 0x66, 0xeb, 0x94, 0xa1, 0x2f,
-	0x73, 0x70, 0x76, 0xd4, 0xd7, 0x04, 0xbf, 0xab, 0x6a, 0x59, 0x5b, 0x61, 0xd8, 0xb7, 0x12, 0x79,
-	0x6d, 0x8c, 0x88, 0x42, 0xa9, 0x65, 0xae, 0xd4, 0x1d, 0xb4, 0x70, 0x08, 0xa5, 0x96, 0x8c, 0x67,
-	0x3b, 0x45, 0xe9, 0xf9, 0x4e, 0x51, 0xfa, 0x75, 0xa7, 0x28, 0x7d, 0xb3, 0x5b, 0x9c, 0x78, 0xbe,
-	0x5b, 0x9c, 0xf8, 0x79, 0xb7, 0x38, 0xf1, 0xa8, 0xd2, 0xb2, 0xd8, 0x46, 0xa7, 0xa9, 0x1a, 0xa4,
-	0xad, 0x51, 0xcb, 0xc4, 0xfc, 0x33, 0x98, 0x41, 0x6c, 0xdf, 0x5b, 0x80, 0xfe, 0xa6, 0xd6, 0x26,
-	0x66, 0xc7, 0xc6, 0x34, 0x70, 0x5e, 0xba, 0x56, 0xba, 0x3a, 0x70, 0x77, 0x95, 0xaf, 0x61, 0x5b,
-	0x2e, 0xa6, 0xcd, 0x3c, 0xb7, 0xbd, 0xfe, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0x63, 0xbb,
-	0xda, 0xab, 0x14, 0x00, 0x00,
+	// 1554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x37, 0x6d, 0xd2, 0xbe, 0xd0, 0x0a, 0x0d, 0x2d, 0xdd, 0x9a, 0x36, 0x0d, 0xa6, 0x4d,
+	0xab, 0xb4, 0x5d, 0x37, 0x29, 0xfd, 0x41, 0xd3, 0xa4, 0x4d, 0xd2, 0x36, 0x5a, 0x48, 0xc8, 0x36,
+	0x41, 0x6d, 0xe9, 0x81, 0x95, 0x63, 0x4f, 0x37, 0x56, 0xbc, 0x1e, 0xd7, 0x33, 0x9b, 0x34, 0x0a,
+	0x91, 0x10, 0xe2, 0x82, 0x00, 0x09, 0xc4, 0x85, 0x1b, 0xc7, 0xfe, 0x05, 0x9c, 0xb8, 0x70, 0x2c,
+	0x12, 0x87, 0x4a, 0x5c, 0x80, 0x43, 0x85, 0x5a, 0x0e, 0x08, 0x24, 0xc4, 0x01, 0x21, 0x0e, 0x1c,
+	0x90, 0xc7, 0xe3, 0xac, 0x37, 0xeb, 0x5d, 0x7b, 0xbd, 0x9b, 0x0b, 0xa7, 0xc4, 0xf6, 0x7b, 0xdf,
+	0x7b, 0xdf, 0xf7, 0xde, 0xd8, 0xf3, 0x66, 0x41, 0x35, 0x17, 0x75, 0x55, 0x73, 0x1c, 0xcb, 0xd4,
+	0x35, 0x66, 0x12, 0x9b, 0xaa, 0xa6, 0xcd, 0xb0, 0xab, 0x2f, 0x69, 0xa6, 0x5d, 0xa4, 0xab, 0x9a,
+	0xa3, 0xae, 0x0c, 0xab, 0xf7, 0x2b, 0xd8, 0x5d, 0xcb, 0x39, 0x2e, 0x61, 0x04, 0xbd, 0x62, 0x2e,
+	0xea, 0xb9, 0xb0, 0x43, 0x6e, 0x8b, 0x43, 0x6e, 0x65, 0x58, 0xde, 0x57, 0x22, 0x25, 0xc2, 0xed,
+	0x55, 0xef, 0x3f, 0xdf, 0x55, 0x1e, 0xd2, 0x09, 0x2d, 0x13, 0xaa, 0x2e, 0x6a, 0x14, 0xfb, 0x98,
+	0xea, 0xca, 0xf0, 0x22, 0x66, 0xda, 0xb0, 0xea, 0x68, 0x25, 0xd3, 0xe6, 0x78, 0xc2, 0x36, 0x51,
+	0x5e, 0x8e, 0xe6, 0x6a, 0x65, 0xe1, 0x70, 0x26, 0x89, 0x43, 0x59, 0x73, 0x97, 0x31, 0x13, 0x1e,
+	0xa7, 0x92, 0x78, 0xb0, 0x07, 0xc2, 0xfa, 0x50, 0x89, 0x90, 0x92, 0x85, 0x55, 0xcd, 0x31, 0x55,
+	0xcd, 0xb6, 0x09, 0x13, 0xec, 0xfd, 0xa7, 0xfd, 0x61, 0x6a, 0x01, 0x29, 0x9d, 0x98, 0x82, 0x8e,
+	0xf2, 0x0e, 0x0c, 0xdd, 0xf4, 0x08, 0x4f, 0x63, 0x96, 0xdf, 0x0c, 0x32, 0x5b, 0xb1, 0x98, 0x79,
+	0x0d, 0x3b, 0x84, 0x9a, 0x6c, 0xce, 0x35, 0xb0, 0x3b, 0x8f, 0xef, 0x57, 0x30, 0x65, 0xe8, 0x45,
+	0xe8, 0x71, 0x08, 0xb1, 0xf2, 0x46, 0x56, 0x1a, 0x90, 0x4e, 0xec, 0x9e, 0x17, 0x57, 0x28, 0x0b,
+	0xbd, 0xc4, 0xb3, 0xcb, 0x1b, 0xd9, 0x0c, 0x7f, 0x10, 0x5c, 0x2a, 0xef, 0x49, 0x70, 0x32, 0x51,
+	0x00, 0xea, 0x10, 0x9b, 0x62, 0x74, 0x13, 0x76, 0x72, 0x57, 0x1e, 0xa0, 0x6f, 0x64, 0x34, 0x97,
+	0xa0, 0xaa, 0x39, 0x0e, 0x37, 0x41, 0x29, 0x66, 0x35, 0x98, 0x3e, 0x92, 0xf2, 0x49, 0x90, 0xc2,
+	0x84, 0x65, 0x35, 0x49, 0x81, 0xc6, 0x91, 0xbc, 0x01, 0x50, 0xed, 0x06, 0xce, 0xb3, 0x6f, 0x64,
+	0x30, 0xe7, 0xeb, 0x9b, 0xf3, 0xf4, 0xcd, 0xf9, 0xed, 0x28, 0x54, 0xce, 0x15, 0xb4, 0x12, 0x16,
+	0x98, 0xf3, 0x21, 0x4f, 0xe5, 0x3b, 0x09, 0x4e, 0x25, 0xcb, 0x47, 0x68, 0xb2, 0x00, 0x3d, 0x9c,
+	0x09, 0xcd, 0x4a, 0x03, 0xdd, 0xed, 0x8a, 0x22, 0xa0, 0xd0, 0x74, 0x04, 0x9b, 0xe3, 0xb1, 0x6c,
+	0xfc, 0x8c, 0x6a, 0xe8, 0xac, 0xc0, 0x25, 0xce, 0x66, 0x46, 0x63, 0x98, 0x36, 0xab, 0xf1, 0xe4,
+	0xda, 0x02, 0xa9, 0xb8, 0x3a, 0x9e, 0xd5, 0x96, 0xe3, 0x3b, 0x6a, 0x00, 0xfa, 0x68, 0xd5, 0x5a,
+	0x74, 0x55, 0xf8, 0x96, 0xb2, 0x0f, 0x10, 0x8f, 0x5b, 0xf0, 0xd6, 0x5a, 0x50, 0x3c, 0xe5, 0x2e,
+	0xbc, 0x50, 0x73, 0x57, 0x48, 0x38, 0x05, 0x3d, 0x7c, 0x4d, 0x52, 0xd1, 0x57, 0x27, 0x13, 0x49,
+	0x28, 0x40, 0x84, 0xab, 0xb2, 0x00, 0x07, 0x39, 0xf6, 0x75, 0xaa, 0xbb, 0x64, 0x75, 0xc2, 0x30,
+	0x5c, 0x4c, 0x37, 0xbb, 0xe6, 0x00, 0xf4, 0x3a, 0xc4, 0x65, 0x45, 0x33, 0xc4, 0xc4, 0x65, 0x79,
+	0x03, 0x1d, 0x06, 0xd0, 0x97, 0x34, 0xdb, 0xc6, 0x96, 0xf7, 0xcc, 0x27, 0xb2, 0x5b, 0xdc, 0xc9,
+	0x1b, 0xca, 0x14, 0xc8, 0x51, 0xa0, 0x22, 0xef, 0x63, 0xb0, 0x17, 0xf3, 0x07, 0x45, 0xcd, 0x7f,
+	0x22, 0xc0, 0xf7, 0xe0, 0xb0, 0xb9, 0x72, 0x15, 0x06, 0xeb, 0x17, 0xd9, 0x8c, 0x79, 0xbf, 0x62,
+	0x1a, 0x26, 0x5b, 0x2b, 0x10, 0x62, 0xc5, 0xe8, 0xad, 0x3c, 0x94, 0xe0, 0x78, 0x2c, 0x84, 0x48,
+	0xea, 0x5d, 0x38, 0x60, 0x46, 0x9b, 0x08, 0x75, 0x2f, 0x27, 0x52, 0xb7, 0x41, 0x98, 0xc9, 0x1d,
+	0x8f, 0x9e, 0x1c, 0xe9, 0x9a, 0x6f, 0x14, 0x42, 0x71, 0x60, 0xb0, 0x7e, 0xf5, 0x44, 0x72, 0xad,
+	0x5d, 0xb0, 0x52, 0xea, 0x05, 0xfb, 0xb1, 0x04, 0x27, 0x9a, 0x84, 0x9c, 0xad, 0x09, 0x9a, 0x85,
+	0x5e, 0xdd, 0xc5, 0x1a, 0x23, 0xae, 0x50, 0x38, 0xb8, 0xec, 0xd8, 0xfb, 0xe3, 0xcf, 0xa0, 0x54,
+	0xcd, 0x14, 0x48, 0x52, 0xaa, 0xee, 0x6d, 0x2e, 0x55, 0xe7, 0xde, 0x31, 0xe3, 0x70, 0x34, 0xe2,
+	0x23, 0xc2, 0xbf, 0x99, 0x49, 0xde, 0x26, 0xca, 0x97, 0x12, 0x1c, 0x8b, 0x01, 0x10, 0x82, 0xad,
+	0xc0, 0x7e, 0x33, 0xca, 0x40, 0xb4, 0xcf, 0xa5, 0x16, 0xe5, 0x0a, 0x21, 0x08, 0xb1, 0xa2, 0xe1,
+	0x15, 0x1b, 0x8e, 0xd6, 0xd7, 0x34, 0x82, 0x61, 0xa7, 0x7a, 0xfa, 0xd7, 0x40, 0x91, 0xc6, 0x01,
+	0xe3, 0x15, 0xe9, 0xde, 0x46, 0x45, 0x3a, 0xd7, 0x3c, 0x1f, 0x4a, 0x90, 0x15, 0xaf, 0x58, 0x66,
+	0x96, 0x35, 0x86, 0x17, 0x56, 0x35, 0x27, 0xee, 0xfb, 0xf3, 0x1a, 0xf4, 0x32, 0xb2, 0x8c, 0xed,
+	0x7c, 0x10, 0xfa, 0x60, 0x4d, 0xe8, 0x20, 0xe8, 0x14, 0x31, 0x6d, 0x41, 0x23, 0xb0, 0x47, 0x32,
+	0xec, 0x32, 0xb0, 0x4d, 0xca, 0x73, 0x15, 0x96, 0xed, 0xe6, 0xa0, 0x9b, 0xd7, 0xca, 0x57, 0x19,
+	0x38, 0x18, 0x91, 0x8b, 0x90, 0x7a, 0x14, 0x76, 0x71, 0x10, 0xcf, 0x53, 0x4a, 0x16, 0x75, 0xd3,
+	0x01, 0x0d, 0x43, 0xf7, 0x3d, 0x8c, 0x93, 0x66, 0xeb, 0xd9, 0xa2, 0x02, 0xf4, 0x39, 0xae, 0xa9,
+	0xe3, 0x7c, 0xd9, 0xd1, 0x74, 0x91, 0xec, 0x64, 0xce, 0x7b, 0xfe, 0xd3, 0x93, 0x23, 0x83, 0x25,
+	0x93, 0x2d, 0x55, 0x16, 0x73, 0x3a, 0x29, 0xab, 0x62, 0x13, 0xe9, 0xff, 0x39, 0x4d, 0x8d, 0x65,
+	0x95, 0xad, 0x39, 0x98, 0xe6, 0xae, 0x61, 0x7d, 0x3e, 0x0c, 0x81, 0x6e, 0xc1, 0x5e, 0xea, 0x10,
+	0x56, 0xf0, 0x6e, 0x4d, 0xdc, 0x63, 0xd8, 0xcd, 0xee, 0x48, 0x05, 0xba, 0x05, 0x45, 0xf9, 0x4c,
+	0x82, 0x81, 0x3a, 0xdd, 0xae, 0x3f, 0xd0, 0x74, 0x36, 0x57, 0x61, 0x71, 0xb5, 0x0c, 0xcb, 0x9a,
+	0x69, 0x55, 0xd6, 0x2c, 0xf4, 0xf2, 0xea, 0xe5, 0x6d, 0x51, 0xcc, 0xe0, 0x52, 0xf9, 0x3a, 0x03,
+	0x2f, 0x37, 0xc9, 0x49, 0xd4, 0x34, 0xd4, 0x48, 0x52, 0x8b, 0x8d, 0xf4, 0xbf, 0xae, 0xa8, 0xbb,
+	0x55, 0x3c, 0xd3, 0x2e, 0x59, 0x58, 0xec, 0x17, 0xe3, 0x2a, 0x7a, 0x0e, 0x76, 0x72, 0x91, 0x92,
+	0x6a, 0xe3, 0x5b, 0x2b, 0x3a, 0x28, 0xcd, 0x62, 0x8a, 0x8a, 0x8d, 0xc1, 0x6e, 0x2f, 0xcc, 0x5b,
+	0x3c, 0x40, 0xc2, 0x9a, 0x55, 0x3d, 0x94, 0x0a, 0x1c, 0xaa, 0x09, 0x72, 0xdb, 0x64, 0x4b, 0x86,
+	0xab, 0xad, 0xc6, 0x71, 0xaa, 0x09, 0x9b, 0x69, 0x39, 0xec, 0x1d, 0x38, 0xdc, 0x20, 0xac, 0xa0,
+	0x75, 0x01, 0x7a, 0xb8, 0x0a, 0xc1, 0x14, 0x11, 0x0b, 0x2e, 0xcc, 0x47, 0xfe, 0x91, 0x61, 0x27,
+	0x87, 0x46, 0x0f, 0x25, 0xe8, 0xf1, 0xf7, 0xc4, 0xe8, 0x42, 0xa2, 0xd7, 0x7e, 0xfd, 0x06, 0x5d,
+	0xbe, 0xd8, 0xba, 0xa3, 0x4f, 0x40, 0x19, 0x7a, 0xff, 0xfb, 0x5f, 0x3e, 0xcf, 0x1c, 0x45, 0x4a,
+	0x30, 0x82, 0x87, 0xe7, 0xe2, 0x9a, 0xc9, 0x9b, 0xa2, 0xdf, 0x24, 0xd8, 0x53, 0xb3, 0xa3, 0x46,
+	0xe3, 0xc9, 0xe3, 0x46, 0xed, 0xef, 0xe5, 0x2b, 0xa9, 0xfd, 0x45, 0xfa, 0x77, 0x78, 0xfa, 0xf3,
+	0xa8, 0xd0, 0x2c, 0x7d, 0x31, 0x17, 0x50, 0x75, 0xbd, 0x3a, 0x33, 0x6c, 0xa8, 0x0e, 0x71, 0x19,
+	0x55, 0xd7, 0xc5, 0x7c, 0xb1, 0xa1, 0xd6, 0x8e, 0x04, 0xe8, 0x5f, 0x09, 0x0e, 0x34, 0xd8, 0xa1,
+	0xa1, 0x37, 0x92, 0xa7, 0x1d, 0x3b, 0x3c, 0xc8, 0x33, 0x9d, 0x01, 0x13, 0x82, 0xdc, 0xe0, 0x82,
+	0x5c, 0x45, 0xe3, 0xcd, 0x04, 0x09, 0xe1, 0x5b, 0x01, 0x4a, 0xd1, 0xeb, 0x7a, 0x75, 0xdd, 0x5f,
+	0x37, 0x1b, 0xe8, 0x6f, 0x09, 0xe4, 0x06, 0xb1, 0x26, 0xac, 0x96, 0x14, 0x88, 0x1d, 0x29, 0xe4,
+	0x99, 0xce, 0x80, 0x09, 0x05, 0xc6, 0xb8, 0x02, 0x17, 0xd0, 0xb9, 0x54, 0x0a, 0xa0, 0x0f, 0x32,
+	0x70, 0xa8, 0xe1, 0x3c, 0xe2, 0x51, 0x9f, 0x6d, 0x37, 0xdb, 0xd9, 0x6d, 0x24, 0x3f, 0xcd, 0xc9,
+	0x4f, 0xa0, 0x2b, 0x29, 0xcb, 0x2f, 0xc6, 0xaa, 0x0d, 0xf4, 0x97, 0x04, 0xfb, 0x23, 0xf7, 0x97,
+	0x28, 0x9f, 0xb2, 0x5f, 0xeb, 0xf7, 0xdd, 0xf2, 0xeb, 0x9d, 0x80, 0x12, 0xcc, 0xaf, 0x71, 0xe6,
+	0xe3, 0xe8, 0x72, 0x42, 0xe6, 0xfe, 0xe1, 0x60, 0xb1, 0xec, 0x81, 0x54, 0xdb, 0xfe, 0x0f, 0x09,
+	0xb2, 0x91, 0x71, 0xbc, 0xca, 0xe7, 0x53, 0x96, 0xaa, 0x3d, 0xe6, 0x71, 0xb3, 0x84, 0x32, 0xca,
+	0x99, 0x9f, 0x43, 0x67, 0x53, 0x30, 0x47, 0x5f, 0x64, 0xe0, 0xa5, 0x26, 0xc7, 0x4b, 0x68, 0x2e,
+	0x6d, 0x89, 0x1a, 0x9c, 0x76, 0xca, 0x85, 0xce, 0x01, 0x0a, 0xfe, 0xb7, 0x38, 0xff, 0x02, 0x7a,
+	0x33, 0x29, 0x7f, 0x0f, 0xa9, 0x68, 0xf8, 0x50, 0x45, 0xff, 0xe4, 0x6e, 0xb3, 0x03, 0xd4, 0x75,
+	0x71, 0xc8, 0xba, 0x81, 0xbe, 0xc9, 0xc0, 0x50, 0x68, 0xbd, 0xf1, 0x93, 0xb8, 0x88, 0xf3, 0xb7,
+	0x29, 0x71, 0x12, 0x51, 0x4c, 0x4e, 0x2c, 0xd5, 0xa9, 0xde, 0x36, 0x28, 0x57, 0xe4, 0xca, 0xbd,
+	0x8d, 0x6e, 0x77, 0x46, 0xb9, 0xd0, 0x41, 0xe2, 0x86, 0x6a, 0x69, 0x94, 0xa1, 0x8f, 0x32, 0x70,
+	0xa4, 0x49, 0x22, 0xd4, 0x5b, 0x55, 0x85, 0xb4, 0x4b, 0xa1, 0xd1, 0x59, 0xb3, 0x7c, 0xb3, 0x83,
+	0x88, 0x42, 0xa9, 0x29, 0xae, 0xd4, 0x18, 0x1a, 0x6d, 0x43, 0x29, 0xf4, 0xad, 0x04, 0xcf, 0x85,
+	0xc7, 0x1a, 0x34, 0xd6, 0xca, 0xf6, 0xa7, 0x6e, 0xcc, 0x96, 0xc7, 0xd3, 0xba, 0x0b, 0x52, 0x97,
+	0x38, 0xa9, 0x57, 0xd1, 0x48, 0x33, 0x52, 0x58, 0x78, 0x72, 0xbc, 0xea, 0x8b, 0xf2, 0x77, 0x09,
+	0xf6, 0x45, 0x8d, 0x68, 0xe8, 0x7a, 0xba, 0xa4, 0xb6, 0x8c, 0x9d, 0xf2, 0x8d, 0x76, 0x61, 0x5a,
+	0xf9, 0x2c, 0xd4, 0x70, 0x2c, 0x62, 0x0f, 0xa3, 0x48, 0x2a, 0xac, 0xe6, 0xb3, 0xb0, 0x3f, 0x72,
+	0xbe, 0x41, 0x69, 0xf2, 0x8c, 0x18, 0xca, 0xe4, 0xe9, 0xb6, 0x71, 0xd2, 0x11, 0xe6, 0x18, 0x41,
+	0xa3, 0x56, 0x09, 0xff, 0x28, 0xc1, 0xf3, 0x5b, 0x87, 0x1e, 0x34, 0xd1, 0x7a, 0x8e, 0x5b, 0xe6,
+	0x34, 0x79, 0xb2, 0x1d, 0x08, 0xc1, 0x70, 0x9c, 0x33, 0xbc, 0x88, 0xce, 0x27, 0x62, 0xb8, 0x2a,
+	0xdc, 0x37, 0xb9, 0x4d, 0xea, 0x8f, 0x9e, 0xf6, 0x4b, 0x8f, 0x9f, 0xf6, 0x4b, 0x3f, 0x3f, 0xed,
+	0x97, 0x3e, 0x7d, 0xd6, 0xdf, 0xf5, 0xf8, 0x59, 0x7f, 0xd7, 0x0f, 0xcf, 0xfa, 0xbb, 0xee, 0xe6,
+	0x43, 0x63, 0x37, 0x35, 0x0d, 0xcc, 0x7f, 0xcd, 0xd3, 0x89, 0xe5, 0x05, 0xf2, 0x81, 0xcf, 0xab,
+	0x65, 0x62, 0x54, 0x2c, 0x4c, 0xfd, 0xb8, 0xc3, 0x67, 0x86, 0x4f, 0x57, 0x63, 0x9f, 0xe6, 0x36,
+	0x7c, 0x3a, 0x5f, 0xec, 0xe1, 0xbe, 0x67, 0xff, 0x1b, 0x00, 0x8c, 0x2a, 0x39, 0xfe, 0x72, 0x1d,
+	0x00, 0x00,
 }
 
 func (m *QueryGetInterchainMultiDepositOrderRequest) Marshal() (dAtA []byte, err error) {
@@ -1687,196 +2155,424 @@ func (m *QueryAllInterchainMarketMakerResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEstimateSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGetInterchainMultiDepositOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryEstimateSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PoolId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DenomOut) > 0 {
+		i -= len(m.DenomOut)
+		copy(dAtA[i:], m.DenomOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomOut)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetInterchainMultiDepositOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Order != nil {
-		l = m.Order.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEstimateSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllInterchainMultiDepositOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PoolId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryEstimateSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllInterchainMultiDepositOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEstimateSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.SpotPriceAfter.Size()
+		i -= size
+		if _, err := m.SpotPriceAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestInterchainMultiDepositOrderBySourceMakerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEstimateSwapExactOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateSwapExactOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateSwapExactOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PoolId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DenomIn) > 0 {
+		i -= len(m.DenomIn)
+		copy(dAtA[i:], m.DenomIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomIn)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.SourceMaker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEstimateSwapExactOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryEstimateSwapExactOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEscrowAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEstimateSwapExactOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.SpotPriceAfter.Size()
+		i -= size
+		if _, err := m.SpotPriceAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryEscrowAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.EscrowAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetInterchainLiquidityPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PoolId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEstimateSingleDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetInterchainLiquidityPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.InterchainLiquidityPool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryEstimateSingleDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllInterchainLiquidityPoolRequest) Size() (n int) {
+func (m *QueryEstimateSingleDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateSingleDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateSingleDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateSingleDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetInterchainMultiDepositOrderRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryAllInterchainLiquidityMyPoolRequest) Size() (n int) {
+func (m *QueryGetInterchainMultiDepositOrderResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Creator)
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInterchainMultiDepositOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -1887,14 +2583,14 @@ func (m *QueryAllInterchainLiquidityMyPoolRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllInterchainLiquidityPoolResponse) Size() (n int) {
+func (m *QueryAllInterchainMultiDepositOrdersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.InterchainLiquidityPool) > 0 {
-		for _, e := range m.InterchainLiquidityPool {
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -1906,7 +2602,7 @@ func (m *QueryAllInterchainLiquidityPoolResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetInterchainMarketMakerRequest) Size() (n int) {
+func (m *QueryLatestInterchainMultiDepositOrderBySourceMakerRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1916,21 +2612,90 @@ func (m *QueryGetInterchainMarketMakerRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.SourceMaker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryGetInterchainMarketMakerResponse) Size() (n int) {
+func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.InterchainMarketMaker.Size()
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInterchainLiquidityPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInterchainLiquidityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InterchainLiquidityPool.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryAllInterchainMarketMakerRequest) Size() (n int) {
+func (m *QueryAllInterchainLiquidityPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1943,32 +2708,1117 @@ func (m *QueryAllInterchainMarketMakerRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllInterchainMarketMakerResponse) Size() (n int) {
+func (m *QueryAllInterchainLiquidityMyPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.InterchainMarketMaker) > 0 {
-		for _, e := range m.InterchainMarketMaker {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInterchainLiquidityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InterchainLiquidityPool) > 0 {
+		for _, e := range m.InterchainLiquidityPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInterchainMarketMakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInterchainMarketMakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InterchainMarketMaker.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllInterchainMarketMakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInterchainMarketMakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InterchainMarketMaker) > 0 {
+		for _, e := range m.InterchainMarketMaker {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateSwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.DenomOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpotPriceAfter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateSwapExactOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TokenOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.DenomIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateSwapExactOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpotPriceAfter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateSingleDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateSingleDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.PoolToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetInterchainMultiDepositOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetInterchainMultiDepositOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetInterchainMultiDepositOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetInterchainMultiDepositOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetInterchainMultiDepositOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetInterchainMultiDepositOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Order == nil {
+				m.Order = &MultiAssetDepositOrder{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInterchainMultiDepositOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInterchainMultiDepositOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInterchainMultiDepositOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInterchainMultiDepositOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInterchainMultiDepositOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInterchainMultiDepositOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &MultiAssetDepositOrder{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestInterchainMultiDepositOrderBySourceMakerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestInterchainMultiDepositOrderBySourceMakerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestInterchainMultiDepositOrderBySourceMakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceMaker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceMaker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetInterchainMultiDepositOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetInterchainLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1991,10 +3841,10 @@ func (m *QueryGetInterchainMultiDepositOrderRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInterchainMultiDepositOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetInterchainLiquidityPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInterchainMultiDepositOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetInterchainLiquidityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2029,11 +3879,61 @@ func (m *QueryGetInterchainMultiDepositOrderRequest) Unmarshal(dAtA []byte) erro
 			}
 			m.PoolId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetInterchainLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetInterchainLiquidityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetInterchainLiquidityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainLiquidityPool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2043,23 +3943,24 @@ func (m *QueryGetInterchainMultiDepositOrderRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			if err := m.InterchainLiquidityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2082,7 +3983,7 @@ func (m *QueryGetInterchainMultiDepositOrderRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetInterchainMultiDepositOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInterchainLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2105,15 +4006,15 @@ func (m *QueryGetInterchainMultiDepositOrderResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInterchainMultiDepositOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInterchainLiquidityPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInterchainMultiDepositOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInterchainLiquidityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2140,10 +4041,10 @@ func (m *QueryGetInterchainMultiDepositOrderResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Order == nil {
-				m.Order = &MultiAssetDepositOrder{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2168,7 +4069,7 @@ func (m *QueryGetInterchainMultiDepositOrderResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryAllInterchainMultiDepositOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInterchainLiquidityMyPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2191,15 +4092,15 @@ func (m *QueryAllInterchainMultiDepositOrdersRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInterchainMultiDepositOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInterchainLiquidityMyPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInterchainMultiDepositOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInterchainLiquidityMyPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2227,7 +4128,7 @@ func (m *QueryAllInterchainMultiDepositOrdersRequest) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolId = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2286,7 +4187,7 @@ func (m *QueryAllInterchainMultiDepositOrdersRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryAllInterchainMultiDepositOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInterchainLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2309,15 +4210,15 @@ func (m *QueryAllInterchainMultiDepositOrdersResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInterchainMultiDepositOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInterchainLiquidityPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInterchainMultiDepositOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInterchainLiquidityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainLiquidityPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2344,8 +4245,8 @@ func (m *QueryAllInterchainMultiDepositOrdersResponse) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &MultiAssetDepositOrder{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InterchainLiquidityPool = append(m.InterchainLiquidityPool, InterchainLiquidityPool{})
+			if err := m.InterchainLiquidityPool[len(m.InterchainLiquidityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2406,7 +4307,7 @@ func (m *QueryAllInterchainMultiDepositOrdersResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryLatestInterchainMultiDepositOrderBySourceMakerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetInterchainMarketMakerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2429,10 +4330,10 @@ func (m *QueryLatestInterchainMultiDepositOrderBySourceMakerRequest) Unmarshal(d
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestInterchainMultiDepositOrderBySourceMakerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetInterchainMarketMakerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestInterchainMultiDepositOrderBySourceMakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetInterchainMarketMakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2467,38 +4368,6 @@ func (m *QueryLatestInterchainMultiDepositOrderBySourceMakerRequest) Unmarshal(d
 			}
 			m.PoolId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceMaker", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceMaker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2520,7 +4389,7 @@ func (m *QueryLatestInterchainMultiDepositOrderBySourceMakerRequest) Unmarshal(d
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetInterchainMarketMakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2530,25 +4399,58 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetInterchainMarketMakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetInterchainMarketMakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainMarketMaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.InterchainMarketMaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2570,7 +4472,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInterchainMarketMakerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2593,15 +4495,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInterchainMarketMakerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInterchainMarketMakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2628,10 +4530,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2656,7 +4558,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInterchainMarketMakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2679,17 +4581,17 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInterchainMarketMakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInterchainMarketMakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainMarketMaker", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2699,29 +4601,31 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.InterchainMarketMaker = append(m.InterchainMarketMaker, InterchainMarketMaker{})
+			if err := m.InterchainMarketMaker[len(m.InterchainMarketMaker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2731,23 +4635,27 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2770,7 +4678,7 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateSwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2793,15 +4701,15 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateSwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2829,61 +4737,44 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			m.PoolId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetInterchainLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInterchainLiquidityPoolRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInterchainLiquidityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2911,7 +4802,7 @@ func (m *QueryGetInterchainLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolId = string(dAtA[iNdEx:postIndex])
+			m.DenomOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2934,7 +4825,7 @@ func (m *QueryGetInterchainLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInterchainLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2957,15 +4848,15 @@ func (m *QueryGetInterchainLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInterchainLiquidityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInterchainLiquidityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterchainLiquidityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2992,65 +4883,82 @@ func (m *QueryGetInterchainLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InterchainLiquidityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllInterchainLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInterchainLiquidityPoolRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInterchainLiquidityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPriceAfter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3060,25 +4968,23 @@ func (m *QueryAllInterchainLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpotPriceAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3103,7 +5009,7 @@ func (m *QueryAllInterchainLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInterchainLiquidityMyPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateSwapExactOutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3126,15 +5032,15 @@ func (m *QueryAllInterchainLiquidityMyPoolRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInterchainLiquidityMyPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateSwapExactOutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInterchainLiquidityMyPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateSwapExactOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3162,11 +5068,11 @@ func (m *QueryAllInterchainLiquidityMyPoolRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.PoolId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3193,13 +5099,42 @@ func (m *QueryAllInterchainLiquidityMyPoolRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3221,7 +5156,7 @@ func (m *QueryAllInterchainLiquidityMyPoolRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryAllInterchainLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateSwapExactOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3244,15 +5179,15 @@ func (m *QueryAllInterchainLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInterchainLiquidityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateSwapExactOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInterchainLiquidityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateSwapExactOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterchainLiquidityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3279,14 +5214,13 @@ func (m *QueryAllInterchainLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterchainLiquidityPool = append(m.InterchainLiquidityPool, InterchainLiquidityPool{})
-			if err := m.InterchainLiquidityPool[len(m.InterchainLiquidityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3313,10 +5247,75 @@ func (m *QueryAllInterchainLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPriceAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPriceAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3341,7 +5340,7 @@ func (m *QueryAllInterchainLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInterchainMarketMakerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateSingleDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3364,10 +5363,10 @@ func (m *QueryGetInterchainMarketMakerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInterchainMarketMakerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateSingleDepositRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInterchainMarketMakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateSingleDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3402,6 +5401,39 @@ func (m *QueryGetInterchainMarketMakerRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PoolId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3423,7 +5455,7 @@ func (m *QueryGetInterchainMarketMakerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInterchainMarketMakerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateSingleDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3446,15 +5478,15 @@ func (m *QueryGetInterchainMarketMakerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInterchainMarketMakerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateSingleDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInterchainMarketMakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateSingleDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterchainMarketMaker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3481,7 +5513,7 @@ func (m *QueryGetInterchainMarketMakerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InterchainMarketMaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3506,7 +5538,7 @@ func (m *QueryGetInterchainMarketMakerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInterchainMarketMakerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateWithdrawRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3529,15 +5561,47 @@ func (m *QueryAllInterchainMarketMakerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInterchainMarketMakerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateWithdrawRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInterchainMarketMakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3564,10 +5628,7 @@ func (m *QueryAllInterchainMarketMakerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3592,7 +5653,7 @@ func (m *QueryAllInterchainMarketMakerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInterchainMarketMakerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateWithdrawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3615,49 +5676,15 @@ func (m *QueryAllInterchainMarketMakerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInterchainMarketMakerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateWithdrawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInterchainMarketMakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterchainMarketMaker", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InterchainMarketMaker = append(m.InterchainMarketMaker, InterchainMarketMaker{})
-			if err := m.InterchainMarketMaker[len(m.InterchainMarketMaker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3684,10 +5711,8 @@ func (m *QueryAllInterchainMarketMakerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
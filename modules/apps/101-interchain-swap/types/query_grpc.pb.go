@@ -32,6 +32,14 @@ type QueryClient interface {
 	InterchainMultiDepositOrder(ctx context.Context, in *QueryGetInterchainMultiDepositOrderRequest, opts ...grpc.CallOption) (*QueryGetInterchainMultiDepositOrderResponse, error)
 	InterchainLatestMultiDepositOrderByCreator(ctx context.Context, in *QueryLatestInterchainMultiDepositOrderBySourceMakerRequest, opts ...grpc.CallOption) (*QueryGetInterchainMultiDepositOrderResponse, error)
 	InterchainMultiDepositOrdersAll(ctx context.Context, in *QueryAllInterchainMultiDepositOrdersRequest, opts ...grpc.CallOption) (*QueryAllInterchainMultiDepositOrdersResponse, error)
+	// EstimateSwap quotes the output of a left swap of tokenIn for denomOut.
+	EstimateSwap(ctx context.Context, in *QueryEstimateSwapRequest, opts ...grpc.CallOption) (*QueryEstimateSwapResponse, error)
+	// EstimateSwapExactOut quotes the input of denomIn of a right swap for tokenOut.
+	EstimateSwapExactOut(ctx context.Context, in *QueryEstimateSwapExactOutRequest, opts ...grpc.CallOption) (*QueryEstimateSwapExactOutResponse, error)
+	// EstimateSingleDeposit quotes the pool tokens issued for a single asset deposit.
+	EstimateSingleDeposit(ctx context.Context, in *QueryEstimateSingleDepositRequest, opts ...grpc.CallOption) (*QueryEstimateSingleDepositResponse, error)
+	// EstimateWithdraw quotes the assets redeemed by pool tokens.
+	EstimateWithdraw(ctx context.Context, in *QueryEstimateWithdrawRequest, opts ...grpc.CallOption) (*QueryEstimateWithdrawResponse, error)
 }
 
 type queryClient struct {
@@ -132,6 +140,42 @@ func (c *queryClient) InterchainMultiDepositOrdersAll(ctx context.Context, in *Q
 	return out, nil
 }
 
+func (c *queryClient) EstimateSwap(ctx context.Context, in *QueryEstimateSwapRequest, opts ...grpc.CallOption) (*QueryEstimateSwapResponse, error) {
+	out := new(QueryEstimateSwapResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_swap.v1.Query/EstimateSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateSwapExactOut(ctx context.Context, in *QueryEstimateSwapExactOutRequest, opts ...grpc.CallOption) (*QueryEstimateSwapExactOutResponse, error) {
+	out := new(QueryEstimateSwapExactOutResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_swap.v1.Query/EstimateSwapExactOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateSingleDeposit(ctx context.Context, in *QueryEstimateSingleDepositRequest, opts ...grpc.CallOption) (*QueryEstimateSingleDepositResponse, error) {
+	out := new(QueryEstimateSingleDepositResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_swap.v1.Query/EstimateSingleDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateWithdraw(ctx context.Context, in *QueryEstimateWithdrawRequest, opts ...grpc.CallOption) (*QueryEstimateWithdrawResponse, error) {
+	out := new(QueryEstimateWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_swap.v1.Query/EstimateWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations should embed UnimplementedQueryServer
 // for forward compatibility
@@ -150,6 +194,14 @@ type QueryServer interface {
 	InterchainMultiDepositOrder(context.Context, *QueryGetInterchainMultiDepositOrderRequest) (*QueryGetInterchainMultiDepositOrderResponse, error)
 	InterchainLatestMultiDepositOrderByCreator(context.Context, *QueryLatestInterchainMultiDepositOrderBySourceMakerRequest) (*QueryGetInterchainMultiDepositOrderResponse, error)
 	InterchainMultiDepositOrdersAll(context.Context, *QueryAllInterchainMultiDepositOrdersRequest) (*QueryAllInterchainMultiDepositOrdersResponse, error)
+	// EstimateSwap quotes the output of a left swap of tokenIn for denomOut.
+	EstimateSwap(context.Context, *QueryEstimateSwapRequest) (*QueryEstimateSwapResponse, error)
+	// EstimateSwapExactOut quotes the input of denomIn of a right swap for tokenOut.
+	EstimateSwapExactOut(context.Context, *QueryEstimateSwapExactOutRequest) (*QueryEstimateSwapExactOutResponse, error)
+	// EstimateSingleDeposit quotes the pool tokens issued for a single asset deposit.
+	EstimateSingleDeposit(context.Context, *QueryEstimateSingleDepositRequest) (*QueryEstimateSingleDepositResponse, error)
+	// EstimateWithdraw quotes the assets redeemed by pool tokens.
+	EstimateWithdraw(context.Context, *QueryEstimateWithdrawRequest) (*QueryEstimateWithdrawResponse, error)
 }
 
 // UnimplementedQueryServer should be embedded to have forward compatible implementations.
@@ -186,6 +238,18 @@ func (UnimplementedQueryServer) InterchainLatestMultiDepositOrderByCreator(conte
 func (UnimplementedQueryServer) InterchainMultiDepositOrdersAll(context.Context, *QueryAllInterchainMultiDepositOrdersRequest) (*QueryAllInterchainMultiDepositOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InterchainMultiDepositOrdersAll not implemented")
 }
+func (UnimplementedQueryServer) EstimateSwap(context.Context, *QueryEstimateSwapRequest) (*QueryEstimateSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwap not implemented")
+}
+func (UnimplementedQueryServer) EstimateSwapExactOut(context.Context, *QueryEstimateSwapExactOutRequest) (*QueryEstimateSwapExactOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactOut not implemented")
+}
+func (UnimplementedQueryServer) EstimateSingleDeposit(context.Context, *QueryEstimateSingleDepositRequest) (*QueryEstimateSingleDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSingleDeposit not implemented")
+}
+func (UnimplementedQueryServer) EstimateWithdraw(context.Context, *QueryEstimateWithdrawRequest) (*QueryEstimateWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateWithdraw not implemented")
+}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to QueryServer will
@@ -378,6 +442,78 @@ func _Query_InterchainMultiDepositOrdersAll_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EstimateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_swap.v1.Query/EstimateSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwap(ctx, req.(*QueryEstimateSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateSwapExactOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateSwapExactOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSwapExactOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_swap.v1.Query/EstimateSwapExactOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSwapExactOut(ctx, req.(*QueryEstimateSwapExactOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateSingleDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateSingleDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSingleDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_swap.v1.Query/EstimateSingleDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSingleDeposit(ctx, req.(*QueryEstimateSingleDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_swap.v1.Query/EstimateWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateWithdraw(ctx, req.(*QueryEstimateWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -425,6 +561,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InterchainMultiDepositOrdersAll",
 			Handler:    _Query_InterchainMultiDepositOrdersAll_Handler,
 		},
+		{
+			MethodName: "EstimateSwap",
+			Handler:    _Query_EstimateSwap_Handler,
+		},
+		{
+			MethodName: "EstimateSwapExactOut",
+			Handler:    _Query_EstimateSwapExactOut_Handler,
+		},
+		{
+			MethodName: "EstimateSingleDeposit",
+			Handler:    _Query_EstimateSingleDeposit_Handler,
+		},
+		{
+			MethodName: "EstimateWithdraw",
+			Handler:    _Query_EstimateWithdraw_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_swap/v1/query.proto",
@@ -0,0 +1,484 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxNewtonIterations bounds the iterations of the Newton solvers of the StableSwap invariant, they
+// converge within a few dozens of iterations for balanced pools.
+const maxNewtonIterations = 255
+
+var bigOne = big.NewInt(1)
+
+// NewAmplification returns a constant amplification.
+func NewAmplification(a uint64) *Amplification {
+	return &Amplification{InitialA: a, FutureA: a}
+}
+
+// At returns the amplification at a unix time in seconds, it is interpolated linearly during a ramp.
+func (a *Amplification) At(time int64) uint64 {
+	if a == nil {
+		return 0
+	}
+	if time >= a.FutureTime || a.FutureTime <= a.InitialTime {
+		return a.FutureA
+	}
+	if time <= a.InitialTime {
+		return a.InitialA
+	}
+	elapsed := uint64(time - a.InitialTime)
+	duration := uint64(a.FutureTime - a.InitialTime)
+	if a.FutureA > a.InitialA {
+		return a.InitialA + (a.FutureA-a.InitialA)*elapsed/duration
+	}
+	return a.InitialA - (a.InitialA-a.FutureA)*elapsed/duration
+}
+
+// ValidateAmplificationRamp checks a ramp from the amplification initialA at now to futureA at futureTime.
+func ValidateAmplificationRamp(initialA, futureA uint64, now, futureTime int64) error {
+	if futureA == 0 || futureA > MaxAmplification {
+		return ErrInvalidAmplification
+	}
+	if futureTime < now+MinAmplificationRampDuration {
+		return fmt.Errorf("%w: the ramp has to last %d seconds at least", ErrInvalidAmplificationRamp, MinAmplificationRampDuration)
+	}
+	if futureA > initialA*MaxAmplificationChange || futureA*MaxAmplificationChange < initialA {
+		return fmt.Errorf("%w: the amplification can change by a factor %d at most", ErrInvalidAmplificationRamp, MaxAmplificationChange)
+	}
+	return nil
+}
+
+// StableSwapMarketMaker implements the Curve StableSwap invariant of the stable pools
+//
+//	A * n^n * Σx + D = A * D * n^n + D^(n+1) / (n^n * Πx)
+//
+// over the balances x normalized to 18 decimals. D and the balances of the swaps are solved with
+// Newton's method on integers. The withdrawals are proportional as in weighted pools.
+type StableSwapMarketMaker struct {
+	*InterchainMarketMaker
+	// the amplification A at the block time
+	Amplification uint64
+}
+
+var _ MarketMaker = &StableSwapMarketMaker{}
+
+// Create new stable swap market maker
+func NewStableSwapMarketMaker(pool *InterchainLiquidityPool, amplification uint64) *StableSwapMarketMaker {
+	return &StableSwapMarketMaker{
+		InterchainMarketMaker: NewInterchainMarketMaker(pool),
+		Amplification:         amplification,
+	}
+}
+
+// MarketPrice returns the marginal price of denomOut in denomIn, the ratio of the partial derivatives of
+// the invariant Ann + D^(n+1) / (n^n * Πx * x_k) for the assets out and in.
+func (ssm *StableSwapMarketMaker) MarketPrice(denomIn, denomOut string) (*types.Dec, error) {
+	i, err := ssm.assetIndex(denomIn)
+	if err != nil {
+		return nil, err
+	}
+	j, err := ssm.assetIndex(denomOut)
+	if err != nil {
+		return nil, err
+	}
+	xp, scales, err := ssm.balances()
+	if err != nil {
+		return nil, err
+	}
+	ann, err := ssm.ann()
+	if err != nil {
+		return nil, err
+	}
+	d, err := stableD(xp, ann)
+	if err != nil {
+		return nil, err
+	}
+	dP := stableDP(xp, d)
+
+	// x_i * (Ann * x_j + dP) / (x_j * (Ann * x_i + dP)) in normalized units, scaled to the decimals
+	numerator := new(big.Int).Mul(ann, xp[j])
+	numerator.Add(numerator, dP).Mul(numerator, xp[i]).Mul(numerator, scales[j]).Mul(numerator, types.OneDec().BigInt())
+	denominator := new(big.Int).Mul(ann, xp[i])
+	denominator.Add(denominator, dP).Mul(denominator, xp[j]).Mul(denominator, scales[i])
+	price := types.NewDecFromBigIntWithPrec(numerator.Quo(numerator, denominator), types.Precision)
+	return &price, nil
+}
+
+// P_issued = P_supply * (D2 - D0) / D0, D2 the invariant of the balances less the imbalance fees
+//
+// As in Curve, the deposit pays the swap fee scaled by n / (4 * (n - 1)) on the deviation of every balance
+// from the balances of a proportional deposit, otherwise a single asset deposit followed by a multi asset
+// withdraw would swap without fees. The fees stay in the pool.
+func (ssm *StableSwapMarketMaker) DepositSingleAsset(token types.Coin) (*types.Coin, error) {
+	i, err := ssm.assetIndex(token.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if ssm.Pool.Status != PoolStatus_ACTIVE {
+		return nil, ErrNotReadyForSwap
+	}
+	xp, scales, err := ssm.balances()
+	if err != nil {
+		return nil, err
+	}
+	ann, err := ssm.ann()
+	if err != nil {
+		return nil, err
+	}
+	d0, err := stableD(xp, ann)
+	if err != nil {
+		return nil, err
+	}
+	balances := make([]*big.Int, len(xp))
+	copy(balances, xp)
+	xp[i] = new(big.Int).Add(xp[i], new(big.Int).Mul(token.Amount.BigInt(), scales[i]))
+	d1, err := stableD(xp, ann)
+	if err != nil {
+		return nil, err
+	}
+
+	n := int64(len(xp))
+	feeNumerator := new(big.Int).Mul(big.NewInt(int64(ssm.Pool.SwapFee)), big.NewInt(n))
+	feeDenominator := big.NewInt(10000 * 4 * (n - 1))
+	for k := range xp {
+		ideal := new(big.Int).Mul(d1, balances[k])
+		ideal.Quo(ideal, d0)
+		fee := ideal.Sub(ideal, xp[k]).Abs(ideal)
+		fee.Mul(fee, feeNumerator).Quo(fee, feeDenominator)
+		xp[k] = new(big.Int).Sub(xp[k], fee)
+	}
+	d2, err := stableD(xp, ann)
+	if err != nil {
+		return nil, err
+	}
+
+	issueAmount := new(big.Int).Sub(d2, d0)
+	if issueAmount.Sign() < 0 {
+		issueAmount.SetInt64(0)
+	}
+	issueAmount.Mul(issueAmount, ssm.Pool.Supply.Amount.BigInt()).Quo(issueAmount, d0)
+	return &types.Coin{
+		Amount: types.NewIntFromBigInt(issueAmount),
+		Denom:  ssm.Pool.Supply.Denom,
+	}, nil
+}
+
+// P_issued = P_supply * Dt / ΣB, the deposits and balances normalized to 18 decimals
+// The initial deposit of a pool issues its invariant D in units, split by the weights of the assets.
+func (ssm *StableSwapMarketMaker) DepositMultiAsset(coins types.Coins) ([]*types.Coin, error) {
+	xp, scales, err := ssm.balances()
+	if err != nil {
+		return nil, err
+	}
+	if ssm.Pool.Status == PoolStatus_INITIALIZED {
+		ann, err := ssm.ann()
+		if err != nil {
+			return nil, err
+		}
+		d, err := stableD(xp, ann)
+		if err != nil {
+			return nil, err
+		}
+		invariant := types.NewDecFromBigIntWithPrec(d, types.Precision)
+		outTokens := []*types.Coin{}
+		for _, coin := range coins {
+			i, err := ssm.assetIndex(coin.Denom)
+			if err != nil {
+				return nil, err
+			}
+			issueAmount := invariant.MulInt64(int64(ssm.Pool.Assets[i].Weight)).QuoInt64(100)
+			outTokens = append(outTokens, &types.Coin{
+				Amount: issueAmount.RoundInt(),
+				Denom:  ssm.Pool.Supply.Denom,
+			})
+		}
+		return outTokens, nil
+	}
+	sum := new(big.Int)
+	for _, x := range xp {
+		sum.Add(sum, x)
+	}
+
+	outTokens := []*types.Coin{}
+	for _, coin := range coins {
+		i, err := ssm.assetIndex(coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+		issueAmount := new(big.Int).Mul(coin.Amount.BigInt(), scales[i])
+		issueAmount.Mul(issueAmount, ssm.Pool.Supply.Amount.BigInt()).Quo(issueAmount, sum)
+		outTokens = append(outTokens, &types.Coin{
+			Amount: types.NewIntFromBigInt(issueAmount),
+			Denom:  ssm.Pool.Supply.Denom,
+		})
+	}
+	return outTokens, nil
+}
+
+// LeftSwap implements OutGivenIn
+// Input how many coins you want to sell, output an amount you will receive
+// Ao = Bo - y(Bi + Ai), the fees are taken from the input
+func (ssm *StableSwapMarketMaker) LeftSwap(amountIn types.Coin, denomOut string) (*types.Coin, error) {
+	i, err := ssm.assetIndex(amountIn.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("left swap failed: could not find asset in by denom")
+	}
+	j, err := ssm.assetIndex(denomOut)
+	if err != nil {
+		return nil, fmt.Errorf("left swap failed: could not find asset out by denom")
+	}
+	xp, scales, err := ssm.balances()
+	if err != nil {
+		return nil, err
+	}
+	ann, err := ssm.ann()
+	if err != nil {
+		return nil, err
+	}
+	d, err := stableD(xp, ann)
+	if err != nil {
+		return nil, err
+	}
+
+	dx := new(big.Int).Mul(ssm.MinusFees(amountIn.Amount).TruncateInt().BigInt(), scales[i])
+	y, err := stableY(xp, ann, d, i, j, new(big.Int).Add(xp[i], dx))
+	if err != nil {
+		return nil, err
+	}
+
+	// the output is rounded down in favor of the pool
+	dy := new(big.Int).Sub(xp[j], y)
+	dy.Sub(dy, bigOne)
+	if dy.Sign() < 0 {
+		dy.SetInt64(0)
+	}
+	return &types.Coin{
+		Amount: types.NewIntFromBigInt(dy.Quo(dy, scales[j])),
+		Denom:  denomOut,
+	}, nil
+}
+
+// RightSwap implements InGivenOut
+// Input how many coins you want to buy, output an amount you need to pay
+// Ai = (x(Bo - Ao) - Bi) / (1 - fee)
+func (ssm *StableSwapMarketMaker) RightSwap(amountIn types.Coin, amountOut types.Coin) (*types.Coin, error) {
+	amountRequired, err := ssm.InGivenOut(amountIn.Denom, amountOut)
+	if err != nil {
+		return nil, err
+	}
+	if amountIn.Amount.LT(amountRequired.Amount) {
+		return nil, fmt.Errorf("right swap failed: insufficient amount")
+	}
+	return amountRequired, nil
+}
+
+// InGivenOut returns the amount of denomIn to pay for amountOut, fees included
+// Ai = (x(Bo - Ao) - Bi) / (1 - fee)
+func (ssm *StableSwapMarketMaker) InGivenOut(denomIn string, amountOut types.Coin) (*types.Coin, error) {
+	i, err := ssm.assetIndex(denomIn)
+	if err != nil {
+		return nil, fmt.Errorf("right swap failed: could not find asset in by denom")
+	}
+	j, err := ssm.assetIndex(amountOut.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("right swap failed: could not find asset out by denom")
+	}
+	if ssm.Pool.SwapFee >= 10000 {
+		return nil, ErrInvalidSwapFee
+	}
+	xp, scales, err := ssm.balances()
+	if err != nil {
+		return nil, err
+	}
+	dy := new(big.Int).Mul(amountOut.Amount.BigInt(), scales[j])
+	if dy.Cmp(xp[j]) >= 0 {
+		return nil, fmt.Errorf("right swap failed: insufficient liquidity")
+	}
+	ann, err := ssm.ann()
+	if err != nil {
+		return nil, err
+	}
+	d, err := stableD(xp, ann)
+	if err != nil {
+		return nil, err
+	}
+
+	x, err := stableY(xp, ann, d, j, i, new(big.Int).Sub(xp[j], dy))
+	if err != nil {
+		return nil, err
+	}
+
+	// the input is rounded up in favor of the pool, then grossed up by the fees
+	dx := new(big.Int).Sub(x, xp[i])
+	dx.Add(dx, bigOne)
+	if dx.Sign() < 0 {
+		dx.SetInt64(0)
+	}
+	dx = ceilQuo(dx, scales[i])
+	dx = ceilQuo(dx.Mul(dx, big.NewInt(10000)), big.NewInt(int64(10000-ssm.Pool.SwapFee)))
+	return &types.Coin{
+		Amount: types.NewIntFromBigInt(dx),
+		Denom:  denomIn,
+	}, nil
+}
+
+// Invariant returns D with the balances normalized to units, it is zero if D can not be solved.
+func (ssm *StableSwapMarketMaker) Invariant() types.Dec {
+	xp, _, err := ssm.balances()
+	if err != nil {
+		return types.ZeroDec()
+	}
+	ann, err := ssm.ann()
+	if err != nil {
+		return types.ZeroDec()
+	}
+	d, err := stableD(xp, ann)
+	if err != nil {
+		return types.ZeroDec()
+	}
+	return types.NewDecFromBigIntWithPrec(d, types.Precision)
+}
+
+// index of the pool asset of a denom
+func (ssm *StableSwapMarketMaker) assetIndex(denom string) (int, error) {
+	for index, asset := range ssm.Pool.Assets {
+		if asset.Balance.Denom == denom {
+			return index, nil
+		}
+	}
+	return 0, ErrNotFoundDenomInPool
+}
+
+// balances returns the balances of the pool assets normalized to 18 decimals and their scales,
+// 10^(18 - decimal). The balances have to be positive.
+func (ssm *StableSwapMarketMaker) balances() ([]*big.Int, []*big.Int, error) {
+	xp := []*big.Int{}
+	scales := []*big.Int{}
+	for _, asset := range ssm.Pool.Assets {
+		if asset.Decimal > types.Precision {
+			return nil, nil, ErrInvalidDecimalPair
+		}
+		if !asset.Balance.Amount.IsPositive() {
+			return nil, nil, ErrInvalidAmount
+		}
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(types.Precision-asset.Decimal)), nil)
+		xp = append(xp, new(big.Int).Mul(asset.Balance.Amount.BigInt(), scale))
+		scales = append(scales, scale)
+	}
+	return xp, scales, nil
+}
+
+// ann returns A * n^n
+func (ssm *StableSwapMarketMaker) ann() (*big.Int, error) {
+	if ssm.Amplification == 0 || ssm.Amplification > MaxAmplification {
+		return nil, ErrInvalidAmplification
+	}
+	n := big.NewInt(int64(len(ssm.Pool.Assets)))
+	ann := new(big.Int).Exp(n, n, nil)
+	return ann.Mul(ann, new(big.Int).SetUint64(ssm.Amplification)), nil
+}
+
+// stableD solves the invariant D of the positive balances xp with Newton's method
+//
+//	D = (Ann * S + n * D_P) * D / ((Ann - 1) * D + (n + 1) * D_P), D_P = D^(n+1) / (n^n * Πx)
+//
+// starting from S = Σx.
+func stableD(xp []*big.Int, ann *big.Int) (*big.Int, error) {
+	n := big.NewInt(int64(len(xp)))
+	sum := new(big.Int)
+	for _, x := range xp {
+		sum.Add(sum, x)
+	}
+	if sum.Sign() == 0 {
+		return sum, nil
+	}
+
+	annSum := new(big.Int).Mul(ann, sum)
+	annMinusOne := new(big.Int).Sub(ann, bigOne)
+	nPlusOne := new(big.Int).Add(n, bigOne)
+	d := new(big.Int).Set(sum)
+	for iteration := 0; iteration < maxNewtonIterations; iteration++ {
+		dP := stableDP(xp, d)
+		previous := d
+
+		numerator := new(big.Int).Mul(n, dP)
+		numerator.Add(numerator, annSum).Mul(numerator, previous)
+		denominator := new(big.Int).Mul(annMinusOne, previous)
+		denominator.Add(denominator, new(big.Int).Mul(nPlusOne, dP))
+		d = numerator.Quo(numerator, denominator)
+
+		if withinOne(d, previous) {
+			return d, nil
+		}
+	}
+	return nil, ErrInvariantNotConverged
+}
+
+// stableDP returns D^(n+1) / (n^n * Πx), divided by each balance in turn to bound its magnitude
+func stableDP(xp []*big.Int, d *big.Int) *big.Int {
+	n := big.NewInt(int64(len(xp)))
+	dP := new(big.Int).Set(d)
+	for _, x := range xp {
+		dP.Mul(dP, d).Quo(dP, new(big.Int).Mul(x, n))
+	}
+	return dP
+}
+
+// stableY solves the balance j of the invariant d when the balance i is x and the other balances of xp
+// are unchanged, with Newton's method on
+//
+//	y^2 + (b - D) * y = c, b = S' + D / Ann, c = D^(n+1) / (n^n * Πx' * Ann)
+//
+// where S' and Πx' cover the balances besides j.
+func stableY(xp []*big.Int, ann, d *big.Int, i, j int, x *big.Int) (*big.Int, error) {
+	n := big.NewInt(int64(len(xp)))
+	c := new(big.Int).Set(d)
+	sum := new(big.Int)
+	for k, balance := range xp {
+		if k == j {
+			continue
+		}
+		if k == i {
+			balance = x
+		}
+		sum.Add(sum, balance)
+		c.Mul(c, d).Quo(c, new(big.Int).Mul(balance, n))
+	}
+	c.Mul(c, d).Quo(c, new(big.Int).Mul(ann, n))
+	b := new(big.Int).Quo(d, ann)
+	b.Add(b, sum)
+
+	y := new(big.Int).Set(d)
+	for iteration := 0; iteration < maxNewtonIterations; iteration++ {
+		previous := y
+
+		// y = (y^2 + c) / (2 * y + b - D)
+		numerator := new(big.Int).Mul(previous, previous)
+		numerator.Add(numerator, c)
+		denominator := new(big.Int).Lsh(previous, 1)
+		denominator.Add(denominator, b).Sub(denominator, d)
+		y = numerator.Quo(numerator, denominator)
+
+		if withinOne(y, previous) {
+			return y, nil
+		}
+	}
+	return nil, ErrInvariantNotConverged
+}
+
+// withinOne returns true if |a - b| <= 1
+func withinOne(a, b *big.Int) bool {
+	return new(big.Int).Sub(a, b).CmpAbs(bigOne) <= 0
+}
+
+// ceilQuo returns a / b rounded up for a non negative a and a positive b
+func ceilQuo(a, b *big.Int) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(a, b, new(big.Int))
+	if remainder.Sign() > 0 {
+		quotient.Add(quotient, bigOne)
+	}
+	return quotient
+}
@@ -7,19 +7,20 @@ import (
 )
 
 func ValidateLiquidityBasic(liquidity []*PoolAsset) error {
-	if len(liquidity) != 2 {
+	if len(liquidity) < MinPoolAssets || len(liquidity) > MaxPoolAssets {
 		return ErrInvalidDenomPair
 	}
 
 	weightSum := 0
+	denoms := map[string]bool{}
 	for _, asset := range liquidity {
-		if asset.Balance.Amount.Equal(sdk.NewInt(0)) {
+		if asset.Balance == nil || !asset.Balance.Amount.IsPositive() {
 			return ErrInvalidAmount
 		}
 		if asset.Decimal > 18 {
 			return ErrInvalidDecimalPair
 		}
-		if asset.Weight >= 100 {
+		if asset.Weight == 0 || asset.Weight >= 100 {
 			return ErrInvalidWeight
 		}
 		weightSum += int(asset.Weight)
@@ -28,6 +29,30 @@ func ValidateLiquidityBasic(liquidity []*PoolAsset) error {
 	if weightSum != 100 {
 		return ErrInvalidWeightPair
 	}
+
+	for _, asset := range liquidity {
+		if denoms[asset.Balance.Denom] {
+			return ErrInvalidDenomPair
+		}
+		denoms[asset.Balance.Denom] = true
+	}
+	return nil
+}
+
+// ValidatePoolType checks the amplification of a pool type, only stable pools are amplified.
+func ValidatePoolType(poolType PoolType, amplification uint64) error {
+	switch poolType {
+	case PoolType_WEIGHTED:
+		if amplification != 0 {
+			return ErrInvalidAmplification
+		}
+	case PoolType_STABLE:
+		if amplification == 0 || amplification > MaxAmplification {
+			return ErrInvalidAmplification
+		}
+	default:
+		return ErrInvalidPoolType
+	}
 	return nil
 }
 
@@ -39,6 +64,14 @@ func GetCoinsFromDepositAssets(assets []*DepositAsset) []*sdk.Coin {
 	return coins
 }
 
+func GetCoinsFromPointers(coins []*sdk.Coin) sdk.Coins {
+	var result sdk.Coins
+	for _, coin := range coins {
+		result = append(result, *coin)
+	}
+	return result
+}
+
 func GetEventAttrOfAsset(assets []*DepositAsset) []sdk.Attribute {
 	var attr []sdk.Attribute
 	for index, asset := range assets {
@@ -61,6 +61,9 @@ type MsgMakePoolRequest struct {
 	SwapFee             uint32        `protobuf:"varint,6,opt,name=swapFee,proto3" json:"swapFee,omitempty"`
 	TimeoutHeight       *types.Height `protobuf:"bytes,8,opt,name=timeoutHeight,proto3" json:"timeoutHeight,omitempty" yaml:"timeout_height"`
 	TimeoutTimeStamp    uint64        `protobuf:"varint,9,opt,name=timeoutTimeStamp,proto3" json:"timeoutTimeStamp,omitempty"`
+	PoolType            PoolType      `protobuf:"varint,10,opt,name=poolType,proto3,enum=ibc.applications.interchain_swap.v1.PoolType" json:"poolType,omitempty"`
+	// the amplification of a stable pool
+	Amplification uint64 `protobuf:"varint,11,opt,name=amplification,proto3" json:"amplification,omitempty"`
 }
 
 func (m *MsgMakePoolRequest) Reset()         { *m = MsgMakePoolRequest{} }
@@ -152,6 +155,20 @@ func (m *MsgMakePoolRequest) GetTimeoutTimeStamp() uint64 {
 	return 0
 }
 
+func (m *MsgMakePoolRequest) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return PoolType_WEIGHTED
+}
+
+func (m *MsgMakePoolRequest) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
 type MsgMakePoolResponse struct {
 	PoolId string `protobuf:"bytes,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
 }
@@ -1353,86 +1370,88 @@ func init() {
 }
 
 var fileDescriptor_46ca82afc7d40094 = []byte{
-	// 1255 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x8f, 0xd3, 0xc6,
-	0x1b, 0x5e, 0xe7, 0x3b, 0xef, 0xfe, 0xe0, 0x87, 0x06, 0x68, 0x43, 0x44, 0xc3, 0xd6, 0xed, 0x61,
-	0x85, 0x8a, 0xbd, 0xc9, 0xb6, 0xa5, 0x70, 0x02, 0x96, 0x02, 0x2b, 0x11, 0x15, 0x99, 0xa8, 0x5f,
-	0x1c, 0x90, 0xe3, 0x4c, 0x9d, 0x11, 0x8e, 0xc7, 0x78, 0x26, 0x0b, 0x39, 0x70, 0xea, 0xa1, 0xd7,
-	0x4a, 0x3d, 0x70, 0xe0, 0x52, 0xa9, 0x3d, 0xb5, 0xff, 0x48, 0x8f, 0xf4, 0x56, 0xa9, 0x52, 0x55,
-	0xc1, 0x7f, 0xd0, 0x7b, 0xa5, 0x6a, 0xc6, 0x63, 0xe7, 0xd3, 0x89, 0xc3, 0x02, 0xe2, 0x36, 0x1f,
-	0xef, 0xbc, 0xf3, 0xce, 0xf3, 0x3c, 0xf3, 0xbe, 0x63, 0xc3, 0x07, 0xa4, 0xeb, 0x98, 0x76, 0x10,
-	0x78, 0xc4, 0xb1, 0x39, 0xa1, 0x3e, 0x33, 0x89, 0xcf, 0x71, 0xe8, 0xf4, 0x6d, 0xe2, 0xdf, 0x65,
-	0x0f, 0xec, 0xc0, 0x3c, 0x68, 0x9a, 0xfc, 0xa1, 0x11, 0x84, 0x94, 0x53, 0xf4, 0x1e, 0xe9, 0x3a,
-	0xc6, 0xa4, 0xb5, 0x31, 0x63, 0x6d, 0x1c, 0x34, 0xeb, 0x27, 0x5c, 0xea, 0x52, 0x69, 0x6f, 0x8a,
-	0x56, 0xb4, 0xb4, 0x7e, 0xca, 0xa5, 0xd4, 0xf5, 0xb0, 0x29, 0x7b, 0xdd, 0xe1, 0x37, 0xa6, 0xed,
-	0x8f, 0xd4, 0x54, 0xc3, 0xa1, 0x6c, 0x40, 0x99, 0xd9, 0xb5, 0x19, 0x36, 0x0f, 0x9a, 0x5d, 0xcc,
-	0xed, 0xa6, 0xe9, 0x50, 0xe2, 0xab, 0xf9, 0xba, 0x9a, 0xe7, 0x0f, 0x93, 0xd9, 0x38, 0xa2, 0xfa,
-	0x19, 0x11, 0xbf, 0x43, 0x43, 0x6c, 0x3a, 0x1e, 0xc1, 0x3e, 0x17, 0xe1, 0x46, 0x2d, 0x65, 0xb0,
-	0x93, 0xe5, 0x80, 0x03, 0x3b, 0xbc, 0x87, 0xd5, 0x0a, 0xfd, 0x71, 0x1e, 0x50, 0x9b, 0xb9, 0x6d,
-	0xfb, 0x1e, 0xbe, 0x45, 0xa9, 0x67, 0xe1, 0xfb, 0x43, 0xcc, 0x38, 0x6a, 0x00, 0x30, 0x3a, 0x0c,
-	0x1d, 0x7c, 0x8b, 0x86, 0xbc, 0xa6, 0x6d, 0x69, 0xdb, 0x55, 0x6b, 0x62, 0x04, 0xbd, 0x0f, 0x47,
-	0xa2, 0xde, 0x5e, 0xdf, 0xf6, 0x7d, 0xec, 0xd5, 0x72, 0xd2, 0x64, 0x7a, 0x10, 0xd5, 0xa0, 0xec,
-	0x84, 0xd8, 0xe6, 0x34, 0xac, 0xe5, 0xe5, 0x7c, 0xdc, 0x45, 0x3b, 0x70, 0xdc, 0xa1, 0x43, 0x11,
-	0xdb, 0x2d, 0x3b, 0xe4, 0xa3, 0x3d, 0x65, 0x55, 0x90, 0x56, 0x8b, 0xa6, 0xd0, 0x4d, 0xa8, 0x7a,
-	0xe4, 0xfe, 0x90, 0xf4, 0x08, 0x1f, 0xd5, 0x8a, 0x5b, 0xf9, 0xed, 0xcd, 0x96, 0x61, 0x64, 0x60,
-	0xc8, 0x10, 0xc7, 0xba, 0xcc, 0x18, 0xe6, 0xd6, 0xd8, 0x81, 0x88, 0x4c, 0xcc, 0x5f, 0xc3, 0xb8,
-	0x56, 0xda, 0xd2, 0xb6, 0x8f, 0x58, 0x71, 0x17, 0xdd, 0x81, 0x23, 0x9c, 0x0c, 0x30, 0x1d, 0xf2,
-	0x1b, 0x98, 0xb8, 0x7d, 0x5e, 0xab, 0x6c, 0x69, 0xdb, 0x9b, 0xad, 0xba, 0xdc, 0x4b, 0x60, 0x6f,
-	0x28, 0xc4, 0x0f, 0x9a, 0x46, 0x64, 0x71, 0xe5, 0xd4, 0x3f, 0x7f, 0x9d, 0x39, 0x39, 0xb2, 0x07,
-	0xde, 0x45, 0x5d, 0x2d, 0xbd, 0xdb, 0x97, 0x33, 0xba, 0x35, 0xed, 0x0b, 0x9d, 0x85, 0x63, 0x6a,
-	0xa0, 0x43, 0x06, 0xf8, 0x36, 0xb7, 0x07, 0x41, 0xad, 0xba, 0xa5, 0x6d, 0x17, 0xac, 0xb9, 0x71,
-	0xfd, 0x1c, 0x1c, 0x9f, 0x22, 0x86, 0x05, 0xd4, 0x67, 0x18, 0xbd, 0x05, 0xa5, 0x80, 0x52, 0x6f,
-	0xbf, 0xa7, 0x58, 0x51, 0x3d, 0xfd, 0x71, 0x0e, 0x4e, 0xb4, 0x99, 0xbb, 0x67, 0xfb, 0x0e, 0xf6,
-	0x5e, 0x27, 0x95, 0xe3, 0x80, 0x0a, 0x93, 0x01, 0xcd, 0x03, 0x59, 0x7c, 0xc5, 0x40, 0x96, 0x52,
-	0x80, 0x34, 0xe1, 0xe4, 0x0c, 0x30, 0x2b, 0xa0, 0xfc, 0x57, 0x93, 0x77, 0xa2, 0x33, 0x73, 0x27,
-	0x26, 0x20, 0xd0, 0xd2, 0x20, 0xc8, 0x4d, 0x41, 0x80, 0xa0, 0x10, 0x08, 0xd0, 0x23, 0xc4, 0x64,
-	0x5b, 0x7a, 0x51, 0x40, 0x17, 0x94, 0x17, 0x05, 0xf1, 0x1b, 0x03, 0x58, 0xa4, 0xbc, 0x4e, 0x56,
-	0xe5, 0x3d, 0xc9, 0xc1, 0xe9, 0x36, 0x73, 0x6f, 0x13, 0xdf, 0xf5, 0xb0, 0xbc, 0x69, 0x57, 0x71,
-	0x40, 0x19, 0xe1, 0x31, 0x70, 0x29, 0x0b, 0xc5, 0x38, 0xc3, 0x7e, 0x0f, 0x87, 0x31, 0x6c, 0x51,
-	0x0f, 0x99, 0x50, 0xe4, 0xf4, 0x1e, 0xf6, 0x25, 0x6e, 0x9b, 0xad, 0x53, 0x46, 0x94, 0x12, 0x0d,
-	0x91, 0x32, 0x0d, 0x95, 0x14, 0x8d, 0x3d, 0x4a, 0x7c, 0x2b, 0xb2, 0x4b, 0x70, 0x2e, 0x2c, 0xc6,
-	0xb9, 0x38, 0x8d, 0xf3, 0xa5, 0x59, 0x9c, 0x4b, 0xab, 0x70, 0xce, 0x02, 0x66, 0x39, 0x05, 0xcc,
-	0x2f, 0xe1, 0x9d, 0x14, 0x70, 0x14, 0xac, 0xe7, 0xa1, 0x2a, 0xf0, 0xe8, 0xc8, 0x13, 0x6b, 0xab,
-	0x4e, 0x3c, 0xb6, 0xd5, 0x7f, 0xce, 0xc1, 0x19, 0x95, 0x21, 0xda, 0x43, 0x8f, 0x93, 0x75, 0xa0,
-	0x6f, 0x43, 0xa5, 0x17, 0x59, 0xb2, 0x5a, 0x4e, 0x26, 0xd3, 0x66, 0xa6, 0x64, 0xaa, 0xdc, 0x47,
-	0xf9, 0x34, 0x71, 0xb1, 0xa6, 0xd0, 0x2f, 0xad, 0x2d, 0xf4, 0xc3, 0xa8, 0xf9, 0xbb, 0x08, 0xa6,
-	0xce, 0x0a, 0x98, 0x94, 0x12, 0xb5, 0x29, 0x25, 0xa6, 0x5d, 0xec, 0x1a, 0x94, 0x69, 0xd8, 0xc3,
-	0xe1, 0x7e, 0x2f, 0xce, 0x86, 0xaa, 0xfb, 0x46, 0x4b, 0xf1, 0x0e, 0xfc, 0x6f, 0x92, 0xbf, 0xd4,
-	0x53, 0xef, 0x42, 0xb9, 0x6b, 0x7b, 0x22, 0x5f, 0xca, 0x63, 0x2f, 0xd5, 0x63, 0x6c, 0xa9, 0x7f,
-	0x25, 0x93, 0xc0, 0x02, 0x84, 0x95, 0xcc, 0x2f, 0x00, 0x24, 0xd2, 0x65, 0x35, 0x4d, 0x6a, 0x6e,
-	0x89, 0xdf, 0x09, 0x63, 0xfd, 0xa7, 0x1c, 0xbc, 0x9b, 0x64, 0xf0, 0xb5, 0xa5, 0x3e, 0xc1, 0x55,
-	0x6e, 0x9a, 0xab, 0xf4, 0x9a, 0x36, 0x5d, 0x33, 0x0b, 0xab, 0x6b, 0x66, 0x71, 0x51, 0xcd, 0x7c,
-	0xbd, 0xec, 0x7e, 0x0e, 0xfa, 0x32, 0x90, 0x96, 0x27, 0xf1, 0x74, 0x94, 0xf4, 0x3f, 0x73, 0x33,
-	0xcc, 0x7e, 0x41, 0x78, 0xbf, 0x17, 0xda, 0x0f, 0x56, 0x01, 0x5f, 0x87, 0x4a, 0x88, 0x1d, 0x4c,
-	0x0e, 0x92, 0x04, 0x9f, 0xf4, 0x51, 0x0b, 0x4e, 0x4c, 0x3e, 0xf2, 0xac, 0xd8, 0x2e, 0xe2, 0x61,
-	0xe1, 0xdc, 0x74, 0xa2, 0x2c, 0x64, 0x4f, 0x94, 0xc9, 0x9d, 0x2c, 0x2e, 0xbe, 0x93, 0xa5, 0x15,
-	0x77, 0xb2, 0xfc, 0x32, 0x58, 0xab, 0xa4, 0xb0, 0x66, 0xc9, 0xf2, 0xb0, 0x08, 0x5c, 0x45, 0x58,
-	0x13, 0x4a, 0x3c, 0xe3, 0x9d, 0x51, 0x86, 0xfa, 0xef, 0x79, 0x38, 0x2a, 0x6a, 0xce, 0x03, 0x3b,
-	0x88, 0x39, 0x6a, 0x43, 0x55, 0xa4, 0xf0, 0xbb, 0x7c, 0x14, 0x60, 0x49, 0xd3, 0xd1, 0xd6, 0x4e,
-	0xa6, 0x84, 0x2f, 0x9c, 0x88, 0xec, 0x39, 0x0a, 0xb0, 0x55, 0x11, 0x83, 0xa2, 0x95, 0x5a, 0xb9,
-	0xc7, 0x52, 0xc8, 0x4f, 0x49, 0x61, 0x17, 0xca, 0x32, 0xb6, 0xfd, 0x0c, 0xc4, 0xc5, 0x96, 0xe8,
-	0x23, 0xa8, 0xc8, 0xe6, 0x67, 0xc3, 0xb8, 0x42, 0x2c, 0x59, 0x95, 0x98, 0x0a, 0xd9, 0x31, 0x8f,
-	0x04, 0x81, 0xed, 0x62, 0x55, 0x13, 0x92, 0x3e, 0x3a, 0x0d, 0xd5, 0x10, 0x3b, 0x24, 0x10, 0xfc,
-	0x49, 0x5e, 0xab, 0xd6, 0x78, 0x20, 0xd1, 0x49, 0x65, 0xb1, 0x4e, 0xaa, 0x2b, 0x74, 0x02, 0x2f,
-	0x43, 0x27, 0x9b, 0x29, 0x3a, 0xf9, 0x41, 0x83, 0xff, 0x27, 0x9c, 0x2a, 0x69, 0xbc, 0x64, 0x52,
-	0xc7, 0x4a, 0xcb, 0x65, 0x54, 0xda, 0x59, 0x1d, 0x36, 0x27, 0x7c, 0xa1, 0x0a, 0x14, 0x6e, 0x7e,
-	0x7a, 0xad, 0x73, 0x6c, 0x03, 0x55, 0xa1, 0x68, 0xed, 0x5f, 0xbf, 0xd1, 0x39, 0xa6, 0xb5, 0x7e,
-	0x05, 0xc8, 0xb7, 0x99, 0x8b, 0x1e, 0x41, 0x25, 0xfe, 0x98, 0x41, 0xe7, 0x33, 0x85, 0x39, 0xff,
-	0x5d, 0x5a, 0xff, 0x64, 0xfd, 0x85, 0x0a, 0xac, 0x47, 0x50, 0xe9, 0xac, 0xbd, 0x7d, 0xe7, 0x45,
-	0xb7, 0x9f, 0x7b, 0x3c, 0x7f, 0xab, 0x01, 0x8c, 0x3f, 0x41, 0xd0, 0x85, 0xac, 0x8e, 0xe6, 0xbe,
-	0xe7, 0xea, 0x17, 0x5f, 0x64, 0xa9, 0x8a, 0xe2, 0x89, 0x06, 0x68, 0xfe, 0x29, 0x8a, 0x2e, 0x67,
-	0x75, 0x99, 0xfa, 0xc6, 0xaf, 0x5f, 0x39, 0x8c, 0x0b, 0x15, 0xdd, 0x8f, 0x1a, 0x9c, 0x5c, 0xf8,
-	0x9a, 0x45, 0x57, 0xd7, 0xa1, 0x3d, 0xed, 0x85, 0x50, 0xcf, 0x7c, 0xcc, 0xf4, 0xf2, 0x29, 0x42,
-	0xec, 0x1c, 0x2e, 0xc4, 0xce, 0x2b, 0x0e, 0xf1, 0x17, 0x0d, 0xde, 0x4e, 0x79, 0x05, 0xa0, 0x6b,
-	0xeb, 0x69, 0x27, 0x35, 0xcc, 0xeb, 0x87, 0xf6, 0x33, 0x21, 0xc8, 0xf9, 0xe2, 0x87, 0x5e, 0x00,
-	0x86, 0x99, 0x57, 0x49, 0x76, 0x41, 0x2e, 0xa9, 0xbd, 0xf7, 0xa1, 0x20, 0xd2, 0x1b, 0xda, 0xcd,
-	0x2c, 0xee, 0x71, 0xc9, 0xad, 0x7f, 0xb8, 0xde, 0xa2, 0x68, 0xcb, 0x2b, 0xce, 0x6f, 0xcf, 0x1a,
-	0xda, 0xd3, 0x67, 0x0d, 0xed, 0xef, 0x67, 0x0d, 0xed, 0xfb, 0xe7, 0x8d, 0x8d, 0xa7, 0xcf, 0x1b,
-	0x1b, 0x7f, 0x3c, 0x6f, 0x6c, 0x7c, 0xbd, 0xef, 0x12, 0xde, 0x1f, 0x76, 0x0d, 0x87, 0x0e, 0x4c,
-	0x46, 0x7a, 0x58, 0xfe, 0xbf, 0x73, 0xa8, 0x67, 0x92, 0xae, 0x13, 0xfd, 0xda, 0xfb, 0xd8, 0x1c,
-	0xd0, 0xde, 0xd0, 0xc3, 0xcc, 0xb4, 0x83, 0x80, 0x99, 0xcd, 0x9d, 0xe6, 0xb9, 0xf1, 0x8e, 0xe7,
-	0xa4, 0x8d, 0x28, 0x15, 0xac, 0x5b, 0x92, 0x6b, 0x77, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xe4,
-	0x64, 0x17, 0x8a, 0x10, 0x15, 0x00, 0x00,
+	// 1290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x8f, 0xd3, 0xc6,
+	0x17, 0x5f, 0x67, 0x93, 0x6c, 0xf2, 0xf6, 0x0b, 0x5f, 0x34, 0x40, 0x6b, 0x2c, 0x08, 0xa9, 0xdb,
+	0xc3, 0x0a, 0x75, 0xed, 0xcd, 0x6e, 0x5b, 0x0a, 0x27, 0xd8, 0xa5, 0xc0, 0x4a, 0x44, 0x45, 0x26,
+	0xea, 0x2f, 0x0e, 0xc8, 0x71, 0x06, 0xef, 0x08, 0xc7, 0x63, 0x3c, 0x93, 0x85, 0x3d, 0x70, 0xea,
+	0xa1, 0x3d, 0x56, 0xea, 0xa1, 0x07, 0x2e, 0x95, 0xda, 0x53, 0xfb, 0x8f, 0xf4, 0x48, 0x6f, 0x95,
+	0x2a, 0x55, 0x15, 0xfc, 0x07, 0xbd, 0x57, 0xaa, 0x66, 0x3c, 0x76, 0x92, 0x4d, 0xbc, 0x71, 0xd8,
+	0x05, 0x71, 0x9b, 0x1f, 0xef, 0xbd, 0x79, 0xf3, 0x79, 0x9f, 0x79, 0xef, 0xd9, 0xf0, 0x3e, 0xe9,
+	0x7a, 0xb6, 0x1b, 0x45, 0x01, 0xf1, 0x5c, 0x4e, 0x68, 0xc8, 0x6c, 0x12, 0x72, 0x1c, 0x7b, 0x3b,
+	0x2e, 0x09, 0xef, 0xb1, 0x47, 0x6e, 0x64, 0xef, 0xb6, 0x6c, 0xfe, 0xd8, 0x8a, 0x62, 0xca, 0x29,
+	0x7a, 0x97, 0x74, 0x3d, 0x6b, 0x54, 0xda, 0xda, 0x27, 0x6d, 0xed, 0xb6, 0x8c, 0x53, 0x3e, 0xf5,
+	0xa9, 0x94, 0xb7, 0xc5, 0x28, 0x51, 0x35, 0xce, 0xf8, 0x94, 0xfa, 0x01, 0xb6, 0xe5, 0xac, 0x3b,
+	0xb8, 0x6f, 0xbb, 0xe1, 0x9e, 0xda, 0x6a, 0x78, 0x94, 0xf5, 0x29, 0xb3, 0xbb, 0x2e, 0xc3, 0xf6,
+	0x6e, 0xab, 0x8b, 0xb9, 0xdb, 0xb2, 0x3d, 0x4a, 0x42, 0xb5, 0x6f, 0xa8, 0x7d, 0xfe, 0x38, 0xdb,
+	0x4d, 0x3d, 0x32, 0xce, 0x0b, 0xff, 0x3d, 0x1a, 0x63, 0xdb, 0x0b, 0x08, 0x0e, 0xb9, 0x70, 0x37,
+	0x19, 0x29, 0x81, 0xb5, 0x22, 0x17, 0xec, 0xbb, 0xf1, 0x03, 0xac, 0x34, 0xcc, 0x6f, 0xcb, 0x80,
+	0xda, 0xcc, 0x6f, 0xbb, 0x0f, 0xf0, 0x6d, 0x4a, 0x03, 0x07, 0x3f, 0x1c, 0x60, 0xc6, 0x51, 0x03,
+	0x80, 0xd1, 0x41, 0xec, 0xe1, 0xdb, 0x34, 0xe6, 0xba, 0xd6, 0xd4, 0x56, 0xea, 0xce, 0xc8, 0x0a,
+	0x7a, 0x0f, 0x8e, 0x25, 0xb3, 0xad, 0x1d, 0x37, 0x0c, 0x71, 0xa0, 0x97, 0xa4, 0xc8, 0xf8, 0x22,
+	0xd2, 0x61, 0xc9, 0x8b, 0xb1, 0xcb, 0x69, 0xac, 0x2f, 0xca, 0xfd, 0x74, 0x8a, 0xd6, 0xe0, 0xa4,
+	0x47, 0x07, 0xc2, 0xb7, 0xdb, 0x6e, 0xcc, 0xf7, 0xb6, 0x94, 0x54, 0x59, 0x4a, 0x4d, 0xdb, 0x42,
+	0xb7, 0xa0, 0x1e, 0x90, 0x87, 0x03, 0xd2, 0x23, 0x7c, 0x4f, 0xaf, 0x34, 0x17, 0x57, 0x96, 0xd7,
+	0x2d, 0xab, 0x40, 0x84, 0x2c, 0x71, 0xad, 0xab, 0x8c, 0x61, 0xee, 0x0c, 0x0d, 0x08, 0xcf, 0xc4,
+	0xfe, 0x75, 0x8c, 0xf5, 0x6a, 0x53, 0x5b, 0x39, 0xe6, 0xa4, 0x53, 0x74, 0x17, 0x8e, 0x71, 0xd2,
+	0xc7, 0x74, 0xc0, 0x6f, 0x62, 0xe2, 0xef, 0x70, 0xbd, 0xd6, 0xd4, 0x56, 0x96, 0xd7, 0x0d, 0x79,
+	0x96, 0xc0, 0xde, 0x52, 0x88, 0xef, 0xb6, 0xac, 0x44, 0x62, 0xf3, 0xcc, 0x3f, 0x7f, 0x9d, 0x3f,
+	0xbd, 0xe7, 0xf6, 0x83, 0xcb, 0xa6, 0x52, 0xbd, 0xb7, 0x23, 0x77, 0x4c, 0x67, 0xdc, 0x16, 0xba,
+	0x00, 0x27, 0xd4, 0x42, 0x87, 0xf4, 0xf1, 0x1d, 0xee, 0xf6, 0x23, 0xbd, 0xde, 0xd4, 0x56, 0xca,
+	0xce, 0xc4, 0x3a, 0xda, 0x86, 0x5a, 0x44, 0x69, 0xd0, 0xd9, 0x8b, 0xb0, 0x0e, 0x4d, 0x6d, 0xe5,
+	0xf8, 0xfa, 0x6a, 0xe1, 0xfb, 0x0a, 0x25, 0x27, 0x53, 0x17, 0xd1, 0x72, 0xfb, 0x51, 0x40, 0xee,
+	0x2b, 0x3d, 0x7d, 0x59, 0x9e, 0x39, 0xbe, 0x68, 0xae, 0xc2, 0xc9, 0x31, 0x26, 0xb0, 0x88, 0x86,
+	0x0c, 0xa3, 0xb7, 0xa0, 0x2a, 0x0c, 0x6d, 0xf7, 0x14, 0x0d, 0xd4, 0xcc, 0xfc, 0xa1, 0x04, 0xa7,
+	0xda, 0xcc, 0xdf, 0x72, 0x43, 0x0f, 0x07, 0xaf, 0x93, 0x3b, 0x43, 0x87, 0xca, 0xa3, 0x0e, 0x4d,
+	0x46, 0xae, 0xf2, 0x8a, 0x23, 0x57, 0x9d, 0x1e, 0x39, 0xd3, 0x86, 0xd3, 0xfb, 0x80, 0x99, 0x01,
+	0xe5, 0xbf, 0x9a, 0x7c, 0x84, 0x9d, 0x7d, 0x8f, 0x70, 0x04, 0x02, 0x2d, 0x0f, 0x82, 0xd2, 0x18,
+	0x04, 0x08, 0xca, 0x91, 0x00, 0x3d, 0x41, 0x4c, 0x8e, 0xa5, 0x15, 0x05, 0x74, 0x59, 0x59, 0x51,
+	0x10, 0xbf, 0x31, 0x80, 0x25, 0xcc, 0xeb, 0x14, 0x65, 0xde, 0xd3, 0x12, 0x9c, 0x6d, 0x33, 0xff,
+	0x0e, 0x09, 0xfd, 0x00, 0xcb, 0xa7, 0x7d, 0x0d, 0x47, 0x94, 0x11, 0x9e, 0x02, 0x97, 0xa3, 0x28,
+	0xd6, 0x19, 0x0e, 0x7b, 0x38, 0x4e, 0x61, 0x4b, 0x66, 0xc8, 0x86, 0x0a, 0xa7, 0x0f, 0x70, 0x28,
+	0x71, 0x5b, 0x5e, 0x3f, 0x63, 0x25, 0x39, 0xd8, 0x12, 0x39, 0xda, 0x52, 0x59, 0xd8, 0xda, 0xa2,
+	0x24, 0x74, 0x12, 0xb9, 0x0c, 0xe7, 0xf2, 0x74, 0x9c, 0x2b, 0xe3, 0x38, 0x5f, 0xd9, 0x8f, 0x73,
+	0x75, 0x16, 0xce, 0x45, 0xc0, 0x5c, 0xca, 0x01, 0xf3, 0x0b, 0x38, 0x97, 0x03, 0x8e, 0x82, 0xf5,
+	0x22, 0xd4, 0x65, 0x66, 0x90, 0x37, 0xd6, 0x66, 0xdd, 0x78, 0x28, 0x6b, 0xfe, 0x5c, 0x82, 0xf3,
+	0x2a, 0x43, 0xb4, 0x07, 0x01, 0x27, 0xf3, 0x40, 0xdf, 0x86, 0x5a, 0x2f, 0x91, 0x64, 0x7a, 0x49,
+	0x66, 0xef, 0x56, 0xa1, 0x6c, 0xa6, 0xcc, 0x27, 0x09, 0x3c, 0x33, 0x31, 0x27, 0xd1, 0xaf, 0xcc,
+	0x4d, 0xf4, 0xc3, 0xb0, 0xf9, 0x9b, 0x04, 0xa6, 0xce, 0x0c, 0x98, 0x14, 0x13, 0xb5, 0x31, 0x26,
+	0xe6, 0x3d, 0x6c, 0x1d, 0x96, 0x68, 0xdc, 0xc3, 0xf1, 0x76, 0x2f, 0xcd, 0x86, 0x6a, 0xfa, 0x46,
+	0x53, 0xf1, 0x2e, 0xfc, 0x6f, 0x34, 0x7e, 0xb9, 0xb7, 0xde, 0x80, 0xa5, 0xae, 0x1b, 0x88, 0x7c,
+	0xa9, 0x97, 0x66, 0xf1, 0x31, 0x95, 0x34, 0xbf, 0x94, 0x49, 0x60, 0x0a, 0xc2, 0x8a, 0xe6, 0x97,
+	0x00, 0x32, 0xea, 0x32, 0x5d, 0x6b, 0x2e, 0x1e, 0x6c, 0x77, 0x44, 0xd8, 0xfc, 0xa9, 0x04, 0xef,
+	0x64, 0x19, 0x7c, 0x6e, 0xaa, 0x8f, 0xc4, 0xaa, 0x34, 0x1e, 0xab, 0xfc, 0x9a, 0x36, 0x5e, 0x33,
+	0xcb, 0xb3, 0x6b, 0x66, 0x65, 0x5a, 0xcd, 0x7c, 0xbd, 0xd1, 0xfd, 0x0c, 0xcc, 0x83, 0x40, 0x3a,
+	0x38, 0x89, 0xe7, 0xa3, 0x64, 0xfe, 0x59, 0xda, 0x17, 0xd9, 0xcf, 0x09, 0xdf, 0xe9, 0xc5, 0xee,
+	0xa3, 0x59, 0xc0, 0x1b, 0x50, 0x8b, 0xb1, 0x87, 0xc9, 0x6e, 0x96, 0xe0, 0xb3, 0x39, 0x5a, 0x87,
+	0x53, 0xa3, 0x5d, 0xa5, 0x93, 0xca, 0x25, 0x71, 0x98, 0xba, 0x37, 0x9e, 0x28, 0xcb, 0xc5, 0x13,
+	0x65, 0xf6, 0x26, 0x2b, 0xd3, 0xdf, 0x64, 0x75, 0xc6, 0x9b, 0x5c, 0x3a, 0x8a, 0xa8, 0xd5, 0x72,
+	0xa2, 0xe6, 0xc0, 0xb9, 0x1c, 0x70, 0x55, 0xc0, 0x5a, 0x50, 0xe5, 0x05, 0xdf, 0x8c, 0x12, 0x34,
+	0x7f, 0x5f, 0x84, 0xe3, 0xa2, 0xe6, 0x3c, 0x72, 0xa3, 0x34, 0x46, 0x6d, 0xa8, 0x8b, 0x14, 0x7e,
+	0x8f, 0x8b, 0xf6, 0x55, 0x93, 0xed, 0xeb, 0x5a, 0xa1, 0x84, 0x2f, 0x8c, 0x88, 0xec, 0x29, 0x3b,
+	0x58, 0xb1, 0x28, 0x46, 0xb9, 0x95, 0x7b, 0x48, 0x85, 0xc5, 0x31, 0x2a, 0x6c, 0xc0, 0x92, 0xf4,
+	0x6d, 0xbb, 0x40, 0xe0, 0x52, 0x49, 0xf4, 0x21, 0xd4, 0xe4, 0xf0, 0xd3, 0x41, 0x5a, 0x21, 0x0e,
+	0xd0, 0xca, 0x44, 0x05, 0xed, 0x58, 0x40, 0xa2, 0xc8, 0xf5, 0xb1, 0xaa, 0x09, 0xd9, 0x1c, 0x9d,
+	0x85, 0x7a, 0x8c, 0x3d, 0x12, 0x89, 0xf8, 0xc9, 0xb8, 0xd6, 0x9d, 0xe1, 0x42, 0xc6, 0x93, 0xda,
+	0x74, 0x9e, 0xd4, 0x67, 0xf0, 0x04, 0x8e, 0x82, 0x27, 0xcb, 0x39, 0x3c, 0xf9, 0x5e, 0x83, 0xff,
+	0x67, 0x31, 0x55, 0xd4, 0x38, 0xe2, 0xa0, 0x0e, 0x99, 0x56, 0x2a, 0xc8, 0xb4, 0x0b, 0x26, 0x2c,
+	0x8f, 0xd8, 0x42, 0x35, 0x28, 0xdf, 0xfa, 0xe4, 0x7a, 0xe7, 0xc4, 0x02, 0xaa, 0x43, 0xc5, 0xd9,
+	0xbe, 0x71, 0xb3, 0x73, 0x42, 0x5b, 0xff, 0x15, 0x60, 0xb1, 0xcd, 0x7c, 0xf4, 0x04, 0x6a, 0xe9,
+	0xc7, 0x0c, 0xba, 0x58, 0xc8, 0xcd, 0xc9, 0x0f, 0x61, 0xe3, 0xe3, 0xf9, 0x15, 0x15, 0x58, 0x4f,
+	0xa0, 0xd6, 0x99, 0xfb, 0xf8, 0xce, 0xcb, 0x1e, 0x3f, 0xd1, 0x3c, 0x7f, 0xad, 0x01, 0x0c, 0x3f,
+	0x41, 0xd0, 0xa5, 0xa2, 0x86, 0x26, 0xbe, 0xe7, 0x8c, 0xcb, 0x2f, 0xa3, 0xaa, 0xbc, 0x78, 0xaa,
+	0x01, 0x9a, 0x6c, 0x45, 0xd1, 0xd5, 0xa2, 0x26, 0x73, 0x7b, 0x7c, 0x63, 0xf3, 0x30, 0x26, 0x94,
+	0x77, 0x3f, 0x6a, 0x70, 0x7a, 0x6a, 0x37, 0x8b, 0xae, 0xcd, 0x13, 0xf6, 0xbc, 0x0e, 0xc1, 0x28,
+	0x7c, 0xcd, 0xfc, 0xf2, 0x29, 0x5c, 0xec, 0x1c, 0xce, 0xc5, 0xce, 0x2b, 0x76, 0xf1, 0x17, 0x0d,
+	0xde, 0xce, 0xe9, 0x02, 0xd0, 0xf5, 0xf9, 0xb8, 0x93, 0xeb, 0xe6, 0x8d, 0x43, 0xdb, 0x19, 0x21,
+	0xe4, 0x64, 0xf1, 0x43, 0x2f, 0x01, 0xc3, 0xbe, 0xae, 0xc4, 0xd8, 0x3c, 0x8c, 0x09, 0xe5, 0xdd,
+	0x43, 0x28, 0x8b, 0xf4, 0x86, 0x36, 0x0a, 0x93, 0x7b, 0x58, 0x72, 0x8d, 0x0f, 0xe6, 0x53, 0x4a,
+	0x8e, 0xdc, 0xf4, 0x7e, 0x7b, 0xde, 0xd0, 0x9e, 0x3d, 0x6f, 0x68, 0x7f, 0x3f, 0x6f, 0x68, 0xdf,
+	0xbd, 0x68, 0x2c, 0x3c, 0x7b, 0xd1, 0x58, 0xf8, 0xe3, 0x45, 0x63, 0xe1, 0xab, 0x6d, 0x9f, 0xf0,
+	0x9d, 0x41, 0xd7, 0xf2, 0x68, 0xdf, 0x66, 0xa4, 0x87, 0xe5, 0x0f, 0x43, 0x8f, 0x06, 0x36, 0xe9,
+	0x7a, 0xc9, 0xbf, 0xc4, 0x8f, 0xec, 0x3e, 0xed, 0x0d, 0x02, 0xcc, 0xc4, 0x3f, 0x47, 0x66, 0xb7,
+	0xd6, 0x5a, 0xab, 0xc3, 0x13, 0x57, 0xa5, 0x8c, 0x28, 0x15, 0xac, 0x5b, 0x95, 0xba, 0x1b, 0xff,
+	0x0d, 0x00, 0xdd, 0xf8, 0x38, 0xbc, 0x81, 0x15, 0x00, 0x00,
 }
 
 func (m *MsgMakePoolRequest) Marshal() (dAtA []byte, err error) {
@@ -1455,6 +1474,16 @@ func (m *MsgMakePoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Amplification != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.TimeoutTimeStamp != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimeStamp))
 		i--
@@ -2528,6 +2557,12 @@ func (m *MsgMakePoolRequest) Size() (n int) {
 	if m.TimeoutTimeStamp != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutTimeStamp))
 	}
+	if m.PoolType != 0 {
+		n += 1 + sovTx(uint64(m.PoolType))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovTx(uint64(m.Amplification))
+	}
 	return n
 }
 
@@ -3232,6 +3267,44 @@ func (m *MsgMakePoolRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
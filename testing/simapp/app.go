@@ -386,7 +386,7 @@ func NewSimApp(
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(interchainswaptypes.RouterKey, interchainswap.NewMarketFeeUpdateProposalHandler(app.InterchainSwapKeeper)).
+		AddRoute(interchainswaptypes.RouterKey, interchainswap.NewProposalHandler(&app.InterchainSwapKeeper)).
 		//AddRoute(atomicswaptypes.RouterKey, ibcclient.NewClientProposalHandler(app.AtomicSwapKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
 
@@ -460,7 +460,10 @@ func NewSimApp(
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.DistrKeeper,
+		app.AuthzKeeper,
 		scopedAtomicSwapKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.InterchainSwapKeeper = *interchainswapkeeper.NewKeeper(
@@ -474,6 +477,8 @@ func NewSimApp(
 		app.AccountKeeper,
 		scopedInterchainSwapKeeper,
 		app.MsgServiceRouter(),
+		app.AtomicSwapKeeper,
+		app.AtomicSwapKeeper,
 	)
 
 	// Mock Module Stack
@@ -610,7 +615,7 @@ func NewSimApp(
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 
 		// Swap modules
-		atomicswap.NewAppModule(app.AtomicSwapKeeper),
+		atomicswap.NewAppModule(app.AtomicSwapKeeper, app.BankKeeper),
 		interchainswap.NewAppModule(app.InterchainSwapKeeper),
 		mockModule,
 	)
@@ -677,7 +682,7 @@ func NewSimApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		transfer.NewAppModule(app.TransferKeeper),
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
-		atomicswap.NewAppModule(app.AtomicSwapKeeper),
+		atomicswap.NewAppModule(app.AtomicSwapKeeper, app.BankKeeper),
 		interchainswap.NewAppModule(app.InterchainSwapKeeper),
 	)
 